@@ -404,12 +404,33 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
+// vcrEnabledTestCase returns a copy of the test case whose provider factories,
+// including those configured on individual test steps, are ready for use with VCR
+func vcrEnabledTestCase(ctx context.Context, t *testing.T, c resource.TestCase) resource.TestCase {
+	t.Helper()
+
+	if c.ProtoV5ProviderFactories != nil {
+		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+	}
+
+	steps := make([]resource.TestStep, len(c.Steps))
+	for i, step := range c.Steps {
+		if step.ProtoV5ProviderFactories != nil {
+			step.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, step.ProtoV5ProviderFactories)
+		}
+		steps[i] = step
+	}
+	c.Steps = steps
+
+	return c
+}
+
 // ParallelTest wraps resource.ParallelTest, initializing VCR if enabled
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if vcr.IsEnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		c = vcrEnabledTestCase(ctx, t, c)
 		defer closeVCRRecorder(ctx, t)
 	}
 
@@ -421,7 +442,7 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if vcr.IsEnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		c = vcrEnabledTestCase(ctx, t, c)
 		defer closeVCRRecorder(ctx, t)
 	}
 
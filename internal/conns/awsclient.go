@@ -31,18 +31,26 @@ type AWSClient struct {
 	awsConfig                 *aws.Config
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
+	endpointURLTemplate       string            // From provider configuration.
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
+	kmsPreventDestroyEnforced bool // From provider configuration.
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	planOperationsPreview     bool                   // From provider configuration.
+	serviceAWSConfigs         map[string]*aws.Config // Service package name -> AWS SDK configuration with per-service credentials.
+	serviceCaches             map[string]any         // Service package name -> service-specific cache.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
-	terraformVersion          string // From provider configuration.
+	s3UsePathStyle            bool                       // From provider configuration.
+	s3USEast1RegionalEndpoint string                     // From provider configuration.
+	skipEC2DescribeCache      bool                       // From provider configuration.
+	skipTagBatching           bool                       // From provider configuration.
+	stsRegion                 string                     // From provider configuration.
+	tagsBatchers              map[string]*tftags.Batcher // Service package name -> tagging operation batcher.
+	terraformVersion          string                     // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -186,6 +194,64 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	return c.s3ExpressClient
 }
 
+// ServiceCache returns the service-specific cache for the specified service package, creating it with `f` on first use.
+// The cache lives as long as the AWSClient, i.e. the configured provider instance.
+func (c *AWSClient) ServiceCache(_ context.Context, servicePackageName string, f func() any) any {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.serviceCaches == nil {
+		c.serviceCaches = make(map[string]any)
+	}
+
+	v, ok := c.serviceCaches[servicePackageName]
+	if !ok {
+		v = f()
+		c.serviceCaches[servicePackageName] = v
+	}
+
+	return v
+}
+
+// TagsBatcher returns the tagging operation batcher for the specified service package, creating it on first use.
+// maxSize is the maximum number of resources the service's tagging APIs accept in a single call.
+// A nil Batcher is returned if the provider is configured with skip_tag_batching.
+func (c *AWSClient) TagsBatcher(_ context.Context, servicePackageName string, maxSize int) *tftags.Batcher {
+	if c.skipTagBatching {
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.tagsBatchers == nil {
+		c.tagsBatchers = make(map[string]*tftags.Batcher)
+	}
+
+	b, ok := c.tagsBatchers[servicePackageName]
+	if !ok {
+		b = tftags.NewBatcher(maxSize)
+		c.tagsBatchers[servicePackageName] = b
+	}
+
+	return b
+}
+
+// KMSPreventDestroyEnforced returns the kms_prevent_destroy_enforced provider configuration value.
+func (c *AWSClient) KMSPreventDestroyEnforced(context.Context) bool {
+	return c.kmsPreventDestroyEnforced
+}
+
+// PlanOperationsPreview returns whether plans list the AWS API operations that resource changes will make.
+func (c *AWSClient) PlanOperationsPreview(context.Context) bool {
+	return c.planOperationsPreview
+}
+
+// SkipEC2DescribeCache returns whether EC2 Describe results are not to be shared between data sources.
+func (c *AWSClient) SkipEC2DescribeCache(context.Context) bool {
+	return c.skipEC2DescribeCache
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -326,12 +392,27 @@ func convertIPToDashIP(ip string) string {
 }
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
-func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) (map[string]any, error) {
+	awsConfig := c.awsConfig
+	if v, ok := c.serviceAWSConfigs[servicePackageName]; ok {
+		awsConfig = v
+	}
+
+	region := c.Region(ctx)
+	endpoint := c.endpoints[servicePackageName]
+	if endpoint == "" {
+		var err error
+		endpoint, err = expandEndpointURLTemplate(c.endpointURLTemplate, servicePackageName, region)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
-		"endpoint":         c.endpoints[servicePackageName],
+		"aws_sdkv2_config": awsConfig,
+		"endpoint":         endpoint,
 		"partition":        c.Partition(ctx),
-		"region":           c.Region(ctx),
+		"region":           region,
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -346,7 +427,7 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
-	return m
+	return m, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
@@ -388,7 +469,11 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
-	config := c.apiClientConfig(ctx, servicePackageName)
+	config, err := c.apiClientConfig(ctx, servicePackageName)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
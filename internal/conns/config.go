@@ -35,25 +35,31 @@ type Config struct {
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointURLTemplate            string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	KMSPreventDestroyEnforced      bool
 	MaxRetries                     int
 	NoProxy                        string
+	PlanOperationsPreview          bool
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceAssumeRoles             map[string]ServiceAssumeRole // Service package name -> role.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
+	SkipEC2DescribeCache           bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
+	SkipTagBatching                bool
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TerraformVersion               string
@@ -72,6 +78,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
+
+	iamEndpoint, err := c.endpoint(names.IAM, c.Region)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+	ssoEndpoint, err := c.endpoint(names.SSO, c.Region)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+	stsEndpoint, err := c.endpoint(names.STS, c.stsRegion())
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -89,7 +109,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		CallerName:                     "Terraform AWS Provider",
 		EC2MetadataServiceEnableState:  c.EC2MetadataServiceEnableState,
 		ForbiddenAccountIds:            c.ForbiddenAccountIds,
-		IamEndpoint:                    c.Endpoints[names.IAM],
+		IamEndpoint:                    iamEndpoint,
 		Insecure:                       c.Insecure,
 		HTTPClient:                     client.HTTPClient(ctx),
 		HTTPProxy:                      c.HTTPProxy,
@@ -105,8 +125,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		SecretKey:                      c.SecretKey,
 		SkipCredsValidation:            c.SkipCredsValidation,
 		SkipRequestingAccountId:        c.SkipRequestingAccountId,
-		SsoEndpoint:                    c.Endpoints[names.SSO],
-		StsEndpoint:                    c.Endpoints[names.STS],
+		SsoEndpoint:                    ssoEndpoint,
+		StsEndpoint:                    stsEndpoint,
 		SuppressDebugLog:               c.SuppressDebugLog,
 		Token:                          c.Token,
 		TokenBucketRateLimiterCapacity: c.TokenBucketRateLimiterCapacity,
@@ -180,7 +200,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
 	}
 
-	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
+	err = awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
 		return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
 	}
@@ -199,15 +219,43 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
+	client.endpointURLTemplate = c.EndpointURLTemplate
 	client.endpoints = c.Endpoints
+	client.serviceAWSConfigs = make(map[string]*aws.Config, len(c.ServiceAssumeRoles))
+	for servicePackageName, role := range c.ServiceAssumeRoles {
+		client.serviceAWSConfigs[servicePackageName] = newServiceAWSConfig(cfg, role, stsEndpoint, c.STSRegion)
+	}
+	client.kmsPreventDestroyEnforced = c.KMSPreventDestroyEnforced
 	client.logger = logger
+	client.planOperationsPreview = c.PlanOperationsPreview
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.skipEC2DescribeCache = c.SkipEC2DescribeCache
+	client.skipTagBatching = c.SkipTagBatching
 	client.stsRegion = c.STSRegion
 
 	return client, diags
 }
 
+// endpoint returns the configured endpoint URL for the specified service package.
+// An explicitly configured endpoint takes precedence over the endpoint URL template.
+func (c *Config) endpoint(servicePackageName, region string) (string, error) {
+	if v := c.Endpoints[servicePackageName]; v != "" {
+		return v, nil
+	}
+
+	return expandEndpointURLTemplate(c.EndpointURLTemplate, servicePackageName, region)
+}
+
+// stsRegion returns the Region used for STS API calls.
+func (c *Config) stsRegion() string {
+	if c.STSRegion != "" {
+		return c.STSRegion
+	}
+
+	return c.Region
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	EndpointURLTemplatePlaceholderDNSSuffix = "{dns_suffix}"
+	EndpointURLTemplatePlaceholderPartition = "{partition}"
+	EndpointURLTemplatePlaceholderRegion    = "{region}"
+	EndpointURLTemplatePlaceholderService   = "{service}"
+)
+
+var endpointURLTemplatePlaceholderRegex = regexache.MustCompile(`\{[^{}]*\}`)
+
+// ValidateEndpointURLTemplate verifies that an endpoint URL template only contains supported placeholders.
+func ValidateEndpointURLTemplate(template string) error {
+	for _, v := range endpointURLTemplatePlaceholderRegex.FindAllString(template, -1) {
+		switch v {
+		case EndpointURLTemplatePlaceholderDNSSuffix, EndpointURLTemplatePlaceholderPartition, EndpointURLTemplatePlaceholderRegion, EndpointURLTemplatePlaceholderService:
+		default:
+			return fmt.Errorf("unsupported placeholder %q, expected one of %s, %s, %s or %s", v,
+				EndpointURLTemplatePlaceholderRegion, EndpointURLTemplatePlaceholderService, EndpointURLTemplatePlaceholderPartition, EndpointURLTemplatePlaceholderDNSSuffix)
+		}
+	}
+
+	return nil
+}
+
+// expandEndpointURLTemplate returns the endpoint URL for the specified service package and Region.
+// The service is the service package's AWS SDK endpoint ID, and the partition and DNS suffix are those of the partition containing the Region.
+// An error is returned if the template refers to the Region, partition or DNS suffix and the Region isn't in any known partition.
+func expandEndpointURLTemplate(template, servicePackageName, region string) (string, error) {
+	if template == "" {
+		return "", nil
+	}
+
+	var partitionID, dnsSuffix string
+	if slices.ContainsFunc([]string{EndpointURLTemplatePlaceholderDNSSuffix, EndpointURLTemplatePlaceholderPartition, EndpointURLTemplatePlaceholderRegion}, func(v string) bool {
+		return strings.Contains(template, v)
+	}) {
+		if region == "" {
+			return "", fmt.Errorf("expanding endpoint URL template (%s) for %s: Region not configured", template, servicePackageName)
+		}
+
+		partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+		if !ok {
+			return "", fmt.Errorf("expanding endpoint URL template (%s) for %s: no known partition contains Region (%s)", template, servicePackageName, region)
+		}
+
+		partitionID, dnsSuffix = partition.ID(), partition.DNSSuffix()
+	}
+
+	return strings.NewReplacer(
+		EndpointURLTemplatePlaceholderDNSSuffix, dnsSuffix,
+		EndpointURLTemplatePlaceholderPartition, partitionID,
+		EndpointURLTemplatePlaceholderRegion, region,
+		EndpointURLTemplatePlaceholderService, names.EndpointID(servicePackageName),
+	).Replace(template), nil
+}
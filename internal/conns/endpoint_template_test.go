@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestValidateEndpointURLTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		template    string
+		expectError bool
+	}{
+		"empty": {
+			template: "",
+		},
+		"no placeholders": {
+			template: "http://localhost:4566",
+		},
+		"all placeholders": {
+			template: "https://{service}.{region}.{partition}.{dns_suffix}",
+		},
+		"unsupported placeholder": {
+			template:    "https://{service}.{account_id}.example.com",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateEndpointURLTemplate(testCase.template)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateEndpointURLTemplate(%q) error = %v, expectError %t", testCase.template, err, want)
+			}
+		})
+	}
+}
+
+func TestExpandEndpointURLTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		template           string
+		servicePackageName string
+		region             string
+		expected           string
+		expectError        bool
+	}{
+		"empty": {
+			template:           "",
+			servicePackageName: "s3",
+			region:             "us-west-2", //lintignore:AWSAT003
+			expected:           "",
+		},
+		"emulator": {
+			template:           "http://localhost:4566",
+			servicePackageName: "s3",
+			region:             "us-west-2", //lintignore:AWSAT003
+			expected:           "http://localhost:4566",
+		},
+		"emulator no Region": {
+			template:           "http://localhost:4566/{service}",
+			servicePackageName: "s3",
+			expected:           "http://localhost:4566/s3",
+		},
+		"standard partition": {
+			template:           "https://{service}.{region}.{dns_suffix}",
+			servicePackageName: "ec2",
+			region:             "us-west-2",                           //lintignore:AWSAT003
+			expected:           "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		"China partition": {
+			template:           "https://{service}.{region}.{dns_suffix}",
+			servicePackageName: "ec2",
+			region:             "cn-north-1",                              //lintignore:AWSAT003
+			expected:           "https://ec2.cn-north-1.amazonaws.com.cn", //lintignore:AWSAT003
+		},
+		"partition placeholder": {
+			template:           "https://{partition}.example.com/{service}",
+			servicePackageName: "sqs",
+			region:             "us-gov-west-1", //lintignore:AWSAT003
+			expected:           "https://aws-us-gov.example.com/sqs",
+		},
+		"endpoint ID": {
+			template:           "https://{service}.{region}.{dns_suffix}",
+			servicePackageName: "cloudwatch",
+			region:             "us-west-2",                                  //lintignore:AWSAT003
+			expected:           "https://monitoring.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		"no Region": {
+			template:           "https://{service}.{region}.{dns_suffix}",
+			servicePackageName: "ec2",
+			expectError:        true,
+		},
+		"unknown Region": {
+			template:           "https://{service}.{region}.{dns_suffix}",
+			servicePackageName: "ec2",
+			region:             "mars-north-1",
+			expectError:        true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandEndpointURLTemplate(testCase.template, testCase.servicePackageName, testCase.region)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("expandEndpointURLTemplate(%q, %q, %q) error = %v, expectError %t", testCase.template, testCase.servicePackageName, testCase.region, err, want)
+			}
+
+			if err == nil {
+				if want := testCase.expected; got != want {
+					t.Errorf("expandEndpointURLTemplate(%q, %q, %q) = %q, want %q", testCase.template, testCase.servicePackageName, testCase.region, got, want)
+				}
+			}
+		})
+	}
+}
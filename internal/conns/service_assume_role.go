@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// ServiceAssumeRole configures the IAM role assumed by the API clients of a single service.
+// If neither WebIdentityToken nor WebIdentityTokenFile is set the role is assumed using the provider's credentials.
+type ServiceAssumeRole struct {
+	Duration             time.Duration
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
+// webIdentityToken is a stscreds.IdentityTokenRetriever for a literal token.
+type webIdentityToken string
+
+func (t webIdentityToken) GetIdentityToken() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// newServiceAWSConfig returns a copy of the specified AWS SDK configuration whose credentials are obtained by assuming the specified role.
+// Credentials are retrieved, and cached, on first use.
+func newServiceAWSConfig(cfg aws.Config, role ServiceAssumeRole, stsEndpoint, stsRegion string) *aws.Config {
+	stsClient := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if stsEndpoint != "" {
+			o.BaseEndpoint = aws.String(stsEndpoint)
+		}
+		if stsRegion != "" {
+			o.Region = stsRegion
+		}
+	})
+
+	var provider aws.CredentialsProvider
+	if role.WebIdentityToken != "" || role.WebIdentityTokenFile != "" {
+		var tokenRetriever stscreds.IdentityTokenRetriever = webIdentityToken(role.WebIdentityToken)
+		if role.WebIdentityTokenFile != "" {
+			tokenRetriever = stscreds.IdentityTokenFile(role.WebIdentityTokenFile)
+		}
+
+		provider = stscreds.NewWebIdentityRoleProvider(stsClient, role.RoleARN, tokenRetriever, func(o *stscreds.WebIdentityRoleOptions) {
+			if role.Duration > 0 {
+				o.Duration = role.Duration
+			}
+			o.RoleSessionName = role.SessionName
+		})
+	} else {
+		provider = stscreds.NewAssumeRoleProvider(stsClient, role.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			if role.Duration > 0 {
+				o.Duration = role.Duration
+			}
+			o.RoleSessionName = role.SessionName
+		})
+	}
+
+	cfg.Credentials = aws.NewCredentialsCache(provider)
+
+	return &cfg
+}
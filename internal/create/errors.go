@@ -10,6 +10,8 @@ import (
 
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tffwdiag "github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -64,7 +66,7 @@ func ProblemStandardMessage(service, action, resource, id string, gotError error
 func AddError(d *fwdiag.Diagnostics, service, action, resource, id string, gotError error) {
 	d.AddError(
 		ProblemStandardMessage(service, action, resource, id, nil),
-		gotError.Error(),
+		tffwdiag.APIErrorDetail(gotError),
 	)
 }
 
@@ -82,10 +84,16 @@ func AppendDiagError(diags diag.Diagnostics, service, action, resource, id strin
 }
 
 func diagError(service, action, resource, id string, gotError error) diag.Diagnostic {
-	return diag.Diagnostic{
+	d := diag.Diagnostic{
 		Severity: diag.Error,
 		Summary:  ProblemStandardMessage(service, action, resource, id, gotError),
 	}
+
+	if m, ok := errs.APIErrorMetadataFromErr(gotError); ok {
+		d.Detail = m.String()
+	}
+
+	return d
 }
 
 func AppendDiagSettingError(diags diag.Diagnostics, service, resource, id, argument string, gotError error) diag.Diagnostics {
@@ -4,6 +4,10 @@
 package errs
 
 import (
+	"fmt"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	smithy "github.com/aws/smithy-go"
 )
 
@@ -14,3 +18,50 @@ func APIError[T ~string](code T, message string) smithy.APIError {
 		Message: message,
 	}
 }
+
+// APIErrorMetadata is the AWS request metadata carried by an AWS API error.
+type APIErrorMetadata struct {
+	Code           string
+	HTTPStatusCode int
+	RequestID      string
+}
+
+// APIErrorMetadataFromErr returns the AWS request metadata carried by err.
+// The second return value is false if err carries no such metadata.
+func APIErrorMetadataFromErr(err error) (APIErrorMetadata, bool) {
+	var m APIErrorMetadata
+
+	if err == nil {
+		return m, false
+	}
+
+	if apiErr, ok := As[smithy.APIError](err); ok {
+		m.Code = apiErr.ErrorCode()
+	}
+
+	if respErr, ok := As[*awshttp.ResponseError](err); ok {
+		m.RequestID = respErr.ServiceRequestID()
+		if respErr.ResponseError != nil && respErr.Response != nil && respErr.Response.Response != nil {
+			m.HTTPStatusCode = respErr.HTTPStatusCode()
+		}
+	}
+
+	return m, m != (APIErrorMetadata{})
+}
+
+// String returns the metadata as one "Key: value" line per non-empty field, suitable for a diagnostic's detail.
+func (m APIErrorMetadata) String() string {
+	var lines []string
+
+	if m.Code != "" {
+		lines = append(lines, "AWS Error Code: "+m.Code)
+	}
+	if m.HTTPStatusCode != 0 {
+		lines = append(lines, fmt.Sprintf("HTTP Status Code: %d", m.HTTPStatusCode))
+	}
+	if m.RequestID != "" {
+		lines = append(lines, "AWS Request ID: "+m.RequestID)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestAPIErrorMetadataFromErr(t *testing.T) {
+	t.Parallel()
+
+	responseErr := &smithy.OperationError{
+		ServiceID:     "KMS",
+		OperationName: "ReplicateKey",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{
+					Response: &http.Response{
+						StatusCode: http.StatusBadRequest,
+					},
+				},
+				Err: errs.APIError("ValidationException", "testing"),
+			},
+			RequestID: "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+		},
+	}
+
+	testCases := map[string]struct {
+		err      error
+		want     errs.APIErrorMetadata
+		wantOK   bool
+		wantText string
+	}{
+		"nil error": {},
+		"not an API error": {
+			err: errors.New("testing"),
+		},
+		"API error without response": {
+			err: errs.APIError("ValidationException", "testing"),
+			want: errs.APIErrorMetadata{
+				Code: "ValidationException",
+			},
+			wantOK:   true,
+			wantText: "AWS Error Code: ValidationException",
+		},
+		"operation error": {
+			err: fmt.Errorf("replicating KMS Key: %w", responseErr),
+			want: errs.APIErrorMetadata{
+				Code:           "ValidationException",
+				HTTPStatusCode: http.StatusBadRequest,
+				RequestID:      "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			},
+			wantOK:   true,
+			wantText: "AWS Error Code: ValidationException\nHTTP Status Code: 400\nAWS Request ID: a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.APIErrorMetadataFromErr(testCase.err)
+
+			if ok != testCase.wantOK {
+				t.Errorf("ok = %t, want %t", ok, testCase.wantOK)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := got.String(), testCase.wantText; got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
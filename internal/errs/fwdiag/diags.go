@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 // DiagnosticsError returns an error containing all Diagnostic with SeverityError
@@ -38,6 +39,20 @@ func DiagnosticString(d diag.Diagnostic) string {
 	return buf.String()
 }
 
+// APIErrorDetail returns err's message for use as a diagnostic's detail.
+// If err is an AWS API error, its request metadata is appended.
+func APIErrorDetail(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if m, ok := errs.APIErrorMetadataFromErr(err); ok {
+		return fmt.Sprintf("%s\n\n%s", err.Error(), m.String())
+	}
+
+	return err.Error()
+}
+
 func NewParsingResourceIDErrorDiagnostic(err error) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		"Parsing Resource ID",
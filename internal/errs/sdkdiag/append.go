@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -17,15 +18,41 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error diagnostic with a formatted summary.
+// If any argument is an AWS API error, its request metadata is added to the diagnostic's detail.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, withAPIErrorDetail(diag.Errorf(format, a...), a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
 }
 
+// AppendFromErr appends an error diagnostic for err.
+// If err is an AWS API error, its request metadata is added to the diagnostic's detail.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, withAPIErrorDetail(diag.FromErr(err), err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+}
+
+// withAPIErrorDetail sets the detail of each diagnostic with no detail to the request metadata of the first AWS API error in a.
+func withAPIErrorDetail(diags diag.Diagnostics, a ...any) diag.Diagnostics {
+	for _, v := range a {
+		err, ok := v.(error)
+		if !ok {
+			continue
+		}
+
+		if m, ok := errs.APIErrorMetadataFromErr(err); ok {
+			for i := range diags {
+				if diags[i].Detail == "" {
+					diags[i].Detail = m.String()
+				}
+			}
+
+			break
+		}
+	}
+
+	return diags
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestAppendErrorf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err         error
+		wantSummary string
+		wantDetail  string
+	}{
+		"not an API error": {
+			err:         errors.New("testing"),
+			wantSummary: "creating Thing (id): testing",
+		},
+		"API error": {
+			err:         errs.APIError("ValidationException", "testing"),
+			wantSummary: "creating Thing (id): api error ValidationException: testing",
+			wantDetail:  "AWS Error Code: ValidationException",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			diags = sdkdiag.AppendErrorf(diags, "creating Thing (%s): %s", "id", testCase.err)
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("len(diags) = %d, want %d", got, want)
+			}
+
+			if got, want := diags[0].Summary, testCase.wantSummary; got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+
+			if got, want := diags[0].Detail, testCase.wantDetail; got != want {
+				t.Errorf("Detail = %q, want %q", got, want)
+			}
+		})
+	}
+}
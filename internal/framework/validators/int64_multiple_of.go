@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// int64MultipleOfValidator validates that an int64 Attribute's value is a multiple of a given number.
+type int64MultipleOfValidator struct {
+	multiple int64
+}
+
+// Description describes the validation in plain text formatting.
+func (validator int64MultipleOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a multiple of %d", validator.multiple)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator int64MultipleOfValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateInt64 performs the validation.
+func (validator int64MultipleOfValidator) ValidateInt64(ctx context.Context, request validator.Int64Request, response *validator.Int64Response) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if v := request.ConfigValue.ValueInt64(); v%validator.multiple != 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			fmt.Sprintf("%d", v),
+		))
+		return
+	}
+}
+
+// Int64MultipleOf returns an int64 validator which ensures that any configured
+// attribute value:
+//
+//   - Is a multiple of the given number.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Int64MultipleOf(multiple int64) validator.Int64 {
+	return int64MultipleOfValidator{
+		multiple: multiple,
+	}
+}
@@ -342,6 +342,7 @@ type ResourceDatum struct {
 	HasExistsFunc               bool
 	ExistsTypeName              string
 	ExistsTakesT                bool
+	UseVCR                      bool
 	FileName                    string
 	Generator                   string
 	idAttrDuplicates            string // TODO: Remove. Still needed for Parameterized Identity
@@ -778,6 +779,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 						d.ExistsTakesT = b
 					}
 				}
+				if attr, ok := args.Keyword["useVCR"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid useVCR value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.UseVCR = b
+					}
+				}
 				if attr, ok := args.Keyword["generator"]; ok {
 					if attr == "false" {
 						generatorSeen = true
@@ -1000,13 +1009,24 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				return
 			}
+			if d.UseVCR {
+				// VCR-enabled tests look up the provider instance state by test name.
+				d.DestroyTakesT = true
+				d.ExistsTakesT = true
+			}
 			if !generatorSeen {
-				d.Generator = "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)"
+				if d.UseVCR {
+					d.Generator = "acctest.RandomWithPrefix(t, acctest.ResourcePrefix)"
+				} else {
+					d.Generator = "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)"
+					d.GoImports = append(d.GoImports,
+						goImport{
+							Path:  "github.com/hashicorp/terraform-plugin-testing/helper/acctest",
+							Alias: "sdkacctest",
+						},
+					)
+				}
 				d.GoImports = append(d.GoImports,
-					goImport{
-						Path:  "github.com/hashicorp/terraform-plugin-testing/helper/acctest",
-						Alias: "sdkacctest",
-					},
 					goImport{
 						Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
 					},
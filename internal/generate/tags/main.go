@@ -42,8 +42,10 @@ var (
 	listTags                 = flag.Bool("ListTags", false, "whether to generate ListTags")
 	listTagsFunc             = flag.String("ListTagsFunc", defaultListTagsFunc, "listTagsFunc")
 	updateTags               = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
+	updateTagsBatched        = flag.Bool("UpdateTagsBatched", false, "whether UpdateTags called from outside the package uses the hand-written batched variant of updateTagsFunc")
 	updateTagsFunc           = flag.String("UpdateTagsFunc", defaultUpdateTagsFunc, "updateTagsFunc")
 	updateTagsNoIgnoreSystem = flag.Bool("UpdateTagsNoIgnoreSystem", false, "whether to not ignore system tags in UpdateTags")
+	updateTagsNoWait         = flag.Bool("UpdateTagsNoWait", false, "whether to not wait for tag propagation in UpdateTags, callers wait using WaitTagsPropagated")
 
 	serviceTagsMap   = flag.Bool("ServiceTagsMap", false, "whether to generate service tags for map")
 	kvtValues        = flag.Bool("KVTValues", false, "Whether KVT string map is of string pointers")
@@ -194,8 +196,10 @@ type TemplateData struct {
 	UntagInNeedTagType         bool
 	UntagInTagsElem            string
 	UntagOp                    string
+	UpdateTagsBatched          bool
 	UpdateTagsFunc             string
 	UpdateTagsIgnoreSystem     bool
+	UpdateTagsWait             bool
 	WaitForPropagation         bool
 	WaitTagsPropagatedFunc     string
 	WaitContinuousOccurence    int
@@ -293,8 +297,10 @@ func main() {
 		UntagInNeedTagType:         *untagInNeedTagType,
 		UntagInTagsElem:            *untagInTagsElem,
 		UntagOp:                    *untagOp,
+		UpdateTagsBatched:          *updateTagsBatched,
 		UpdateTagsFunc:             *updateTagsFunc,
 		UpdateTagsIgnoreSystem:     !*updateTagsNoIgnoreSystem,
+		UpdateTagsWait:             !*updateTagsNoWait,
 		WaitForPropagation:         *waitForPropagation,
 		WaitFuncComparator:         *waitFuncComparator,
 		WaitTagsPropagatedFunc:     *waitTagsPropagatedFunc,
@@ -390,6 +390,7 @@ type ResourceDatum struct {
 	DestroyTakesT                    bool
 	ExistsTypeName                   string
 	ExistsTakesT                     bool
+	UseVCR                           bool
 	FileName                         string
 	Generator                        string
 	NoImport                         bool
@@ -655,6 +656,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 						d.ExistsTakesT = b
 					}
 				}
+				if attr, ok := args.Keyword["useVCR"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid useVCR value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.UseVCR = b
+					}
+				}
 				if attr, ok := args.Keyword["generator"]; ok {
 					if attr == "false" {
 						generatorSeen = true
@@ -850,13 +859,29 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				v.errs = append(v.errs, fmt.Errorf("@Tags specification for %s does not use identifierAttribute. Missing @Testing(tagsIdentifierAttribute) and possibly tagsResourceType", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				return
 			}
+			if d.UseVCR {
+				// VCR records the interactions of a single provider instance.
+				if d.AlternateRegionProvider {
+					v.errs = append(v.errs, fmt.Errorf("useVCR is not supported with altRegionProvider: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					return
+				}
+				// VCR-enabled tests look up the provider instance state by test name.
+				d.DestroyTakesT = true
+				d.ExistsTakesT = true
+			}
 			if !generatorSeen {
-				d.Generator = "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)"
+				if d.UseVCR {
+					d.Generator = "acctest.RandomWithPrefix(t, acctest.ResourcePrefix)"
+				} else {
+					d.Generator = "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)"
+					d.GoImports = append(d.GoImports,
+						goImport{
+							Path:  "github.com/hashicorp/terraform-plugin-testing/helper/acctest",
+							Alias: "sdkacctest",
+						},
+					)
+				}
 				d.GoImports = append(d.GoImports,
-					goImport{
-						Path:  "github.com/hashicorp/terraform-plugin-testing/helper/acctest",
-						Alias: "sdkacctest",
-					},
 					goImport{
 						Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
 					},
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package planpreview collects the AWS API operations that resource changes will make
+// so that they can be surfaced in plan output.
+package planpreview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	// Unknown is the parameter value used for values that are not known until apply.
+	Unknown = "(known after apply)"
+	// Redacted is the parameter value used for sensitive values.
+	Redacted = "(redacted)"
+)
+
+// sensitiveParameterNames are case-insensitive substrings of the names of parameters whose values are never shown.
+var sensitiveParameterNames = []string{
+	"keymaterial",
+	"password",
+	"plaintext",
+	"privatekey",
+	"secret",
+	"token",
+}
+
+// Operation is an AWS API operation that a resource change will make.
+type Operation struct {
+	Service    string         // AWS service, e.g. "kms".
+	Name       string         // API operation name, e.g. "CreateKey".
+	Parameters map[string]any // API operation parameters.
+}
+
+func (o Operation) String() string {
+	if len(o.Parameters) == 0 {
+		return o.Service + ":" + o.Name
+	}
+
+	// encoding/json sorts map keys.
+	parameters, err := json.Marshal(o.Parameters)
+
+	if err != nil {
+		return fmt.Sprintf("%s:%s (parameters: %s)", o.Service, o.Name, err)
+	}
+
+	return fmt.Sprintf("%s:%s %s", o.Service, o.Name, parameters)
+}
+
+// Recorder collects the operations recorded while planning a single resource change.
+type Recorder struct {
+	mu         sync.Mutex
+	operations []Operation
+}
+
+// Operations returns the recorded operations in the order they were recorded.
+func (r *Recorder) Operations() []Operation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Operation(nil), r.operations...)
+}
+
+type recorderKey struct{}
+
+// NewContext returns a context that carries a new operation recorder.
+func NewContext(ctx context.Context) (context.Context, *Recorder) {
+	r := &Recorder{}
+
+	return context.WithValue(ctx, recorderKey{}, r), r
+}
+
+// Enabled returns whether operations recorded in the context are collected.
+// Resources can use this to skip assembling operations that would be discarded.
+func Enabled(ctx context.Context) bool {
+	_, ok := ctx.Value(recorderKey{}).(*Recorder)
+
+	return ok
+}
+
+// Record records an operation in the context's recorder, if any.
+// Values of sensitive parameters, at any depth, are redacted.
+func Record(ctx context.Context, service, name string, parameters map[string]any) {
+	r, ok := ctx.Value(recorderKey{}).(*Recorder)
+	if !ok {
+		return
+	}
+
+	operation := Operation{
+		Service:    service,
+		Name:       name,
+		Parameters: redact(parameters).(map[string]any),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.operations = append(r.operations, operation)
+}
+
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if v == nil {
+			return v
+		}
+
+		redacted := make(map[string]any, len(v))
+		for k, v := range v {
+			if isSensitiveParameterName(k) {
+				redacted[k] = Redacted
+			} else {
+				redacted[k] = redact(v)
+			}
+		}
+		return redacted
+	case []any:
+		redacted := make([]any, len(v))
+		for i, v := range v {
+			redacted[i] = redact(v)
+		}
+		return redacted
+	default:
+		return v
+	}
+}
+
+func isSensitiveParameterName(name string) bool {
+	name = strings.ToLower(name)
+	for _, v := range sensitiveParameterNames {
+		if strings.Contains(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planpreview
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	ctx, recorder := NewContext(context.Background())
+
+	Record(ctx, "rds", "CreateDBInstance", map[string]any{
+		"DBInstanceIdentifier": "test",
+		"MasterUserPassword":   "hunter2",
+		"Tags": []any{
+			map[string]any{"Key": "Name", "Value": "test"},
+		},
+		"Nested": map[string]any{
+			"SecretString": "hunter2",
+		},
+	})
+	Record(ctx, "kms", "EnableKeyRotation", nil)
+
+	got := recorder.Operations()
+	want := []Operation{
+		{
+			Service: "rds",
+			Name:    "CreateDBInstance",
+			Parameters: map[string]any{
+				"DBInstanceIdentifier": "test",
+				"MasterUserPassword":   Redacted,
+				"Tags": []any{
+					map[string]any{"Key": "Name", "Value": "test"},
+				},
+				"Nested": map[string]any{
+					"SecretString": Redacted,
+				},
+			},
+		},
+		{
+			Service:    "kms",
+			Name:       "EnableKeyRotation",
+			Parameters: map[string]any(nil),
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestRecord_disabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	if Enabled(ctx) {
+		t.Error("Enabled = true, want false")
+	}
+
+	// No recorder in the context; must not panic.
+	Record(ctx, "kms", "CreateKey", map[string]any{"Description": "test"})
+}
+
+func TestOperationString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operation Operation
+		want      string
+	}{
+		"no parameters": {
+			operation: Operation{Service: "kms", Name: "EnableKey"},
+			want:      "kms:EnableKey",
+		},
+		"parameters": {
+			operation: Operation{Service: "kms", Name: "CreateKey", Parameters: map[string]any{"MultiRegion": false, "Description": "test"}},
+			want:      `kms:CreateKey {"Description":"test","MultiRegion":false}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.operation.String(); got != testCase.want {
+				t.Errorf("String() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+type mockProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (mockProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	Record(ctx, "kms", "CreateKey", map[string]any{"Description": "test"})
+
+	return &tfprotov5.PlanResourceChangeResponse{}, nil
+}
+
+func TestProviderServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	request := &tfprotov5.PlanResourceChangeRequest{TypeName: "aws_kms_key"}
+
+	for _, enabled := range []bool{false, true} {
+		server := NewProviderServer(func() tfprotov5.ProviderServer { return mockProviderServer{} }, func(context.Context) bool { return enabled })()
+
+		response, err := server.PlanResourceChange(ctx, request)
+
+		if err != nil {
+			t.Fatalf("PlanResourceChange: %s", err)
+		}
+
+		if !enabled {
+			if got := len(response.Diagnostics); got != 0 {
+				t.Errorf("disabled: diagnostics = %d, want 0", got)
+			}
+			continue
+		}
+
+		if got := len(response.Diagnostics); got != 1 {
+			t.Fatalf("enabled: diagnostics = %d, want 1", got)
+		}
+
+		diagnostic := response.Diagnostics[0]
+		if diagnostic.Severity != tfprotov5.DiagnosticSeverityWarning {
+			t.Errorf("severity = %v, want %v", diagnostic.Severity, tfprotov5.DiagnosticSeverityWarning)
+		}
+		if want := `kms:CreateKey {"Description":"test"}`; !strings.Contains(diagnostic.Detail, want) {
+			t.Errorf("detail = %q, want to contain %q", diagnostic.Detail, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planpreview
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ResourceDiffValue returns the planned value of the specified key, or Unknown if the value is not known until apply.
+func ResourceDiffValue(d *schema.ResourceDiff, key string) any {
+	if !d.NewValueKnown(key) {
+		return Unknown
+	}
+
+	switch v := d.Get(key).(type) {
+	case *schema.Set:
+		return v.List()
+	default:
+		return v
+	}
+}
+
+// ResourceDiffTagsChange returns the tags that are added or updated and the keys of the tags that are removed
+// by a planned change to tags_all. ok is false if the new tags are not known until apply.
+func ResourceDiffTagsChange(ctx context.Context, d *schema.ResourceDiff) (updated map[string]string, removed []string, ok bool) {
+	if !d.NewValueKnown(names.AttrTagsAll) {
+		return nil, nil, false
+	}
+
+	o, n := d.GetChange(names.AttrTagsAll)
+	oldTags, newTags := tftags.New(ctx, o), tftags.New(ctx, n)
+
+	return oldTags.Updated(newTags).Map(), oldTags.Removed(newTags).Keys(), true
+}
+
+// ResourceDiffRequiresReplacement returns whether a planned change to an existing resource replaces it,
+// that is whether any of the specified ForceNew keys change.
+// Terraform Plugin SDK v2 then plans the change again as the creation of a new resource, calling CustomizeDiff with an empty ID,
+// so only the deletion of the existing resource should be recorded for it.
+func ResourceDiffRequiresReplacement(d *schema.ResourceDiff, forceNewKeys ...string) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	for _, key := range forceNewKeys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+
+	return false
+}
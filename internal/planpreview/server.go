@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planpreview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// providerServer adds the operations recorded while planning a resource change
+// to the plan response as a warning diagnostic.
+type providerServer struct {
+	tfprotov5.ProviderServer
+
+	enabled func(context.Context) bool
+}
+
+// NewProviderServer wraps a provider server so that, when enabled returns true, plan responses
+// list the AWS API operations recorded by the resource implementations.
+func NewProviderServer(f func() tfprotov5.ProviderServer, enabled func(context.Context) bool) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &providerServer{
+			ProviderServer: f(),
+			enabled:        enabled,
+		}
+	}
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if !s.enabled(ctx) {
+		return s.ProviderServer.PlanResourceChange(ctx, request)
+	}
+
+	ctx, recorder := NewContext(ctx)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	if operations := recorder.Operations(); len(operations) > 0 {
+		response.Diagnostics = append(response.Diagnostics, newOperationsDiagnostic(request.TypeName, operations))
+	}
+
+	return response, nil
+}
+
+func newOperationsDiagnostic(typeName string, operations []Operation) *tfprotov5.Diagnostic {
+	var detail strings.Builder
+
+	fmt.Fprintf(&detail, "Applying this %s change will make the following AWS API operations, in order:\n", typeName)
+	for _, v := range operations {
+		fmt.Fprintf(&detail, "\n  %s", v)
+	}
+	detail.WriteString("\n\nOperations made by waiters and to read the resource after the change are not listed. Sensitive parameters are redacted.")
+
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  "Planned AWS API operations",
+		Detail:   detail.String(),
+	}
+}
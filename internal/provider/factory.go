@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpreview"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
 )
@@ -18,7 +20,7 @@ import (
 // This factory function is suitable for use with the terraform-plugin-go Serve function.
 // The primary (Plugin SDK) provider server is also returned (useful for testing).
 func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
-	primary, err := sdkv2.NewProvider(ctx)
+	primaryServerFactory, primary, err := sdkv2.NewProviderServer(ctx)
 
 	if err != nil {
 		return nil, nil, err
@@ -31,7 +33,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		primaryServerFactory,
 		providerserver.NewProtocol5(secondary),
 	}
 
@@ -41,5 +43,12 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	// Plan output is only enriched once the provider is configured with plan_operations_preview.
+	planOperationsPreview := func(ctx context.Context) bool {
+		c, ok := primary.Meta().(*conns.AWSClient)
+
+		return ok && c.PlanOperationsPreview(ctx)
+	}
+
+	return planpreview.NewProviderServer(muxServer.ProviderServer, planOperationsPreview), primary, nil
 }
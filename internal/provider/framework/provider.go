@@ -135,6 +135,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"kms_prevent_destroy_enforced": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Set this to true to prevent the destruction of KMS keys\nunless `allow_destroy` is set to true on the resource. Specific to the\nAWS Key Management Service (KMS).",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -143,6 +147,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"plan_operations_preview": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Add the AWS API operations that each resource change will make to plan output. Supported by a subset of resources.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -183,6 +191,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Skip the credentials validation via STS API. Used for AWS API implementations that do not have STS available/implemented.",
 			},
+			"skip_ec2_describe_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip caching the results of EC2 Describe API calls made by data sources. By default identical lookups of prefix lists, Availability Zones and instance type offerings share a single API call.",
+			},
 			"skip_metadata_api_check": schema.StringAttribute{
 				Optional:    true,
 				Description: "Skip the AWS Metadata API check. Used for AWS API implementations that do not have a metadata api endpoint.",
@@ -195,6 +207,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Skip requesting the account ID. Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"skip_tag_batching": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip combining identical tagging API calls for multiple resources into a single call. By default EC2 and ELBv2 resources whose tags change in the same way share tagging API calls.",
+			},
 			"sts_region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
@@ -339,6 +355,39 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"service_assume_role": schema.ListNestedBlock{
+				Description: "Configuration block(s) for IAM roles to assume for specific services' API operations, overriding the provider's credentials.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"duration": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"role_arn": schema.StringAttribute{
+							Optional:    true, // Required, but validated during provider configuration as in the `assume_role` block.
+							Description: "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls for the services.",
+						},
+						"services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true, // Required, but validated during provider configuration as in the `assume_role` block.
+							Description: "Services, using the same names as the `endpoints` block, whose API operations use the role.",
+						},
+						"session_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "An identifier for the assumed role session.",
+						},
+						"web_identity_token": schema.StringAttribute{
+							Optional:    true,
+							Description: "OAuth 2.0 access token or OpenID Connect ID token used to assume the role with web identity.",
+						},
+						"web_identity_token_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "File containing an OAuth 2.0 access token or OpenID Connect ID token used to assume the role with web identity.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -1994,6 +1994,10 @@ func endpointsBlock() schema.SetNestedBlock {
 					Optional:    true,
 					Description: "Use this to override the default service endpoint URL",
 				},
+				"url_template": schema.StringAttribute{
+					Optional:    true,
+					Description: "Use this to set the endpoint URL template for services without an endpoint override. Supports the {region}, {service}, {partition} and {dns_suffix} placeholders",
+				},
 			},
 		},
 	}
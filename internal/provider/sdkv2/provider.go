@@ -13,6 +13,7 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -20,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,23 +33,53 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-var (
-	resourceSchemasValidated bool
-)
-
 type sdkProvider struct {
 	provider        *schema.Provider
 	servicePackages iter.Seq2[int, conns.ServicePackage]
+	dataSources     map[string]*lazyResource
+	resources       map[string]*lazyResource
 }
 
 // NewProvider returns a new, initialized Terraform Plugin SDK v2-style provider instance.
+// Every data source and resource is materialized.
 // The provider instance is fully configured once the `ConfigureContextFunc` has been called.
 func NewProvider(ctx context.Context) (*schema.Provider, error) {
+	sdkProvider, err := newProvider(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdkProvider.materialize(); err != nil {
+		return nil, err
+	}
+
+	return sdkProvider.provider, nil
+}
+
+// NewProviderServer returns a new, initialized Terraform Plugin SDK v2-style provider instance
+// and a factory function for terraform-plugin-go protocol v5 servers that serve it.
+// Data sources and resources are materialized by the server on first use, so a provider process only allocates
+// the data sources and resources that are present in configuration or state.
+// The provider advertises that GetProviderSchema is optional, so Terraform requests the full provider schema
+// at most once per command.
+func NewProviderServer(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
+	sdkProvider, err := newProvider(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sdkProvider.newProviderServer, sdkProvider.provider, nil
+}
+
+func newProvider(ctx context.Context) (*sdkProvider, error) {
 	log.Printf("Creating Terraform AWS Provider (SDKv2-style)...")
 
 	sdkProvider := &sdkProvider{
@@ -154,6 +186,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 						"default value is `false`",
 				},
+				"kms_prevent_destroy_enforced": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Set this to true to prevent the destruction of KMS keys\n" +
+						"unless `allow_destroy` is set to true on the resource. Specific to the\n" +
+						"AWS Key Management Service (KMS).",
+				},
 				"max_retries": {
 					Type:     schema.TypeInt,
 					Optional: true,
@@ -167,6 +206,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 						"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 				},
+				"plan_operations_preview": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Add the AWS API operations that each resource change will make to plan output. " +
+						"Supported by a subset of resources.",
+				},
 				"profile": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -206,6 +251,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_assume_role": serviceAssumeRoleSchema(),
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -224,6 +270,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Skip the credentials validation via STS API. " +
 						"Used for AWS API implementations that do not have STS available/implemented.",
 				},
+				"skip_ec2_describe_cache": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Skip caching the results of EC2 Describe API calls made by data sources. " +
+						"By default identical lookups of prefix lists, Availability Zones and instance type offerings share a single API call.",
+				},
 				"skip_metadata_api_check": {
 					Type:         nullable.TypeNullableBool,
 					Optional:     true,
@@ -243,6 +295,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Skip requesting the account ID. " +
 						"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 				},
+				"skip_tag_batching": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Skip combining identical tagging API calls for multiple resources into a single call. " +
+						"By default EC2 and ELBv2 resources whose tags change in the same way share tagging API calls.",
+				},
 				"sts_region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -279,6 +337,8 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 			ResourcesMap:   make(map[string]*schema.Resource),
 		},
 		servicePackages: slices.All(servicePackages(ctx)),
+		dataSources:     make(map[string]*lazyResource),
+		resources:       make(map[string]*lazyResource),
 	}
 
 	sdkProvider.provider.ConfigureContextFunc = sdkProvider.configure
@@ -291,16 +351,6 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 	conns.GlobalMutexKV.Lock(mutexKVKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKVKey)
 
-	// Because we try and share resource schemas as much as possible,
-	// we need to ensure that we only validate the resource schemas once.
-	if !resourceSchemasValidated {
-		if err := sdkProvider.validateResourceSchemas(ctx); err != nil {
-			return nil, err
-		}
-
-		resourceSchemasValidated = true
-	}
-
 	servicePackageMap, err := sdkProvider.initialize(ctx)
 
 	if err != nil {
@@ -319,7 +369,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 	c.SetServicePackages(ctx, servicePackageMap)
 	sdkProvider.provider.SetMeta(c)
 
-	return sdkProvider.provider, nil
+	return sdkProvider, nil
 }
 
 // configure ensures that the provider is fully configured.
@@ -340,14 +390,18 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
+		KMSPreventDestroyEnforced:      d.Get("kms_prevent_destroy_enforced").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		PlanOperationsPreview:          d.Get("plan_operations_preview").(bool),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipEC2DescribeCache:           d.Get("skip_ec2_describe_cache").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipTagBatching:                d.Get("skip_tag_batching").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
@@ -426,6 +480,15 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		})
 	}
 
+	if v, ok := d.GetOk("service_assume_role"); ok {
+		serviceAssumeRoles, dx := expandServiceAssumeRoles(ctx, cty.GetAttrPath("service_assume_role"), v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceAssumeRoles = serviceAssumeRoles
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]any)[0].(map[string]any))
 	} else {
@@ -440,6 +503,13 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.Endpoints = endpoints
 
+	endpointURLTemplate, dx := expandEndpointURLTemplate(ctx, v.(*schema.Set).List())
+	diags = append(diags, dx...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	config.EndpointURLTemplate = endpointURLTemplate
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -502,6 +572,8 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 }
 
 // initialize is called from `New` to perform any Terraform Plugin SDK v2-style initialization.
+// Only service package metadata is read: each data source and resource is registered as an empty placeholder
+// that is materialized by calling its factory on first use.
 func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServicePackage, error) {
 	log.Printf("Initializing Terraform AWS Provider (SDKv2-style)...")
 
@@ -520,316 +592,369 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				continue
 			}
 
-			r := v.Factory()
+			r := new(schema.Resource)
+			p.provider.DataSourcesMap[typeName] = r
+			p.dataSources[typeName] = newLazyResource(r, func() (*schema.Resource, error) {
+				return newDataSource(servicePackageName, v)
+			})
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			typeName := v.TypeName
 
-			// Ensure that the correct CRUD handler variants are used.
-			if r.Read != nil || r.ReadContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Read handler variant: %s data source", typeName))
+			if _, ok := p.provider.ResourcesMap[typeName]; ok {
+				errs = append(errs, fmt.Errorf("duplicate resource: %s", typeName))
 				continue
 			}
 
-			var isRegionOverrideEnabled bool
-			if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
-				isRegionOverrideEnabled = true
+			r := new(schema.Resource)
+			p.provider.ResourcesMap[typeName] = r
+			p.resources[typeName] = newLazyResource(r, func() (*schema.Resource, error) {
+				return newResource(servicePackageName, v)
+			})
+		}
+	}
+
+	return servicePackageMap, errors.Join(errs...)
+}
+
+// materialize materializes every data source and resource.
+func (p *sdkProvider) materialize() error {
+	var errs []error
+
+	for _, m := range []map[string]*lazyResource{p.dataSources, p.resources} {
+		for _, v := range m {
+			if err := v.materialize(); err != nil {
+				errs = append(errs, err)
 			}
+		}
+	}
 
-			var interceptors interceptorInvocations
+	return errors.Join(errs...)
+}
 
-			if isRegionOverrideEnabled {
-				v := v.Region.Value()
-				s := r.SchemaMap()
+// lazyResource is a data source or resource that is registered with the provider as a placeholder
+// and materialized at most once.
+type lazyResource struct {
+	placeholder *schema.Resource
+	factory     func() (*schema.Resource, error)
+	once        sync.Once
+	err         error
+}
 
-				if _, ok := s[names.AttrRegion]; !ok {
-					// Inject a top-level "region" attribute.
-					regionSchema := attribute.Region()
+func newLazyResource(placeholder *schema.Resource, factory func() (*schema.Resource, error)) *lazyResource {
+	return &lazyResource{
+		placeholder: placeholder,
+		factory:     factory,
+	}
+}
 
-					if f := r.SchemaFunc; f != nil {
-						r.SchemaFunc = func() map[string]*schema.Schema {
-							s := f()
-							s[names.AttrRegion] = regionSchema
-							return s
-						}
-					} else {
-						r.Schema[names.AttrRegion] = regionSchema
-					}
-				}
+// materialize replaces the placeholder's contents with the data source or resource returned by the factory.
+func (l *lazyResource) materialize() error {
+	l.once.Do(func() {
+		// Data sources and resources may share schemas, so don't materialize them concurrently.
+		const (
+			mutexKVKey = "provider.New"
+		)
+		conns.GlobalMutexKV.Lock(mutexKVKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKVKey)
 
-				if v.IsValidateOverrideInPartition {
-					interceptors = append(interceptors, interceptorInvocation{
-						when:        Before,
-						why:         Read,
-						interceptor: dataSourceValidateRegion(),
-					})
-				}
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        After,
-					why:         Read,
-					interceptor: setRegionInState(),
-				})
-			}
+		r, err := l.factory()
 
-			if !tfunique.IsHandleNil(v.Tags) {
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before | After,
-					why:         Read,
-					interceptor: dataSourceTransparentTagging(v.Tags),
-				})
-			}
+		if err != nil {
+			l.err = err
+			return
+		}
 
-			opts := wrappedDataSourceOptions{
-				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+		*l.placeholder = *r
+	})
 
-					if isRegionOverrideEnabled && getAttribute != nil {
-						if region, ok := getAttribute(names.AttrRegion); ok {
-							overrideRegion = region.(string)
-						}
-					}
+	return l.err
+}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
-					if c, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
-						ctx = c.RegisterLogger(ctx)
-					}
+// newDataSource returns a new, wrapped Terraform Plugin SDK v2-style data source.
+func newDataSource(servicePackageName string, v *inttypes.ServicePackageSDKDataSource) (*schema.Resource, error) {
+	typeName := v.TypeName
+	r := v.Factory()
 
-					return ctx, nil
-				},
-				interceptors: interceptors,
-				typeName:     typeName,
-			}
-			wrapDataSource(r, opts)
-			p.provider.DataSourcesMap[typeName] = r
-		}
+	// Ensure that the correct CRUD handler variants are used.
+	if r.Read != nil || r.ReadContext != nil {
+		return nil, fmt.Errorf("incorrect Read handler variant: %s data source", typeName)
+	}
 
-		for _, resource := range sp.SDKResources(ctx) {
-			typeName := resource.TypeName
+	// The data source is being materialized for use, so allocate its schema now.
+	s := r.SchemaMap()
+	r.Schema, r.SchemaFunc = s, nil
 
-			if _, ok := p.provider.ResourcesMap[typeName]; ok {
-				errs = append(errs, fmt.Errorf("duplicate resource: %s", typeName))
-				continue
-			}
+	if err := validateDataSourceSchema(v, s); err != nil {
+		return nil, err
+	}
 
-			r := resource.Factory()
+	var isRegionOverrideEnabled bool
+	if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		isRegionOverrideEnabled = true
+	}
 
-			// Ensure that the correct CRUD handler variants are used.
-			if r.Create != nil || r.CreateContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Create handler variant: %s resource", typeName))
-				continue
-			}
-			if r.Read != nil || r.ReadContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Read handler variant: %s resource", typeName))
-				continue
-			}
-			if r.Update != nil || r.UpdateContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Update handler variant: %s resource", typeName))
-				continue
-			}
-			if r.Delete != nil || r.DeleteContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Delete handler variant: %s resource", typeName))
-				continue
-			}
+	var interceptors interceptorInvocations
 
-			var isRegionOverrideEnabled bool
-			if v := resource.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
-				isRegionOverrideEnabled = true
-			}
+	if isRegionOverrideEnabled {
+		v := v.Region.Value()
 
-			var interceptors interceptorInvocations
+		if _, ok := s[names.AttrRegion]; !ok {
+			// Inject a top-level "region" attribute.
+			regionSchema := attribute.Region()
 
-			if isRegionOverrideEnabled {
-				v := resource.Region.Value()
-				s := r.SchemaMap()
+			s[names.AttrRegion] = regionSchema
+		}
 
-				if _, ok := s[names.AttrRegion]; !ok {
-					// Inject a top-level "region" attribute.
-					regionSchema := attribute.Region()
+		if v.IsValidateOverrideInPartition {
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         Read,
+				interceptor: dataSourceValidateRegion(),
+			})
+		}
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        After,
+			why:         Read,
+			interceptor: setRegionInState(),
+		})
+	}
 
-					// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
-					if r.UpdateWithoutTimeout == nil {
-						r.UpdateWithoutTimeout = schema.NoopContext
-					}
+	if !tfunique.IsHandleNil(v.Tags) {
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before | After,
+			why:         Read,
+			interceptor: dataSourceTransparentTagging(v.Tags),
+		})
+	}
 
-					if f := r.SchemaFunc; f != nil {
-						r.SchemaFunc = func() map[string]*schema.Schema {
-							s := f()
-							s[names.AttrRegion] = regionSchema
-							return s
-						}
-					} else {
-						r.Schema[names.AttrRegion] = regionSchema
-					}
-				}
+	opts := wrappedDataSourceOptions{
+		bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
+			var overrideRegion string
 
-				if v.IsValidateOverrideInPartition {
-					interceptors = append(interceptors, interceptorInvocation{
-						when:        Before,
-						why:         CustomizeDiff,
-						interceptor: resourceValidateRegion(),
-					})
-				}
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before,
-					why:         CustomizeDiff,
-					interceptor: defaultRegion(),
-				})
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        After,
-					why:         Read,
-					interceptor: setRegionInState(),
-				})
-				// We can't just set the injected "region" attribute to ForceNew because if
-				// a plan is run with '-refresh=false', then after provider v5 to v6 upgrade
-				// the region attribute is not set in state and its value shows a change.
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before,
-					why:         CustomizeDiff,
-					interceptor: forceNewIfRegionChanges(),
-				})
-				if resource.Identity.HasInherentRegion() {
-					interceptors = append(interceptors, resourceImportRegionNoDefault())
-				} else {
-					interceptors = append(interceptors, resourceImportRegion())
+			if isRegionOverrideEnabled && getAttribute != nil {
+				if region, ok := getAttribute(names.AttrRegion); ok {
+					overrideRegion = region.(string)
 				}
 			}
 
-			if !tfunique.IsHandleNil(resource.Tags) {
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before | After | Finally,
-					why:         Create | Read | Update,
-					interceptor: resourceTransparentTagging(resource.Tags),
-				})
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before,
-					why:         CustomizeDiff,
-					interceptor: setTagsAll(),
-				})
+			ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+			if c, ok := meta.(*conns.AWSClient); ok {
+				ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+				ctx = c.RegisterLogger(ctx)
 			}
 
-			if len(resource.Identity.Attributes) > 0 {
-				r.Identity = newResourceIdentity(resource.Identity)
+			return ctx, nil
+		},
+		interceptors: interceptors,
+		typeName:     typeName,
+	}
+	wrapDataSource(r, opts)
 
-				if resource.Identity.IsMutable {
-					r.ResourceBehavior.MutableIdentity = true
-				}
+	return r, nil
+}
 
-				interceptors = append(interceptors, newIdentityInterceptor(resource.Identity.Attributes))
-			}
+// newResource returns a new, wrapped Terraform Plugin SDK v2-style resource.
+func newResource(servicePackageName string, resource *inttypes.ServicePackageSDKResource) (*schema.Resource, error) {
+	typeName := resource.TypeName
+	r := resource.Factory()
 
-			if resource.Import.WrappedImport {
-				if r.Importer != nil && r.Importer.StateContext != nil {
-					errs = append(errs, fmt.Errorf("resource type %s: uses WrappedImport but defines an import function", typeName))
-					continue
-				}
+	// Ensure that the correct CRUD handler variants are used.
+	if r.Create != nil || r.CreateContext != nil {
+		return nil, fmt.Errorf("incorrect Create handler variant: %s resource", typeName)
+	}
+	if r.Read != nil || r.ReadContext != nil {
+		return nil, fmt.Errorf("incorrect Read handler variant: %s resource", typeName)
+	}
+	if r.Update != nil || r.UpdateContext != nil {
+		return nil, fmt.Errorf("incorrect Update handler variant: %s resource", typeName)
+	}
+	if r.Delete != nil || r.DeleteContext != nil {
+		return nil, fmt.Errorf("incorrect Delete handler variant: %s resource", typeName)
+	}
 
-				if resource.Identity.IsARN {
-					r.Importer = arnIdentityResourceImporter(resource.Identity)
-				} else if resource.Identity.IsSingleton {
-					r.Importer = singletonIdentityResourceImporter(resource.Identity)
-				} else {
-					r.Importer = newParameterizedIdentityImporter(resource.Identity, &resource.Import)
-				}
-			}
+	// The resource is being materialized for use, so allocate its schema now.
+	s := r.SchemaMap()
+	r.Schema, r.SchemaFunc = s, nil
 
-			opts := wrappedResourceOptions{
-				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+	if err := validateResourceSchema(resource, s); err != nil {
+		return nil, err
+	}
 
-					if isRegionOverrideEnabled && getAttribute != nil {
-						if region, ok := getAttribute(names.AttrRegion); ok {
-							overrideRegion = region.(string)
-						}
-					}
+	var isRegionOverrideEnabled bool
+	if v := resource.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		isRegionOverrideEnabled = true
+	}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, overrideRegion)
-					if c, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
-						ctx = c.RegisterLogger(ctx)
-					}
+	var interceptors interceptorInvocations
 
-					return ctx, nil
-				},
-				interceptors: interceptors,
-				typeName:     typeName,
+	if isRegionOverrideEnabled {
+		v := resource.Region.Value()
+
+		if _, ok := s[names.AttrRegion]; !ok {
+			// Inject a top-level "region" attribute.
+			regionSchema := attribute.Region()
+
+			// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
+			if r.UpdateWithoutTimeout == nil {
+				r.UpdateWithoutTimeout = schema.NoopContext
 			}
-			wrapResource(r, opts)
-			p.provider.ResourcesMap[typeName] = r
+
+			s[names.AttrRegion] = regionSchema
+		}
+
+		if v.IsValidateOverrideInPartition {
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         CustomizeDiff,
+				interceptor: resourceValidateRegion(),
+			})
+		}
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before,
+			why:         CustomizeDiff,
+			interceptor: defaultRegion(),
+		})
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        After,
+			why:         Read,
+			interceptor: setRegionInState(),
+		})
+		// We can't just set the injected "region" attribute to ForceNew because if
+		// a plan is run with '-refresh=false', then after provider v5 to v6 upgrade
+		// the region attribute is not set in state and its value shows a change.
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before,
+			why:         CustomizeDiff,
+			interceptor: forceNewIfRegionChanges(),
+		})
+		if resource.Identity.HasInherentRegion() {
+			interceptors = append(interceptors, resourceImportRegionNoDefault())
+		} else {
+			interceptors = append(interceptors, resourceImportRegion())
 		}
 	}
 
-	return servicePackageMap, errors.Join(errs...)
-}
+	if !tfunique.IsHandleNil(resource.Tags) {
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before | After | Finally,
+			why:         Create | Read | Update,
+			interceptor: resourceTransparentTagging(resource.Tags),
+		})
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before,
+			why:         CustomizeDiff,
+			interceptor: setTagsAll(),
+		})
+	}
 
-// validateResourceSchemas is called from `New` to validate Terraform Plugin SDK v2-style resource schemas.
-func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
-	var errs []error
+	if len(resource.Identity.Attributes) > 0 {
+		r.Identity = newResourceIdentity(resource.Identity)
 
-	for _, sp := range p.servicePackages {
-		for _, v := range sp.SDKDataSources(ctx) {
-			typeName := v.TypeName
-			r := v.Factory()
-			s := r.SchemaMap()
+		if resource.Identity.IsMutable {
+			r.ResourceBehavior.MutableIdentity = true
+		}
+
+		interceptors = append(interceptors, newIdentityInterceptor(resource.Identity.Attributes))
+	}
+
+	if resource.Import.WrappedImport {
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			return nil, fmt.Errorf("resource type %s: uses WrappedImport but defines an import function", typeName)
+		}
+
+		if resource.Identity.IsARN {
+			r.Importer = arnIdentityResourceImporter(resource.Identity)
+		} else if resource.Identity.IsSingleton {
+			r.Importer = singletonIdentityResourceImporter(resource.Identity)
+		} else {
+			r.Importer = newParameterizedIdentityImporter(resource.Identity, &resource.Import)
+		}
+	}
+
+	opts := wrappedResourceOptions{
+		// bootstrapContext is run on all wrapped methods before any interceptors.
+		bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, error) {
+			var overrideRegion string
 
-			if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
-				if _, ok := s[names.AttrRegion]; ok {
-					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrRegion, typeName))
-					continue
+			if isRegionOverrideEnabled && getAttribute != nil {
+				if region, ok := getAttribute(names.AttrRegion); ok {
+					overrideRegion = region.(string)
 				}
 			}
 
-			if !tfunique.IsHandleNil(v.Tags) {
-				// The data source has opted in to transparent tagging.
-				// Ensure that the schema look OK.
-				if v, ok := s[names.AttrTags]; ok {
-					if !v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s data source", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s data source", names.AttrTags, typeName))
-					continue
-				}
+			ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, overrideRegion)
+			if c, ok := meta.(*conns.AWSClient); ok {
+				ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+				ctx = c.RegisterLogger(ctx)
 			}
-		}
 
-		for _, v := range sp.SDKResources(ctx) {
-			typeName := v.TypeName
-			r := v.Factory()
-			s := r.SchemaMap()
+			return ctx, nil
+		},
+		interceptors: interceptors,
+		typeName:     typeName,
+	}
+	wrapResource(r, opts)
 
-			if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
-				if _, ok := s[names.AttrRegion]; ok {
-					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrRegion, typeName))
-					continue
-				}
+	return r, nil
+}
+
+// validateDataSourceSchema validates a Terraform Plugin SDK v2-style data source schema.
+func validateDataSourceSchema(v *inttypes.ServicePackageSDKDataSource, s map[string]*schema.Schema) error {
+	typeName := v.TypeName
+
+	if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		if _, ok := s[names.AttrRegion]; ok {
+			return fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrRegion, typeName)
+		}
+	}
+
+	if !tfunique.IsHandleNil(v.Tags) {
+		// The data source has opted in to transparent tagging.
+		// Ensure that the schema look OK.
+		if v, ok := s[names.AttrTags]; ok {
+			if !v.Computed {
+				return fmt.Errorf("`%s` attribute must be Computed: %s data source", names.AttrTags, typeName)
 			}
+		} else {
+			return fmt.Errorf("no `%s` attribute defined in schema: %s data source", names.AttrTags, typeName)
+		}
+	}
 
-			if !tfunique.IsHandleNil(v.Tags) {
-				// The resource has opted in to transparent tagging.
-				// Ensure that the schema look OK.
-				if v, ok := s[names.AttrTags]; ok {
-					if v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s resource", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s resource", names.AttrTags, typeName))
-					continue
-				}
-				if v, ok := s[names.AttrTagsAll]; ok {
-					if !v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s resource", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s resource", names.AttrTagsAll, typeName))
-					continue
-				}
+	return nil
+}
+
+// validateResourceSchema validates a Terraform Plugin SDK v2-style resource schema.
+func validateResourceSchema(v *inttypes.ServicePackageSDKResource, s map[string]*schema.Schema) error {
+	typeName := v.TypeName
+
+	if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		if _, ok := s[names.AttrRegion]; ok {
+			return fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrRegion, typeName)
+		}
+	}
+
+	if !tfunique.IsHandleNil(v.Tags) {
+		// The resource has opted in to transparent tagging.
+		// Ensure that the schema look OK.
+		if v, ok := s[names.AttrTags]; ok {
+			if v.Computed {
+				return fmt.Errorf("`%s` attribute cannot be Computed: %s resource", names.AttrTags, typeName)
 			}
+		} else {
+			return fmt.Errorf("no `%s` attribute defined in schema: %s resource", names.AttrTags, typeName)
+		}
+		if v, ok := s[names.AttrTagsAll]; ok {
+			if !v.Computed {
+				return fmt.Errorf("`%s` attribute must be Computed: %s resource", names.AttrTags, typeName)
+			}
+		} else {
+			return fmt.Errorf("no `%s` attribute defined in schema: %s resource", names.AttrTagsAll, typeName)
 		}
 	}
 
-	return errors.Join(errs...)
+	return nil
 }
 
 func assumeRoleSchema() *schema.Schema {
@@ -959,6 +1084,53 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func serviceAssumeRoleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block(s) for IAM roles to assume for specific services' API operations, overriding the provider's credentials.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Optional:     true, // Required, but validated during provider configuration as in the `assume_role` block.
+					Description:  "Amazon Resource Name (ARN) of an IAM Role to assume prior to making API calls for the services.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"services": {
+					Type:        schema.TypeSet,
+					Optional:    true, // Required, but validated during provider configuration as in the `assume_role` block.
+					Description: "Services, using the same names as the `endpoints` block, whose API operations use the role.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"session_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "An identifier for the assumed role session.",
+					ValidateFunc: validAssumeRoleSessionName,
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token used to assume the role with web identity.",
+					ValidateFunc: validation.StringLenBetween(4, 20000),
+				},
+				"web_identity_token_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "File containing an OAuth 2.0 access token or OpenID Connect ID token used to assume the role with web identity.",
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
 	result = make([]awsbase.AssumeRole, len(tfList))
 
@@ -1068,6 +1240,100 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+// expandServiceAssumeRoles returns the roles to assume keyed by service package name.
+func expandServiceAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (map[string]conns.ServiceAssumeRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make(map[string]conns.ServiceAssumeRole)
+
+	for i, v := range tfList {
+		path := path.IndexInt(i)
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			return nil, append(diags, errs.NewAttributeRequiredError(path, "role_arn"))
+		}
+
+		var role conns.ServiceAssumeRole
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			role.RoleARN = v
+		} else {
+			return nil, append(diags, errs.NewAttributeRequiredError(path, "role_arn"))
+		}
+
+		if v, ok := tfMap["duration"].(string); ok && v != "" {
+			duration, _ := time.ParseDuration(v)
+			role.Duration = duration
+		}
+
+		if v, ok := tfMap["session_name"].(string); ok && v != "" {
+			role.SessionName = v
+		}
+
+		if v, ok := tfMap["web_identity_token"].(string); ok && v != "" {
+			role.WebIdentityToken = v
+		}
+
+		if v, ok := tfMap["web_identity_token_file"].(string); ok && v != "" {
+			if role.WebIdentityToken != "" {
+				return nil, append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("web_identity_token_file"), "Only one of %q and %q can be specified.", "web_identity_token", "web_identity_token_file"))
+			}
+			role.WebIdentityTokenFile = v
+		}
+
+		services, ok := tfMap["services"].(*schema.Set)
+		if !ok || services.Len() == 0 {
+			return nil, append(diags, errs.NewAttributeRequiredError(path, "services"))
+		}
+
+		for _, service := range flex.ExpandStringValueSet(services) {
+			pkg, err := names.ProviderPackageForAlias(service)
+			if err != nil {
+				return nil, append(diags, errs.NewInvalidValueAttributeError(path.GetAttr("services"), err.Error()))
+			}
+
+			if _, ok := result[pkg]; ok {
+				return nil, append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr("services"), "Service %q is configured in more than one \"service_assume_role\" block.", service))
+			}
+
+			result[pkg] = role
+
+			tflog.Info(ctx, "service_assume_role configuration set", map[string]any{
+				"tf_aws.service_assume_role.service":      pkg,
+				"tf_aws.service_assume_role.role_arn":     role.RoleARN,
+				"tf_aws.service_assume_role.session_name": role.SessionName,
+			})
+		}
+	}
+
+	return result, diags
+}
+
+// expandEndpointURLTemplate returns the endpoint URL template from the `endpoints` block.
+func expandEndpointURLTemplate(ctx context.Context, tfList []any) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["url_template"].(string); ok && v != "" {
+			if err := conns.ValidateEndpointURLTemplate(v); err != nil {
+				return "", append(diags, errs.NewInvalidValueAttributeError(cty.GetAttrPath("endpoints").IndexInt(i).GetAttr("url_template"), err.Error()))
+			}
+
+			tflog.Info(ctx, "endpoints url_template configuration set", map[string]any{
+				"tf_aws.endpoints.url_template": v,
+			})
+
+			return v, diags
+		}
+	}
+
+	return "", diags
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
@@ -2300,6 +2300,11 @@ func endpointsSchema() *schema.Schema {
 					Optional:    true,
 					Description: "Use this to override the default service endpoint URL",
 				},
+				"url_template": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Use this to set the endpoint URL template for services without an endpoint override. Supports the {region}, {service}, {partition} and {dns_suffix} placeholders",
+				},
 			},
 		},
 	}
@@ -2340,6 +2345,9 @@ func expandEndpoints(_ context.Context, tfList []any) (map[string]string, diag.D
 				continue
 			}
 			switch k {
+			case "url_template":
+				// The endpoint URL template is not a service endpoint.
+				seen[k] = true
 			case "amp", "prometheus", "prometheusservice":
 				const pkg = "amp"
 				attrs := []string{"amp", "prometheus", "prometheusservice"}
@@ -7,11 +7,15 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -28,6 +32,17 @@ func BenchmarkSDKProviderInitialization(b *testing.B) {
 	}
 }
 
+// go test -bench=BenchmarkSDKProviderServerInitialization -benchmem -run=Bench -v ./internal/provider/sdkv2
+func BenchmarkSDKProviderServerInitialization(b *testing.B) {
+	ctx := b.Context()
+	for b.Loop() {
+		_, _, err := NewProviderServer(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestProvider(t *testing.T) {
 	t.Parallel()
 
@@ -45,6 +60,57 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderServerMaterialization(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	f, p, err := NewProviderServer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range []map[string]*schema.Resource{p.DataSourcesMap, p.ResourcesMap} {
+		for typeName, r := range m {
+			if r.SchemaMap() != nil || r.ReadWithoutTimeout != nil {
+				t.Errorf("%s: materialized on initialization", typeName)
+			}
+		}
+	}
+
+	s := f().(*providerServer)
+
+	if diags := s.materializeResource("aws_vpc"); diags != nil {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if r := p.ResourcesMap["aws_vpc"]; r.ReadWithoutTimeout == nil {
+		t.Error("aws_vpc: not materialized")
+	} else if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+		t.Errorf("aws_vpc: no `%s` attribute injected", names.AttrRegion)
+	}
+
+	if r := p.ResourcesMap["aws_subnet"]; r.ReadWithoutTimeout != nil {
+		t.Error("aws_subnet: materialized on first use of aws_vpc")
+	}
+
+	response, err := s.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(response.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", response.Diagnostics)
+	}
+
+	for _, m := range []map[string]*schema.Resource{p.DataSourcesMap, p.ResourcesMap} {
+		for typeName, r := range m {
+			if len(r.Schema) == 0 || r.SchemaFunc != nil {
+				t.Errorf("%s: Schema is not materialized", typeName)
+			}
+		}
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -425,6 +491,134 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	path := cty.GetAttrPath("service_assume_role")
+	const roleARN = "arn:aws:iam::123456789012:role/KeyManager" //lintignore:AWSAT005
+
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.ServiceAssumeRole
+		expectedDiags diag.Diagnostics
+	}{
+		"assume role": {
+			tfList: []any{
+				map[string]any{
+					"duration":     "1h",
+					"role_arn":     roleARN,
+					"services":     schema.NewSet(schema.HashString, []any{"kms", "secretsmanager"}),
+					"session_name": "kms",
+				},
+			},
+			expected: map[string]conns.ServiceAssumeRole{
+				names.KMS: {
+					Duration:    time.Hour,
+					RoleARN:     roleARN,
+					SessionName: "kms",
+				},
+				names.SecretsManager: {
+					Duration:    time.Hour,
+					RoleARN:     roleARN,
+					SessionName: "kms",
+				},
+			},
+		},
+		"web identity": {
+			tfList: []any{
+				map[string]any{
+					"role_arn":                roleARN,
+					"services":                schema.NewSet(schema.HashString, []any{"kms"}),
+					"web_identity_token_file": "/tmp/token",
+				},
+			},
+			expected: map[string]conns.ServiceAssumeRole{
+				names.KMS: {
+					RoleARN:              roleARN,
+					WebIdentityTokenFile: "/tmp/token",
+				},
+			},
+		},
+		"service alias": {
+			tfList: []any{
+				map[string]any{
+					"role_arn": roleARN,
+					"services": schema.NewSet(schema.HashString, []any{"transcribeservice"}),
+				},
+			},
+			expected: map[string]conns.ServiceAssumeRole{
+				names.Transcribe: {
+					RoleARN: roleARN,
+				},
+			},
+		},
+		"no role_arn": {
+			tfList: []any{
+				map[string]any{
+					"services": schema.NewSet(schema.HashString, []any{"kms"}),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeRequiredError(path.IndexInt(0), "role_arn"),
+			},
+		},
+		"no services": {
+			tfList: []any{
+				map[string]any{
+					"role_arn": roleARN,
+					"services": schema.NewSet(schema.HashString, []any{}),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeRequiredError(path.IndexInt(0), "services"),
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{
+					"role_arn": roleARN,
+					"services": schema.NewSet(schema.HashString, []any{"notaservice"}),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexInt(0).GetAttr("services"), "unable to find service for service alias notaservice"),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"role_arn": roleARN,
+					"services": schema.NewSet(schema.HashString, []any{"kms"}),
+				},
+				map[string]any{
+					"role_arn": roleARN,
+					"services": schema.NewSet(schema.HashString, []any{"kms"}),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeError(path.IndexInt(1).GetAttr("services"), `Service "kms" is configured in more than one "service_assume_role" block.`),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := expandServiceAssumeRoles(ctx, path, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// providerServer materializes data sources and resources before the Terraform Plugin SDK v2 provider server
+// handles a request that uses them.
+type providerServer struct {
+	tfprotov5.ProviderServer
+
+	provider *sdkProvider
+}
+
+func (p *sdkProvider) newProviderServer() tfprotov5.ProviderServer {
+	return &providerServer{
+		ProviderServer: p.provider.GRPCProvider(),
+		provider:       p,
+	}
+}
+
+func (s *providerServer) GetProviderSchema(ctx context.Context, request *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	if diags := s.materialize(); diags != nil {
+		return &tfprotov5.GetProviderSchemaResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.GetProviderSchema(ctx, request)
+}
+
+func (s *providerServer) GetResourceIdentitySchemas(ctx context.Context, request *tfprotov5.GetResourceIdentitySchemasRequest) (*tfprotov5.GetResourceIdentitySchemasResponse, error) {
+	if diags := s.materialize(); diags != nil {
+		return &tfprotov5.GetResourceIdentitySchemasResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.GetResourceIdentitySchemas(ctx, request)
+}
+
+func (s *providerServer) ValidateResourceTypeConfig(ctx context.Context, request *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	if diags := s.materializeResource(request.TypeName); diags != nil {
+		return &tfprotov5.ValidateResourceTypeConfigResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.ValidateResourceTypeConfig(ctx, request)
+}
+
+func (s *providerServer) UpgradeResourceState(ctx context.Context, request *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	if diags := s.materializeResource(request.TypeName); diags != nil {
+		return &tfprotov5.UpgradeResourceStateResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.UpgradeResourceState(ctx, request)
+}
+
+func (s *providerServer) ReadResource(ctx context.Context, request *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if diags := s.materializeResource(request.TypeName); diags != nil {
+		return &tfprotov5.ReadResourceResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.ReadResource(ctx, request)
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if diags := s.materializeResource(request.TypeName); diags != nil {
+		return &tfprotov5.PlanResourceChangeResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.PlanResourceChange(ctx, request)
+}
+
+func (s *providerServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if diags := s.materializeResource(request.TypeName); diags != nil {
+		return &tfprotov5.ApplyResourceChangeResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.ApplyResourceChange(ctx, request)
+}
+
+func (s *providerServer) ImportResourceState(ctx context.Context, request *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	if diags := s.materializeResource(request.TypeName); diags != nil {
+		return &tfprotov5.ImportResourceStateResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.ImportResourceState(ctx, request)
+}
+
+func (s *providerServer) UpgradeResourceIdentity(ctx context.Context, request *tfprotov5.UpgradeResourceIdentityRequest) (*tfprotov5.UpgradeResourceIdentityResponse, error) {
+	if diags := s.materializeResource(request.TypeName); diags != nil {
+		return &tfprotov5.UpgradeResourceIdentityResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.UpgradeResourceIdentity(ctx, request)
+}
+
+func (s *providerServer) ValidateDataSourceConfig(ctx context.Context, request *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	if diags := s.materializeDataSource(request.TypeName); diags != nil {
+		return &tfprotov5.ValidateDataSourceConfigResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.ValidateDataSourceConfig(ctx, request)
+}
+
+func (s *providerServer) ReadDataSource(ctx context.Context, request *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	if diags := s.materializeDataSource(request.TypeName); diags != nil {
+		return &tfprotov5.ReadDataSourceResponse{Diagnostics: diags}, nil
+	}
+
+	return s.ProviderServer.ReadDataSource(ctx, request)
+}
+
+// materialize materializes every data source and resource.
+func (s *providerServer) materialize() []*tfprotov5.Diagnostic {
+	return newMaterializeDiagnostics(s.provider.materialize())
+}
+
+// materializeDataSource materializes the specified data source.
+// Unknown data source types are left to the Terraform Plugin SDK v2 provider server to report.
+func (s *providerServer) materializeDataSource(typeName string) []*tfprotov5.Diagnostic {
+	if v, ok := s.provider.dataSources[typeName]; ok {
+		return newMaterializeDiagnostics(v.materialize())
+	}
+
+	return nil
+}
+
+// materializeResource materializes the specified resource.
+// Unknown resource types are left to the Terraform Plugin SDK v2 provider server to report.
+func (s *providerServer) materializeResource(typeName string) []*tfprotov5.Diagnostic {
+	if v, ok := s.provider.resources[typeName]; ok {
+		return newMaterializeDiagnostics(v.materialize())
+	}
+
+	return nil
+}
+
+func newMaterializeDiagnostics(err error) []*tfprotov5.Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	return []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Provider Initialization Error",
+			Detail:   fmt.Sprintf("Initializing the Terraform AWS Provider: %s", err),
+		},
+	}
+}
@@ -320,3 +320,15 @@ func preSharedKeyStorageType_Values() []string {
 		preSharedKeyStorageTypeStandard,
 	}
 }
+
+const (
+	managedPrefixListIPAMPoolSyncCIDRSourceAllocations      = "allocations"
+	managedPrefixListIPAMPoolSyncCIDRSourceProvisionedCIDRs = "provisioned-cidrs"
+)
+
+func managedPrefixListIPAMPoolSyncCIDRSource_Values() []string {
+	return []string{
+		managedPrefixListIPAMPoolSyncCIDRSourceAllocations,
+		managedPrefixListIPAMPoolSyncCIDRSourceProvisionedCIDRs,
+	}
+}
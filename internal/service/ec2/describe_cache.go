@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// describeCacheTTL is how long cached results are used.
+	// It bounds how stale a result can be when the underlying resources are changed outside of this provider instance.
+	describeCacheTTL = 5 * time.Minute
+)
+
+// describeCaches holds the results of Describe calls frequently repeated by data sources.
+// Configurations with many such lookups otherwise make many identical API calls.
+type describeCaches struct {
+	availabilityZones     *describeCache[awstypes.AvailabilityZone]
+	instanceTypeOfferings *describeCache[awstypes.InstanceTypeOffering]
+	managedPrefixLists    *describeCache[awstypes.ManagedPrefixList]
+	prefixLists           *describeCache[awstypes.PrefixList]
+}
+
+// describeCachesFor returns the Describe caches of the specified AWS client.
+// The caches live as long as the configured provider instance.
+func describeCachesFor(ctx context.Context, c *conns.AWSClient) *describeCaches {
+	return c.ServiceCache(ctx, names.EC2, func() any {
+		return &describeCaches{
+			availabilityZones:     newDescribeCache[awstypes.AvailabilityZone](describeCacheTTL),
+			instanceTypeOfferings: newDescribeCache[awstypes.InstanceTypeOffering](describeCacheTTL),
+			managedPrefixLists:    newDescribeCache[awstypes.ManagedPrefixList](describeCacheTTL),
+			prefixLists:           newDescribeCache[awstypes.PrefixList](describeCacheTTL),
+		}
+	}).(*describeCaches)
+}
+
+// describeCache memoizes the results of read-only Describe API calls for a limited time.
+// Results are keyed by Region and the canonical form of the request's IDs and filters.
+// Concurrent lookups with the same key share a single API call. Errors are not cached.
+type describeCache[T any] struct {
+	mu      sync.Mutex
+	entries map[describeCacheKey]*describeCacheEntry[T]
+	ttl     time.Duration
+}
+
+type describeCacheKey struct {
+	region string
+	input  string
+}
+
+type describeCacheEntry[T any] struct {
+	created time.Time
+	once    sync.Once
+	output  []T
+	err     error
+}
+
+func newDescribeCache[T any](ttl time.Duration) *describeCache[T] {
+	return &describeCache[T]{
+		entries: make(map[describeCacheKey]*describeCacheEntry[T]),
+		ttl:     ttl,
+	}
+}
+
+func (c *describeCache[T]) get(region, input string, f func() ([]T, error)) ([]T, error) {
+	key := describeCacheKey{region: region, input: input}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok || time.Since(entry.created) > c.ttl {
+		entry = &describeCacheEntry[T]{
+			created: time.Now(),
+		}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.output, entry.err = f()
+	})
+
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+
+		return nil, entry.err
+	}
+
+	return slices.Clone(entry.output), nil
+}
+
+// invalidate removes all cached results for the specified Region.
+func (c *describeCache[T]) invalidate(region string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if key.region == region {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// describeCached returns the result of f, shared between callers using the same AWS client and Region.
+// The cache is bypassed if the provider is configured with skip_ec2_describe_cache.
+func describeCached[T any](ctx context.Context, c *conns.AWSClient, cache *describeCache[T], input string, f func(*ec2.Client) ([]T, error)) ([]T, error) {
+	conn := c.EC2Client(ctx)
+
+	if c.SkipEC2DescribeCache(ctx) {
+		return f(conn)
+	}
+
+	return cache.get(c.Region(ctx), input, func() ([]T, error) {
+		return f(conn)
+	})
+}
+
+// invalidateAvailabilityZonesCache removes the AWS client's cached Availability Zone results for the current Region.
+// Opting in to or out of a zone group also changes the instance type offerings listed by location, so those are removed too.
+func invalidateAvailabilityZonesCache(ctx context.Context, c *conns.AWSClient) {
+	caches, region := describeCachesFor(ctx, c), c.Region(ctx)
+
+	caches.availabilityZones.invalidate(region)
+	caches.instanceTypeOfferings.invalidate(region)
+}
+
+// invalidateManagedPrefixListsCache removes the AWS client's cached managed prefix list results for the current Region.
+func invalidateManagedPrefixListsCache(ctx context.Context, c *conns.AWSClient) {
+	describeCachesFor(ctx, c).managedPrefixLists.invalidate(c.Region(ctx))
+}
+
+// describeCacheInput returns the canonical form of a Describe request's IDs, filters and any other parameters.
+// The order of IDs, filters and filter values does not affect the result. The order of other parameters does.
+func describeCacheInput(ids []string, filters []awstypes.Filter, params ...string) string {
+	var sb strings.Builder
+
+	sb.WriteString(strings.Join(params, "|"))
+	sb.WriteString("|")
+
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	sb.WriteString(strings.Join(ids, ","))
+
+	var parts []string
+	for _, filter := range filters {
+		values := slices.Clone(filter.Values)
+		slices.Sort(values)
+		parts = append(parts, aws.ToString(filter.Name)+"="+strings.Join(values, ","))
+	}
+	slices.Sort(parts)
+
+	for _, part := range parts {
+		sb.WriteString(";")
+		sb.WriteString(part)
+	}
+
+	return sb.String()
+}
+
+func findAvailabilityZoneCached(ctx context.Context, c *conns.AWSClient, input *ec2.DescribeAvailabilityZonesInput) (*awstypes.AvailabilityZone, error) {
+	output, err := findAvailabilityZonesCached(ctx, c, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// findAvailabilityZonesCached is like findAvailabilityZones but shares results between callers using the same AWS client and Region.
+func findAvailabilityZonesCached(ctx context.Context, c *conns.AWSClient, input *ec2.DescribeAvailabilityZonesInput) ([]awstypes.AvailabilityZone, error) {
+	zoneNames := slices.Clone(input.ZoneNames)
+	slices.Sort(zoneNames)
+	key := describeCacheInput(input.ZoneIds, input.Filters, strconv.FormatBool(aws.ToBool(input.AllAvailabilityZones)), strings.Join(zoneNames, ","))
+
+	return describeCached(ctx, c, describeCachesFor(ctx, c).availabilityZones, key, func(conn *ec2.Client) ([]awstypes.AvailabilityZone, error) {
+		return findAvailabilityZones(ctx, conn, input)
+	})
+}
+
+// findInstanceTypeOfferingsCached is like findInstanceTypeOfferings but shares results between callers using the same AWS client and Region.
+func findInstanceTypeOfferingsCached(ctx context.Context, c *conns.AWSClient, input *ec2.DescribeInstanceTypeOfferingsInput) ([]awstypes.InstanceTypeOffering, error) {
+	key := describeCacheInput(nil, input.Filters, string(input.LocationType))
+
+	return describeCached(ctx, c, describeCachesFor(ctx, c).instanceTypeOfferings, key, func(conn *ec2.Client) ([]awstypes.InstanceTypeOffering, error) {
+		return findInstanceTypeOfferings(ctx, conn, input)
+	})
+}
+
+func findPrefixListCached(ctx context.Context, c *conns.AWSClient, input *ec2.DescribePrefixListsInput) (*awstypes.PrefixList, error) {
+	output, err := findPrefixListsCached(ctx, c, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// findPrefixListsCached is like findPrefixLists but shares results between callers using the same AWS client and Region.
+func findPrefixListsCached(ctx context.Context, c *conns.AWSClient, input *ec2.DescribePrefixListsInput) ([]awstypes.PrefixList, error) {
+	return describeCached(ctx, c, describeCachesFor(ctx, c).prefixLists, describeCacheInput(input.PrefixListIds, input.Filters), func(conn *ec2.Client) ([]awstypes.PrefixList, error) {
+		return findPrefixLists(ctx, conn, input)
+	})
+}
+
+func findManagedPrefixListCached(ctx context.Context, c *conns.AWSClient, input *ec2.DescribeManagedPrefixListsInput) (*awstypes.ManagedPrefixList, error) {
+	output, err := findManagedPrefixListsCached(ctx, c, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+// findManagedPrefixListsCached is like findManagedPrefixLists but shares results between callers using the same AWS client and Region.
+func findManagedPrefixListsCached(ctx context.Context, c *conns.AWSClient, input *ec2.DescribeManagedPrefixListsInput) ([]awstypes.ManagedPrefixList, error) {
+	return describeCached(ctx, c, describeCachesFor(ctx, c).managedPrefixLists, describeCacheInput(input.PrefixListIds, input.Filters), func(conn *ec2.Client) ([]awstypes.ManagedPrefixList, error) {
+		return findManagedPrefixLists(ctx, conn, input)
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestDescribeCacheInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		IDs1     []string
+		Filters1 []awstypes.Filter
+		IDs2     []string
+		Filters2 []awstypes.Filter
+		Params1  []string
+		Params2  []string
+		Equal    bool
+	}{
+		{
+			TestName: "empty",
+			Equal:    true,
+		},
+		{
+			TestName: "ID order",
+			IDs1:     []string{"pl-1", "pl-2"},
+			IDs2:     []string{"pl-2", "pl-1"},
+			Equal:    true,
+		},
+		{
+			TestName: "filter and value order",
+			Filters1: []awstypes.Filter{
+				{Name: aws.String("prefix-list-name"), Values: []string{"a", "b"}},
+				{Name: aws.String("owner-id"), Values: []string{"123456789012"}},
+			},
+			Filters2: []awstypes.Filter{
+				{Name: aws.String("owner-id"), Values: []string{"123456789012"}},
+				{Name: aws.String("prefix-list-name"), Values: []string{"b", "a"}},
+			},
+			Equal: true,
+		},
+		{
+			TestName: "different values",
+			Filters1: []awstypes.Filter{
+				{Name: aws.String("prefix-list-name"), Values: []string{"a"}},
+			},
+			Filters2: []awstypes.Filter{
+				{Name: aws.String("prefix-list-name"), Values: []string{"b"}},
+			},
+		},
+		{
+			TestName: "ID versus filter",
+			IDs1:     []string{"pl-1"},
+			Filters2: []awstypes.Filter{
+				{Name: aws.String("prefix-list-id"), Values: []string{"pl-1"}},
+			},
+		},
+		{
+			TestName: "same params",
+			IDs1:     []string{"use1-az1"},
+			IDs2:     []string{"use1-az1"},
+			Params1:  []string{"true"},
+			Params2:  []string{"true"},
+			Equal:    true,
+		},
+		{
+			TestName: "different params",
+			IDs1:     []string{"use1-az1"},
+			IDs2:     []string{"use1-az1"},
+			Params1:  []string{"true"},
+			Params2:  []string{"false"},
+		},
+		{
+			TestName: "param versus ID",
+			IDs1:     []string{"region"},
+			Params2:  []string{"region"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got1, got2 := describeCacheInput(testCase.IDs1, testCase.Filters1, testCase.Params1...), describeCacheInput(testCase.IDs2, testCase.Filters2, testCase.Params2...)
+
+			if got, want := got1 == got2, testCase.Equal; got != want {
+				t.Errorf("describeCacheInput equal = %t (%q, %q), want %t", got, got1, got2, want)
+			}
+		})
+	}
+}
+
+func TestDescribeCache(t *testing.T) {
+	t.Parallel()
+
+	cache := newDescribeCache[string](time.Hour)
+	region1, region2 := "us-west-2", "us-east-1" //lintignore:AWSAT003
+	var calls atomic.Int32
+	f := func() ([]string, error) {
+		calls.Add(1)
+		return []string{"v"}, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := cache.get(region1, "k", f); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	if _, err := cache.get(region2, "k", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("calls after second Region = %d, want %d", got, want)
+	}
+
+	cache.invalidate(region1)
+
+	if _, err := cache.get(region1, "k", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := cache.get(region2, "k", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(3); got != want {
+		t.Errorf("calls after invalidate = %d, want %d", got, want)
+	}
+
+	errFailed := errors.New("failed")
+	if _, err := cache.get(region1, "err", func() ([]string, error) { return nil, errFailed }); !errors.Is(err, errFailed) {
+		t.Fatalf("err = %v, want %v", err, errFailed)
+	}
+
+	output, err := cache.get(region1, "err", f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output), 1; got != want {
+		t.Errorf("len(output) after error = %d, want %d", got, want)
+	}
+}
+
+func TestDescribeCache_expired(t *testing.T) {
+	t.Parallel()
+
+	cache := newDescribeCache[string](0)
+	var calls atomic.Int32
+	f := func() ([]string, error) {
+		calls.Add(1)
+		return []string{"v"}, nil
+	}
+
+	for range 2 {
+		if _, err := cache.get("us-west-2", "k", f); err != nil { //lintignore:AWSAT003
+			t.Fatalf("unexpected error: %s", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
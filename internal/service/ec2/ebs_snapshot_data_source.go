@@ -133,6 +133,10 @@ func dataSourceEBSSnapshotRead(ctx context.Context, d *schema.ResourceData, meta
 		input.SnapshotIds = flex.ExpandStringValueList(v.([]any))
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -63,6 +63,10 @@ func dataSourceEBSSnapshotIDsRead(ctx context.Context, d *schema.ResourceData, m
 		input.RestorableByUserIds = flex.ExpandStringValueList(v.([]any))
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
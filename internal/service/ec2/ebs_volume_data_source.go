@@ -101,6 +101,10 @@ func dataSourceEBSVolumeRead(ctx context.Context, d *schema.ResourceData, meta a
 
 	input := ec2.DescribeVolumesInput{}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -48,6 +48,10 @@ func dataSourceEBSVolumesRead(ctx context.Context, d *schema.ResourceData, meta
 		svcTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))),
 	)...)
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
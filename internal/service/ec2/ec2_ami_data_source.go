@@ -254,6 +254,10 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 
 	if v, ok := d.GetOk(names.AttrFilter); ok {
+		if err := validateCustomFilters(v.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		describeImagesInput.Filters = newCustomFilterList(v.(*schema.Set))
 	}
 
@@ -87,6 +87,10 @@ func dataSourceAMIIDsRead(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	if v, ok := d.GetOk(names.AttrFilter); ok {
+		if err := validateCustomFilters(v.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = newCustomFilterList(v.(*schema.Set))
 	}
 
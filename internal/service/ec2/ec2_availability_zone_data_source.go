@@ -86,7 +86,7 @@ func dataSourceAvailabilityZone() *schema.Resource {
 
 func dataSourceAvailabilityZoneRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
 
 	input := ec2.DescribeAvailabilityZonesInput{}
 
@@ -108,6 +108,10 @@ func dataSourceAvailabilityZoneRead(ctx context.Context, d *schema.ResourceData,
 		},
 	)
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -117,7 +121,7 @@ func dataSourceAvailabilityZoneRead(ctx context.Context, d *schema.ResourceData,
 		input.Filters = nil
 	}
 
-	az, err := findAvailabilityZone(ctx, conn, &input)
+	az, err := findAvailabilityZoneCached(ctx, c, &input)
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Availability Zone", err))
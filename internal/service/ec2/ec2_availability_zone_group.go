@@ -52,6 +52,7 @@ func resourceAvailabilityZoneGroup() *schema.Resource {
 func resourceAvailabilityZoneGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateAvailabilityZonesCache(ctx, meta.(*conns.AWSClient))
 
 	groupName := d.Get(names.AttrGroupName).(string)
 	availabilityZone, err := findAvailabilityZoneGroupByName(ctx, conn, groupName)
@@ -94,6 +95,7 @@ func resourceAvailabilityZoneGroupRead(ctx context.Context, d *schema.ResourceDa
 func resourceAvailabilityZoneGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateAvailabilityZonesCache(ctx, meta.(*conns.AWSClient))
 
 	if err := modifyAvailabilityZoneOptInStatus(ctx, conn, d.Id(), d.Get("opt_in_status").(string)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating EC2 Availability Zone Group (%s): %s", d.Id(), err)
@@ -72,7 +72,7 @@ func dataSourceAvailabilityZones() *schema.Resource {
 
 func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
 
 	log.Printf("[DEBUG] Reading Availability Zones.")
 
@@ -92,6 +92,10 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	if filters, filtersOk := d.GetOk(names.AttrFilter); filtersOk {
+		if err := validateCustomFilters(filters.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = append(input.Filters, newCustomFilterList(
 			filters.(*schema.Set),
 		)...)
@@ -103,12 +107,12 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	log.Printf("[DEBUG] Reading Availability Zones: %s", d.Id())
-	resp, err := conn.DescribeAvailabilityZones(ctx, &input)
+	availabilityZones, err := findAvailabilityZonesCached(ctx, c, &input)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "fetching Availability Zones: %s", err)
 	}
 
-	slices.SortFunc(resp.AvailabilityZones, func(a, b awstypes.AvailabilityZone) int {
+	slices.SortFunc(availabilityZones, func(a, b awstypes.AvailabilityZone) int {
 		return cmp.Compare(aws.ToString(a.ZoneName), aws.ToString(b.ZoneName))
 	})
 
@@ -118,7 +122,7 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	groupNames := schema.NewSet(schema.HashString, nil)
 	nms := []string{}
 	zoneIds := []string{}
-	for _, v := range resp.AvailabilityZones {
+	for _, v := range availabilityZones {
 		groupName := aws.ToString(v.GroupName)
 		name := aws.ToString(v.ZoneName)
 		zoneID := aws.ToString(v.ZoneId)
@@ -57,6 +57,10 @@ func dataSourceEIPsRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	}
 
 	if filters, filtersOk := d.GetOk(names.AttrFilter); filtersOk {
+		if err := validateCustomFilters(filters.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = append(input.Filters,
 			newCustomFilterList(filters.(*schema.Set))...)
 	}
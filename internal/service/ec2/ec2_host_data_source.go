@@ -95,6 +95,10 @@ func dataSourceHostRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input := ec2.DescribeHostsInput{
 		Filter: newCustomFilterList(d.Get(names.AttrFilter).(*schema.Set)),
 	}
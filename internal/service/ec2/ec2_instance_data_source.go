@@ -419,6 +419,10 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta an
 		)...)
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -55,11 +55,15 @@ func dataSourceInstanceTypeOfferings() *schema.Resource {
 
 func dataSourceInstanceTypeOfferingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
 
 	input := ec2.DescribeInstanceTypeOfferingsInput{}
 
 	if v, ok := d.GetOk(names.AttrFilter); ok {
+		if err := validateCustomFilters(v.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = newCustomFilterList(v.(*schema.Set))
 	}
 
@@ -71,7 +75,7 @@ func dataSourceInstanceTypeOfferingsRead(ctx context.Context, d *schema.Resource
 	var locations []string
 	var locationTypes []string
 
-	instanceTypeOfferings, err := findInstanceTypeOfferings(ctx, conn, &input)
+	instanceTypeOfferings, err := findInstanceTypeOfferingsCached(ctx, c, &input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance Type Offerings: %s", err)
@@ -42,6 +42,10 @@ func dataSourceInstanceTypesRead(ctx context.Context, d *schema.ResourceData, me
 	input := ec2.DescribeInstanceTypesInput{}
 
 	if v, ok := d.GetOk(names.AttrFilter); ok {
+		if err := validateCustomFilters(v.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = newCustomFilterList(v.(*schema.Set))
 	}
 
@@ -30,7 +30,7 @@ func dataSourceInstances() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			names.AttrFilter: customFiltersSchema(),
+			names.AttrFilter: customFiltersWithExclusionSchema(),
 			names.AttrIDs: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -86,6 +86,10 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta a
 		svcTags(tftags.New(ctx, d.Get("instance_tags").(map[string]any))),
 	)...)
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -100,6 +104,18 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: %s", err)
 	}
 
+	output, err = excludeByCustomFilters(ctx, output, input.Filters, newCustomExclusionFilterList(d.Get(names.AttrFilter).(*schema.Set)), func(ctx context.Context, filters []awstypes.Filter) ([]awstypes.Instance, error) {
+		return findInstances(ctx, conn, &ec2.DescribeInstancesInput{
+			Filters: filters,
+		})
+	}, func(v *awstypes.Instance) string {
+		return aws.ToString(v.InstanceId)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: excluding filtered resources: %s", err)
+	}
+
 	var instanceIDs, privateIPs, publicIPs, ipv6Addresses []string
 
 	for _, v := range output {
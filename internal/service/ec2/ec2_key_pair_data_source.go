@@ -77,6 +77,10 @@ func dataSourceKeyPairRead(ctx context.Context, d *schema.ResourceData, meta any
 	input := ec2.DescribeKeyPairsInput{}
 
 	if v, ok := d.GetOk(names.AttrFilter); ok {
+		if err := validateCustomFilters(v.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = newCustomFilterList(v.(*schema.Set))
 	}
 
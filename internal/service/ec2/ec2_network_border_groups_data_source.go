@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ec2_network_border_groups", name="Network Border Groups")
+func newNetworkBorderGroupsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &networkBorderGroupsDataSource{}, nil
+}
+
+type networkBorderGroupsDataSource struct {
+	framework.DataSourceWithModel[networkBorderGroupsDataSourceModel]
+}
+
+func (d *networkBorderGroupsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"all_availability_zones": schema.BoolAttribute{
+				Optional: true,
+			},
+			"network_border_groups": framework.DataSourceComputedListOfObjectAttribute[networkBorderGroupModel](ctx),
+			names.AttrNames: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: customFiltersBlock(ctx),
+		},
+	}
+}
+
+func (d *networkBorderGroupsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data networkBorderGroupsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := ec2.DescribeAvailabilityZonesInput{
+		AllAvailabilityZones: fwflex.BoolFromFramework(ctx, data.AllAvailabilityZones),
+		Filters:              newCustomFilterListFramework(ctx, data.Filters),
+	}
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	availabilityZones, err := findAvailabilityZonesCached(ctx, d.Meta(), &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Network Border Groups", err.Error())
+
+		return
+	}
+
+	slices.SortFunc(availabilityZones, func(a, b awstypes.AvailabilityZone) int {
+		return cmp.Or(
+			cmp.Compare(aws.ToString(a.NetworkBorderGroup), aws.ToString(b.NetworkBorderGroup)),
+			cmp.Compare(aws.ToString(a.ZoneName), aws.ToString(b.ZoneName)),
+		)
+	})
+
+	var networkBorderGroups []networkBorderGroupModel
+	var networkBorderGroupNames []string
+	for len(availabilityZones) > 0 {
+		name := aws.ToString(availabilityZones[0].NetworkBorderGroup)
+		n := slices.IndexFunc(availabilityZones, func(v awstypes.AvailabilityZone) bool {
+			return aws.ToString(v.NetworkBorderGroup) != name
+		})
+		if n == -1 {
+			n = len(availabilityZones)
+		}
+
+		networkBorderGroup := networkBorderGroupModel{
+			Name: fwflex.StringValueToFramework(ctx, name),
+		}
+		response.Diagnostics.Append(fwflex.Flatten(ctx, availabilityZones[:n], &networkBorderGroup.Zones)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		networkBorderGroups = append(networkBorderGroups, networkBorderGroup)
+		networkBorderGroupNames = append(networkBorderGroupNames, name)
+		availabilityZones = availabilityZones[n:]
+	}
+
+	data.NetworkBorderGroups = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, networkBorderGroups)
+	data.Names = fwflex.FlattenFrameworkStringValueListOfString(ctx, networkBorderGroupNames)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type networkBorderGroupsDataSourceModel struct {
+	framework.WithRegionModel
+	AllAvailabilityZones types.Bool                                               `tfsdk:"all_availability_zones"`
+	Filters              customFilters                                            `tfsdk:"filter"`
+	Names                fwtypes.ListOfString                                     `tfsdk:"names"`
+	NetworkBorderGroups  fwtypes.ListNestedObjectValueOf[networkBorderGroupModel] `tfsdk:"network_border_groups"`
+}
+
+type networkBorderGroupModel struct {
+	Name  types.String                                                 `tfsdk:"name"`
+	Zones fwtypes.ListNestedObjectValueOf[networkBorderGroupZoneModel] `tfsdk:"zones"`
+}
+
+type networkBorderGroupZoneModel struct {
+	GroupName      types.String                                             `tfsdk:"group_name"`
+	OptInStatus    fwtypes.StringEnum[awstypes.AvailabilityZoneOptInStatus] `tfsdk:"opt_in_status"`
+	ParentZoneID   types.String                                             `tfsdk:"parent_zone_id"`
+	ParentZoneName types.String                                             `tfsdk:"parent_zone_name"`
+	State          fwtypes.StringEnum[awstypes.AvailabilityZoneState]       `tfsdk:"state"`
+	ZoneID         types.String                                             `tfsdk:"zone_id"`
+	ZoneName       types.String                                             `tfsdk:"zone_name"`
+	ZoneType       types.String                                             `tfsdk:"zone_type"`
+}
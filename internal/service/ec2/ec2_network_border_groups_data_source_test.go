@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2NetworkBorderGroupsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_network_border_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkBorderGroupsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", dataSourceName, "network_border_groups.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_border_groups.0.zones.0.zone_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_border_groups.0.zones.0.zone_name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", "data.aws_region.current", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2NetworkBorderGroupsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_network_border_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkBorderGroupsDataSourceConfig_filter,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", "data.aws_region.current", names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "network_border_groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_border_groups.0.zones.0.zone_type", "availability-zone"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkBorderGroupsDataSourceConfig_basic = `
+data "aws_region" "current" {}
+
+data "aws_ec2_network_border_groups" "test" {
+  all_availability_zones = true
+}
+`
+
+const testAccNetworkBorderGroupsDataSourceConfig_filter = `
+data "aws_region" "current" {}
+
+data "aws_ec2_network_border_groups" "test" {
+  filter {
+    name   = "zone-type"
+    values = ["availability-zone"]
+  }
+}
+`
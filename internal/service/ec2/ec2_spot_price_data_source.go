@@ -70,6 +70,10 @@ func dataSourceSpotPriceRead(ctx context.Context, d *schema.ResourceData, meta a
 	}
 
 	if v, ok := d.GetOk(names.AttrFilter); ok {
+		if err := validateCustomFilters(v.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = newCustomFilterList(v.(*schema.Set))
 	}
 
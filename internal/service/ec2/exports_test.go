@@ -53,7 +53,10 @@ var (
 	ResourceLocalGatewayRouteTableVPCAssociation          = resourceLocalGatewayRouteTableVPCAssociation
 	ResourceMainRouteTableAssociation                     = resourceMainRouteTableAssociation
 	ResourceManagedPrefixList                             = resourceManagedPrefixList
+	ResourceManagedPrefixListAllowlist                    = resourceManagedPrefixListAllowlist
+	ResourceManagedPrefixListEntries                      = resourceManagedPrefixListEntries
 	ResourceManagedPrefixListEntry                        = resourceManagedPrefixListEntry
+	ResourceManagedPrefixListIPAMPoolSync                 = resourceManagedPrefixListIPAMPoolSync
 	ResourceNATGateway                                    = resourceNATGateway
 	ResourceNetworkACL                                    = resourceNetworkACL
 	ResourceNetworkACLAssociation                         = resourceNetworkACLAssociation
@@ -134,6 +137,7 @@ var (
 
 	CheckMostRecentAndMissingFilters                           = checkMostRecentAndMissingFilters
 	CustomFiltersSchema                                        = customFiltersSchema
+	CustomFiltersWithExclusionSchema                           = customFiltersWithExclusionSchema
 	CustomerGatewayConfigurationToTunnelInfo                   = customerGatewayConfigurationToTunnelInfo
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
@@ -180,6 +184,7 @@ var (
 	FindLocalGatewayRouteTableVPCAssociationByID               = findLocalGatewayRouteTableVPCAssociationByID
 	FindMainRouteTableAssociationByID                          = findMainRouteTableAssociationByID
 	FindManagedPrefixListByID                                  = findManagedPrefixListByID
+	FindManagedPrefixListEntriesByID                           = findManagedPrefixListEntriesByID
 	FindManagedPrefixListEntryByIDAndCIDR                      = findManagedPrefixListEntryByIDAndCIDR
 	FindNATGatewayByID                                         = findNATGatewayByID
 	FindNetworkACLAssociationByID                              = findNetworkACLAssociationByID
@@ -272,6 +277,7 @@ var (
 	MatchRules                                                 = matchRules
 	NetworkACLRuleImportIDSeparator                            = networkACLRuleImportIDSeparator
 	NewAttributeFilterList                                     = newAttributeFilterList
+	NewCustomExclusionFilterList                               = newCustomExclusionFilterList
 	NewCustomFilterList                                        = newCustomFilterList
 	NewTagFilterList                                           = newTagFilterList
 	OpenSSHPublicKeysEqual                                     = openSSHPublicKeysEqual
@@ -292,6 +298,7 @@ var (
 	UnsuccessfulItemError                                      = unsuccessfulItemError
 	UnsuccessfulItemsError                                     = unsuccessfulItemsError
 	UpdateTags                                                 = updateTags
+	ValidateCustomFilters                                      = validateCustomFilters
 	VPCDHCPOptionsAssociationParseResourceID                   = vpcDHCPOptionsAssociationParseResourceID
 	VPCMigrateState                                            = vpcMigrateState
 	VPNGatewayRoutePropagationParseID                          = vpnGatewayRoutePropagationParseID
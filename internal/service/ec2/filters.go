@@ -5,11 +5,16 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -96,23 +101,72 @@ func tagFilters(ctx context.Context) []awstypes.Filter {
 //	  name   = "availabilityZone"
 //	  values = ["us-west-2a", "us-west-2b"]
 //	}
+//
+// As a shortcut, a filter block may instead specify tags, each of which
+// is expanded to an exact match "tag:<key>" filter:
+//
+//	filter {
+//	  tags = {
+//	    Environment = "production"
+//	  }
+//	}
 func customFiltersSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				names.AttrName: {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-				names.AttrValues: {
-					Type:     schema.TypeSet,
-					Required: true,
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
-					},
-				},
+			Schema: customFilterSchemaMap(),
+		},
+	}
+}
+
+// customFiltersWithExclusionSchema is a variant of customFiltersSchema for data sources
+// that support client-side exclusion filters.
+// A filter block with "exclude" set to true removes matching resources from the results:
+//
+//	filter {
+//	  name    = "instance-state-name"
+//	  values  = ["stopped"]
+//	  exclude = true
+//	}
+//
+// Exclusion filters must be extracted with newCustomExclusionFilterList and applied using excludeByCustomFilters.
+// Resources matching all the exclusion filters are removed.
+func customFiltersWithExclusionSchema() *schema.Schema {
+	m := customFilterSchemaMap()
+	m["exclude"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: m,
+		},
+	}
+}
+
+func customFilterSchemaMap() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		names.AttrTags: {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		names.AttrValues: {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
 		},
 	}
@@ -147,12 +201,24 @@ func customFiltersBlock(ctx context.Context) datasourceschema.Block {
 		NestedObject: datasourceschema.NestedBlockObject{
 			Attributes: map[string]datasourceschema.Attribute{
 				names.AttrName: datasourceschema.StringAttribute{
-					Required: true,
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(names.AttrValues)),
+						stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName(names.AttrTags)),
+					},
+				},
+				names.AttrTags: datasourceschema.MapAttribute{
+					CustomType:  fwtypes.MapOfStringType,
+					ElementType: types.StringType,
+					Optional:    true,
 				},
 				names.AttrValues: datasourceschema.SetAttribute{
 					CustomType:  fwtypes.SetOfStringType,
 					ElementType: types.StringType,
-					Required:    true,
+					Optional:    true,
+					Validators: []validator.Set{
+						setvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(names.AttrName)),
+					},
 				},
 			},
 		},
@@ -162,6 +228,7 @@ func customFiltersBlock(ctx context.Context) datasourceschema.Block {
 // customFilterModel represents a single configured filter.
 type customFilterModel struct {
 	Name   types.String        `tfsdk:"name"`
+	Tags   fwtypes.MapOfString `tfsdk:"tags"`
 	Values fwtypes.SetOfString `tfsdk:"values"`
 }
 
@@ -178,15 +245,130 @@ type (
 // This function is intended only to be used in conjunction with
 // CustomFiltersSchema. See the docs on that function for more details
 // on the configuration pattern this is intended to support.
+//
+// Exclusion filters are not included, see newCustomExclusionFilterList.
 func newCustomFilterList(s *schema.Set) []awstypes.Filter {
 	if s == nil {
 		return []awstypes.Filter{}
 	}
 
-	return tfslices.ApplyToAll(s.List(), func(tfList any) awstypes.Filter {
-		tfMap := tfList.(map[string]any)
-		return newFilter(tfMap[names.AttrName].(string), flex.ExpandStringValueEmptySet(tfMap[names.AttrValues].(*schema.Set)))
-	})
+	filters := []awstypes.Filter{}
+
+	for _, tfMapRaw := range s.List() {
+		tfMap := tfMapRaw.(map[string]any)
+
+		if v, ok := tfMap["exclude"].(bool); ok && v {
+			continue
+		}
+
+		filters = append(filters, expandCustomFilter(tfMap)...)
+	}
+
+	return filters
+}
+
+// newCustomExclusionFilterList returns the filters of all the configured exclusion filter blocks.
+// A resource is excluded if it matches all the returned filters.
+func newCustomExclusionFilterList(s *schema.Set) []awstypes.Filter {
+	if s == nil {
+		return nil
+	}
+
+	var filters []awstypes.Filter
+
+	for _, tfMapRaw := range s.List() {
+		tfMap := tfMapRaw.(map[string]any)
+
+		if v, ok := tfMap["exclude"].(bool); !ok || !v {
+			continue
+		}
+
+		filters = append(filters, expandCustomFilter(tfMap)...)
+	}
+
+	return filters
+}
+
+// validateCustomFilters returns an error if any of the configured filter blocks is incomplete.
+// A filter block must specify "name" and "values" together, or "tags", or both.
+// The set's element schema does not allow this to be checked at plan time, so data sources call this when read.
+func validateCustomFilters(s *schema.Set) error {
+	if s == nil {
+		return nil
+	}
+
+	for _, tfMapRaw := range s.List() {
+		tfMap := tfMapRaw.(map[string]any)
+
+		name, _ := tfMap[names.AttrName].(string)
+		var values int
+		if v, ok := tfMap[names.AttrValues].(*schema.Set); ok {
+			values = v.Len()
+		}
+		tags, _ := tfMap[names.AttrTags].(map[string]any)
+
+		switch {
+		case name != "" && values == 0:
+			return fmt.Errorf("filter %q: %q must be specified with %q", name, names.AttrValues, names.AttrName)
+		case name == "" && values > 0:
+			return fmt.Errorf("filter: %q must be specified with %q", names.AttrName, names.AttrValues)
+		case name == "" && len(tags) == 0:
+			return fmt.Errorf("filter: one of %q or %q must be specified", names.AttrName, names.AttrTags)
+		}
+	}
+
+	return nil
+}
+
+func expandCustomFilter(tfMap map[string]any) []awstypes.Filter {
+	var filters []awstypes.Filter
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		var values []string
+		if v, ok := tfMap[names.AttrValues].(*schema.Set); ok {
+			values = flex.ExpandStringValueEmptySet(v)
+		}
+
+		filters = append(filters, newFilter(v, values))
+	}
+
+	if v, ok := tfMap[names.AttrTags].(map[string]any); ok && len(v) > 0 {
+		// Sort the tag keys to make the output deterministic.
+		keys := tfmaps.Keys(v)
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			filters = append(filters, newFilter("tag:"+key, []string{v[key].(string)}))
+		}
+	}
+
+	return filters
+}
+
+// excludeByCustomFilters returns the specified resources less any that also match the specified exclusion filters.
+// The excluded resources are found by a single call to the specified function, scoped by the inclusion filters,
+// and are identified by ID.
+func excludeByCustomFilters[T any](ctx context.Context, resources []T, filters, exclusionFilters []awstypes.Filter, find func(context.Context, []awstypes.Filter) ([]T, error), id func(*T) string) ([]T, error) {
+	if len(resources) == 0 || len(exclusionFilters) == 0 {
+		return resources, nil
+	}
+
+	matches, err := find(ctx, append(slices.Clone(filters), exclusionFilters...))
+
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[string]struct{})
+
+	for _, v := range matches {
+		excluded[id(&v)] = struct{}{}
+	}
+
+	return slices.DeleteFunc(resources, func(v T) bool {
+		_, ok := excluded[id(&v)]
+		return ok
+	}), nil
 }
 
 func newCustomFilterListFramework(ctx context.Context, customFilters customFilters) []awstypes.Filter {
@@ -203,15 +385,23 @@ func newCustomFilterListFramework(ctx context.Context, customFilters customFilte
 			continue
 		}
 
-		if data.Name.IsNull() || data.Name.IsUnknown() {
-			continue
+		if !data.Name.IsNull() && !data.Name.IsUnknown() {
+			if v := fwflex.ExpandFrameworkStringValueSet(ctx, data.Values); v != nil {
+				filters = append(filters, awstypes.Filter{
+					Name:   fwflex.StringFromFramework(ctx, data.Name),
+					Values: v,
+				})
+			}
 		}
 
-		if v := fwflex.ExpandFrameworkStringValueSet(ctx, data.Values); v != nil {
-			filters = append(filters, awstypes.Filter{
-				Name:   fwflex.StringFromFramework(ctx, data.Name),
-				Values: v,
-			})
+		if v := fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags); len(v) > 0 {
+			// Sort the tag keys to make the output deterministic.
+			keys := tfmaps.Keys(v)
+			slices.Sort(keys)
+
+			for _, key := range keys {
+				filters = append(filters, newFilter("tag:"+key, []string{v[key]}))
+			}
 		}
 	}
 
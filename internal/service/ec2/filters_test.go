@@ -156,3 +156,127 @@ func TestNewCustomFilterList(t *testing.T) {
 		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
 	}
 }
+
+func TestNewCustomFilterList_tagsAndExclusions(t *testing.T) {
+	t.Parallel()
+
+	filtersSchema := tfec2.CustomFiltersWithExclusionSchema()
+	filters := filtersSchema.ZeroValue().(*schema.Set)
+
+	valuesSchema := filtersSchema.Elem.(*schema.Resource).Schema[names.AttrValues]
+	valuesSet := func(vals ...string) *schema.Set {
+		ret := valuesSchema.ZeroValue().(*schema.Set)
+		for _, val := range vals {
+			ret.Add(val)
+		}
+		return ret
+	}
+
+	filters.Add(map[string]any{
+		names.AttrTags: map[string]any{
+			"Environment": "production",
+			"Application": "web",
+		},
+		"exclude": false,
+	})
+	filters.Add(map[string]any{
+		names.AttrName:   "instance-state-name",
+		names.AttrValues: valuesSet("stopped"),
+		"exclude":        true,
+	})
+
+	expected := []awstypes.Filter{
+		{
+			Name:   aws.String("tag:Application"),
+			Values: []string{"web"},
+		},
+		{
+			Name:   aws.String("tag:Environment"),
+			Values: []string{"production"},
+		},
+	}
+	result := tfec2.NewCustomFilterList(filters)
+
+	if diff := cmp.Diff(result, expected, cmp.AllowUnexported(awstypes.Filter{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	expectedExclusions := []awstypes.Filter{
+		{
+			Name:   aws.String("instance-state-name"),
+			Values: []string{"stopped"},
+		},
+	}
+	exclusions := tfec2.NewCustomExclusionFilterList(filters)
+
+	if diff := cmp.Diff(exclusions, expectedExclusions, cmp.AllowUnexported(awstypes.Filter{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestValidateCustomFilters(t *testing.T) {
+	t.Parallel()
+
+	filtersSchema := tfec2.CustomFiltersWithExclusionSchema()
+	valuesSchema := filtersSchema.Elem.(*schema.Resource).Schema[names.AttrValues]
+	valuesSet := func(vals ...string) *schema.Set {
+		ret := valuesSchema.ZeroValue().(*schema.Set)
+		for _, val := range vals {
+			ret.Add(val)
+		}
+		return ret
+	}
+
+	testCases := map[string]struct {
+		filter    map[string]any
+		expectErr bool
+	}{
+		"name and values": {
+			filter: map[string]any{
+				names.AttrName:   "vpc-id",
+				names.AttrValues: valuesSet("vpc-12345678"),
+			},
+		},
+		"tags": {
+			filter: map[string]any{
+				names.AttrTags: map[string]any{
+					"Environment": "production",
+				},
+			},
+		},
+		"name without values": {
+			filter: map[string]any{
+				names.AttrName:   "vpc-id",
+				names.AttrValues: valuesSet(),
+			},
+			expectErr: true,
+		},
+		"values without name": {
+			filter: map[string]any{
+				names.AttrValues: valuesSet("vpc-12345678"),
+			},
+			expectErr: true,
+		},
+		"exclude only": {
+			filter: map[string]any{
+				"exclude": true,
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filters := filtersSchema.ZeroValue().(*schema.Set)
+			filters.Add(testCase.filter)
+
+			err := tfec2.ValidateCustomFilters(filters)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("ValidateCustomFilters() error = %v, expectErr %t", err, want)
+			}
+		})
+	}
+}
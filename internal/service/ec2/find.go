@@ -2855,6 +2855,30 @@ func findManagedPrefixListEntries(ctx context.Context, conn *ec2.Client, input *
 	return output, nil
 }
 
+func findManagedPrefixListAssociations(ctx context.Context, conn *ec2.Client, input *ec2.GetManagedPrefixListAssociationsInput) ([]awstypes.PrefixListAssociation, error) {
+	var output []awstypes.PrefixListAssociation
+
+	pages := ec2.NewGetManagedPrefixListAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidPrefixListIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PrefixListAssociations...)
+	}
+
+	return output, nil
+}
+
 func findManagedPrefixListEntriesByID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.PrefixListEntry, error) {
 	input := ec2.GetManagedPrefixListEntriesInput{
 		PrefixListId: aws.String(id),
@@ -2875,6 +2899,23 @@ func findManagedPrefixListEntryByIDAndCIDR(ctx context.Context, conn *ec2.Client
 	}))
 }
 
+func findManagedPrefixListEntryByIDCIDRAndVersion(ctx context.Context, conn *ec2.Client, id, cidr string, version int64) (*awstypes.PrefixListEntry, error) {
+	input := ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId:  aws.String(id),
+		TargetVersion: aws.Int64(version),
+	}
+
+	output, err := findManagedPrefixListEntries(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output, func(v awstypes.PrefixListEntry) bool {
+		return aws.ToString(v.Cidr) == cidr
+	}))
+}
+
 // findMainRouteTableAssociationByID returns the main route table association corresponding to the specified identifier.
 // Returns NotFoundError if no route table association is found.
 func findMainRouteTableAssociationByID(ctx context.Context, conn *ec2.Client, associationID string) (*awstypes.RouteTableAssociation, error) {
@@ -76,6 +76,10 @@ func dataSourceLocalGatewayRead(ctx context.Context, d *schema.ResourceData, met
 		)...)
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
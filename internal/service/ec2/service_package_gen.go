@@ -26,6 +26,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Capacity Block Offering",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newNetworkBorderGroupsDataSource,
+			TypeName: "aws_ec2_network_border_groups",
+			Name:     "Network Border Groups",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSpotDataFeedSubscriptionDataSource,
 			TypeName: "aws_spot_datafeed_subscription",
@@ -404,6 +410,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Managed Prefix List",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourceManagedPrefixListEntry,
+			TypeName: "aws_ec2_managed_prefix_list_entry",
+			Name:     "Managed Prefix List Entry",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  dataSourceManagedPrefixLists,
 			TypeName: "aws_ec2_managed_prefix_lists",
@@ -424,6 +436,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Tags:     unique.Make(inttypes.ServicePackageResourceTags{}),
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourcePrefixListReferences,
+			TypeName: "aws_ec2_prefix_list_references",
+			Name:     "Prefix List References",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  dataSourcePublicIPv4Pool,
 			TypeName: "aws_ec2_public_ipv4_pool",
@@ -643,6 +661,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Prefix List",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourcePrefixLists,
+			TypeName: "aws_prefix_lists",
+			Name:     "Prefix Lists",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  dataSourceRoute,
 			TypeName: "aws_route",
@@ -1033,12 +1057,30 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceManagedPrefixListAllowlist,
+			TypeName: "aws_ec2_managed_prefix_list_allowlist",
+			Name:     "Managed Prefix List Allowlist",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceManagedPrefixListEntry,
 			TypeName: "aws_ec2_managed_prefix_list_entry",
 			Name:     "Managed Prefix List Entry",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceManagedPrefixListEntries,
+			TypeName: "aws_ec2_managed_prefix_list_entries",
+			Name:     "Managed Prefix List Entries",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceManagedPrefixListIPAMPoolSync,
+			TypeName: "aws_ec2_managed_prefix_list_ipam_pool_sync",
+			Name:     "Managed Prefix List IPAM Pool Sync",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceNetworkInsightsAnalysis,
 			TypeName: "aws_ec2_network_insights_analysis",
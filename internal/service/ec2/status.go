@@ -269,6 +269,28 @@ func statusManagedPrefixListState(ctx context.Context, conn *ec2.Client, id stri
 	}
 }
 
+// statusManagedPrefixListVersion returns whether the specified version (or a later one) of a managed prefix list
+// is visible in DescribeManagedPrefixLists and the prefix list's modification has completed.
+func statusManagedPrefixListVersion(ctx context.Context, conn *ec2.Client, id string, version int64) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findManagedPrefixListByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.State == awstypes.PrefixListStateModifyFailed {
+			return output, string(output.State), nil
+		}
+
+		return output, strconv.FormatBool(output.State == awstypes.PrefixListStateModifyComplete && aws.ToInt64(output.Version) >= version), nil
+	}
+}
+
 func statusPlacementGroup(ctx context.Context, conn *ec2.Client, name string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findPlacementGroupByName(ctx, conn, name)
@@ -10,13 +10,20 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 const eventualConsistencyTimeout = 5 * time.Minute
 
+// CreateTags and DeleteTags accept up to 1000 resource IDs but AWS recommends smaller batches.
+const tagsBatchMaxResources = 100
+
 // createTags creates ec2 service tags for new resources.
 func createTags(ctx context.Context, conn *ec2.Client, identifier string, tags []awstypes.Tag, optFns ...func(*ec2.Options)) error {
 	if len(tags) == 0 {
@@ -105,3 +112,45 @@ func tagsSchemaConflictsWith(conflictsWith []string) *schema.Schema {
 
 	return &v
 }
+
+// updateTagsBatched updates ec2 service tags.
+// It is equivalent to updateTags except that removed and updated tags are applied concurrently and
+// the CreateTags and DeleteTags calls are combined with those for other resources receiving identical changes.
+func updateTagsBatched(ctx context.Context, c *conns.AWSClient, identifier string, oldTagsMap, newTagsMap any) error {
+	conn := c.EC2Client(ctx)
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	ctx = tflog.SetField(ctx, logging.KeyResourceId, identifier)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.EC2)
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.EC2)
+
+	untag := func(ctx context.Context, identifiers []string, tags tftags.KeyValueTags) error {
+		input := ec2.DeleteTagsInput{
+			Resources: identifiers,
+			Tags:      svcTags(tags),
+		}
+
+		_, err := conn.DeleteTags(ctx, &input)
+
+		return err
+	}
+	tag := func(ctx context.Context, identifiers []string, tags tftags.KeyValueTags) error {
+		input := ec2.CreateTagsInput{
+			Resources: identifiers,
+			Tags:      svcTags(tags),
+		}
+
+		_, err := conn.CreateTags(ctx, &input)
+
+		return err
+	}
+
+	// Tag operations for different clients or Regions must never be combined.
+	keyPrefix := fmt.Sprintf("%p/%s", conn, conn.Options().Region)
+
+	return c.TagsBatcher(ctx, names.EC2, tagsBatchMaxResources).Update(ctx, keyPrefix, identifier, removedTags, updatedTags, untag, tag)
+}
@@ -206,5 +206,5 @@ func updateTags(ctx context.Context, conn *ec2.Client, identifier string, oldTag
 // UpdateTags updates ec2 service tags.
 // It is called from outside this package.
 func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
-	return updateTags(ctx, meta.(*conns.AWSClient).EC2Client(ctx), identifier, oldTags, newTags)
+	return updateTagsBatched(ctx, meta.(*conns.AWSClient), identifier, oldTags, newTags)
 }
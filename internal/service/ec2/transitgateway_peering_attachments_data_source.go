@@ -44,6 +44,10 @@ func dataSourceTransitGatewayPeeringAttachmentsRead(ctx context.Context, d *sche
 
 	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -65,6 +65,10 @@ func dataSourceTransitGatewayRouteTableRead(ctx context.Context, d *schema.Resou
 
 	input := &ec2.DescribeTransitGatewayRouteTablesInput{}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
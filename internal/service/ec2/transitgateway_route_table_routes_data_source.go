@@ -65,6 +65,10 @@ func dataSourceTransitGatewayRouteTableRoutesRead(ctx context.Context, d *schema
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	tgwRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input := &ec2.SearchTransitGatewayRoutesInput{
 		Filters:                    newCustomFilterList(d.Get(names.AttrFilter).(*schema.Set)),
 		TransitGatewayRouteTableId: aws.String(tgwRouteTableID),
@@ -149,6 +149,10 @@ func dataSourceVPCRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		input.VpcIds = []string{v.(string)}
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(d.Get(names.AttrFilter).(*schema.Set))...)
 	input.Filters = append(input.Filters, tagFilters(ctx)...)
 
@@ -51,6 +51,8 @@ func resourceVPCEndpoint() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceVPCEndpointCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -230,7 +232,7 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 	partition := meta.(*conns.AWSClient).Partition(ctx)
 
-	serviceName := d.Get(names.AttrServiceName).(string)
+	serviceName, targetName := d.Get(names.AttrServiceName).(string), vpcEndpointTargetName(d)
 	input := &ec2.CreateVpcEndpointInput{
 		ClientToken:       aws.String(id.UniqueId()),
 		PrivateDnsEnabled: aws.Bool(d.Get("private_dns_enabled").(bool)),
@@ -304,7 +306,7 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating EC2 VPC Endpoint (%s): %s", serviceName, err)
+		return sdkdiag.AppendErrorf(diags, "creating EC2 VPC Endpoint (%s): %s", targetName, err)
 	}
 
 	vpce := output.VpcEndpoint
@@ -317,7 +319,7 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if _, err := waitVPCEndpointAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint (%s) create: %s", serviceName, err)
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPC Endpoint (%s) create: %s", targetName, err)
 	}
 
 	// For partitions not supporting tag-on-create, attempt tag after create.
@@ -330,7 +332,7 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting EC2 VPC Endpoint (%s) tags: %s", serviceName, err)
+			return sdkdiag.AppendErrorf(diags, "setting EC2 VPC Endpoint (%s) tags: %s", targetName, err)
 		}
 	}
 
@@ -556,6 +558,46 @@ func resourceVPCEndpointDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceVPCEndpointCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	// Resource and ServiceNetwork endpoints connect to a VPC Lattice resource configuration or service network.
+	if !d.NewValueKnown("vpc_endpoint_type") {
+		return nil
+	}
+
+	vpcEndpointType := awstypes.VpcEndpointType(d.Get("vpc_endpoint_type").(string))
+
+	for _, v := range []struct {
+		key             string
+		vpcEndpointType awstypes.VpcEndpointType
+	}{
+		{"resource_configuration_arn", awstypes.VpcEndpointTypeResource},
+		{"service_network_arn", awstypes.VpcEndpointTypeServiceNetwork},
+	} {
+		// An unknown value is always set.
+		configured := !d.NewValueKnown(v.key) || d.Get(v.key).(string) != ""
+
+		switch {
+		case configured && vpcEndpointType != v.vpcEndpointType:
+			return fmt.Errorf(`"%s" requires "vpc_endpoint_type" to be %q, got %q`, v.key, v.vpcEndpointType, vpcEndpointType)
+		case !configured && vpcEndpointType == v.vpcEndpointType:
+			return fmt.Errorf(`"vpc_endpoint_type" %q requires "%s" to be set`, vpcEndpointType, v.key)
+		}
+	}
+
+	return nil
+}
+
+// vpcEndpointTargetName returns the name of the service, resource configuration or service network that the VPC endpoint connects to.
+func vpcEndpointTargetName(d *schema.ResourceData) string {
+	for _, k := range []string{names.AttrServiceName, "resource_configuration_arn", "service_network_arn"} {
+		if v, ok := d.GetOk(k); ok {
+			return v.(string)
+		}
+	}
+
+	return ""
+}
+
 func vpcEndpointAccept(ctx context.Context, conn *ec2.Client, vpceID, serviceName string, timeout time.Duration) error {
 	serviceConfiguration, err := findVPCEndpointServiceConfigurationByServiceName(ctx, conn, serviceName)
 
@@ -156,6 +156,10 @@ func dataSourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceDat
 			svcTags(tftags.New(ctx, v.(map[string]any))))...)
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set))...)
 
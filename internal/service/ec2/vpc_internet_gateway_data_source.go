@@ -86,6 +86,10 @@ func dataSourceInternetGatewayRead(ctx context.Context, d *schema.ResourceData,
 	input.Filters = append(input.Filters, newTagFilterList(
 		svcTags(tftags.New(ctx, tags.(map[string]any))),
 	)...)
+	if err := validateCustomFilters(filter.(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		filter.(*schema.Set),
 	)...)
@@ -116,6 +116,10 @@ func dataSourceIPAMPoolRead(ctx context.Context, d *schema.ResourceData, meta an
 		input.IpamPoolIds = []string{v.(string)}
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
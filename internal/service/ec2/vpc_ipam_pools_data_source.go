@@ -110,6 +110,10 @@ func dataSourceIPAMPoolsRead(ctx context.Context, d *schema.ResourceData, meta a
 
 	input := &ec2.DescribeIpamPoolsInput{}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
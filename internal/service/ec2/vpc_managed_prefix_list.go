@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -35,13 +36,19 @@ func resourceManagedPrefixList() *schema.Resource {
 		DeleteWithoutTimeout: resourceManagedPrefixListDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				d.Set("adopt_existing", false)
+				d.Set("auto_grow_max_entries", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ComputedIf(names.AttrVersion, func(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
 				return diff.HasChange("entry")
 			}),
+			resourceManagedPrefixListEffectiveMaxEntriesCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -51,10 +58,29 @@ func resourceManagedPrefixList() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(managedPrefixListAddressFamily_Values(), false),
 			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_grow_max_entries": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"auto_grow_max_entries_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"effective_max_entries": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"entry": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -88,6 +114,7 @@ func resourceManagedPrefixList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"share_with":      managedPrefixListShareWithSchema(),
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrVersion: {
@@ -102,12 +129,36 @@ func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
 
 	name := d.Get(names.AttrName).(string)
+
+	if d.Get("adopt_existing").(bool) {
+		pl, err := findManagedPrefixList(ctx, conn, &ec2.DescribeManagedPrefixListsInput{
+			Filters: newAttributeFilterList(map[string]string{
+				"owner-id":         meta.(*conns.AWSClient).AccountID(ctx),
+				"prefix-list-name": name,
+			}),
+		})
+
+		switch {
+		case tfresource.NotFound(err):
+			// Nothing to adopt, create a new prefix list.
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) to adopt: %s", name, err)
+		default:
+			if err := adoptManagedPrefixList(ctx, conn, d, pl); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			return append(diags, resourceManagedPrefixListCreateShare(ctx, d, meta, name, aws.ToString(pl.PrefixListArn))...)
+		}
+	}
+
 	input := &ec2.CreateManagedPrefixListInput{
 		AddressFamily:     aws.String(d.Get("address_family").(string)),
 		ClientToken:       aws.String(id.UniqueId()),
-		MaxEntries:        aws.Int32(int32(d.Get("max_entries").(int))),
+		MaxEntries:        aws.Int32(expandManagedPrefixListMaxEntries(d)),
 		PrefixListName:    aws.String(name),
 		TagSpecifications: getTagSpecificationsIn(ctx, awstypes.ResourceTypePrefixList),
 	}
@@ -128,6 +179,27 @@ func resourceManagedPrefixListCreate(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Managed Prefix List (%s) create: %s", d.Id(), err)
 	}
 
+	return append(diags, resourceManagedPrefixListCreateShare(ctx, d, meta, name, aws.ToString(output.PrefixList.PrefixListArn))...)
+}
+
+// resourceManagedPrefixListCreateShare shares a newly created or adopted prefix list, then reads it.
+func resourceManagedPrefixListCreateShare(ctx context.Context, d *schema.ResourceData, meta any, name, prefixListARN string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v, ok := d.GetOk("share_with"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		arn, err := createManagedPrefixListResourceShare(ctx, meta.(*conns.AWSClient).RAMClient(ctx), name, prefixListARN, tfMap)
+
+		if arn != "" {
+			tfMap["resource_share_arn"] = arn
+			d.Set("share_with", []any{tfMap})
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "sharing EC2 Managed Prefix List (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceManagedPrefixListRead(ctx, d, meta)...)
 }
 
@@ -159,11 +231,39 @@ func resourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("entry", flattenPrefixListEntries(prefixListEntries)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting entry: %s", err)
 	}
-	d.Set("max_entries", pl.MaxEntries)
+	d.Set("effective_max_entries", pl.MaxEntries)
+	// When auto-growing, max_entries is the configured minimum rather than the prefix list's maximum.
+	if !d.Get("auto_grow_max_entries").(bool) {
+		d.Set("max_entries", pl.MaxEntries)
+	}
 	d.Set(names.AttrName, pl.PrefixListName)
 	d.Set(names.AttrOwnerID, pl.OwnerId)
 	d.Set(names.AttrVersion, pl.Version)
 
+	if arn := d.Get("share_with.0.resource_share_arn").(string); arn != "" {
+		ramConn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+		resourceShare, err := findManagedPrefixListResourceShareByARN(ctx, ramConn, arn)
+
+		switch {
+		case tfresource.NotFound(err):
+			log.Printf("[WARN] RAM Resource Share (%s) for EC2 Managed Prefix List (%s) not found", arn, d.Id())
+			d.Set("share_with", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) RAM Resource Share (%s): %s", d.Id(), arn, err)
+		default:
+			associations, err := findManagedPrefixListResourceSharePrincipalAssociations(ctx, ramConn, arn)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) RAM Resource Share (%s) principals: %s", d.Id(), arn, err)
+			}
+
+			if err := d.Set("share_with", flattenManagedPrefixListResourceShare(resourceShare, associations)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting share_with: %s", err)
+			}
+		}
+	}
+
 	setTagsOut(ctx, pl.Tags)
 
 	return diags
@@ -173,128 +273,92 @@ func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
 
 	// MaxEntries & Entry cannot change in the same API call.
 	//   If MaxEntry is increasing, complete before updating entry(s)
 	//   If MaxEntry is decreasing, complete after updating entry(s)
 	maxEntryChangedDecrease := false
-	var newMaxEntryInt int32
-
-	if d.HasChange("max_entries") {
-		oldMaxEntry, newMaxEntry := d.GetChange("max_entries")
-		newMaxEntryInt = int32(d.Get("max_entries").(int))
+	oldMaxEntry, _ := d.GetChange("effective_max_entries")
+	newMaxEntryInt := expandManagedPrefixListMaxEntries(d)
 
-		if newMaxEntry.(int) < oldMaxEntry.(int) {
-			maxEntryChangedDecrease = true
-		} else {
-			err := updateMaxEntry(ctx, conn, d.Id(), newMaxEntryInt)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s) increased MaxEntries : %s", d.Id(), err)
-			}
+	if newMaxEntryInt < int32(oldMaxEntry.(int)) {
+		maxEntryChangedDecrease = true
+	} else if newMaxEntryInt > int32(oldMaxEntry.(int)) {
+		err := updateMaxEntry(ctx, conn, d.Id(), newMaxEntryInt)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s) increased MaxEntries : %s", d.Id(), err)
 		}
 	}
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "max_entries") {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "auto_grow_max_entries", "auto_grow_max_entries_limit", "effective_max_entries", "max_entries", "share_with") {
 		input := &ec2.ModifyManagedPrefixListInput{
 			PrefixListId: aws.String(d.Id()),
 		}
 
-		input.PrefixListName = aws.String(d.Get(names.AttrName).(string))
-		currentVersion := int64(d.Get(names.AttrVersion).(int))
-		wait := false
-
-		oldAttr, newAttr := d.GetChange("entry")
-		os := oldAttr.(*schema.Set)
-		ns := newAttr.(*schema.Set)
-
-		if addEntries := ns.Difference(os); addEntries.Len() > 0 {
-			input.AddEntries = expandAddPrefixListEntries(addEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
+		if d.HasChange(names.AttrName) {
+			input.PrefixListName = aws.String(d.Get(names.AttrName).(string))
 		}
 
-		if removeEntries := os.Difference(ns); removeEntries.Len() > 0 {
-			input.RemoveEntries = expandRemovePrefixListEntries(removeEntries.List())
-			input.CurrentVersion = aws.Int64(currentVersion)
-			wait = true
-		}
+		o, n := d.GetChange("entry")
+		input.AddEntries, input.RemoveEntries = managedPrefixListEntriesDiff(
+			expandPrefixListEntriesToMap(o.(*schema.Set)),
+			expandPrefixListEntriesToMap(n.(*schema.Set)),
+		)
 
-		// Prevent the following error on description-only updates:
-		//   InvalidParameterValue: Request cannot contain Cidr #.#.#.#/# in both AddPrefixListEntries and RemovePrefixListEntries
-		// Attempting to just delete the RemoveEntries item causes:
-		//   InvalidRequest: The request received was invalid.
-		// Therefore it seems we must issue two ModifyManagedPrefixList calls,
-		// one with a collection of all description-only removals and the
-		// second one will add them all back.
-		if len(input.AddEntries) > 0 && len(input.RemoveEntries) > 0 {
-			descriptionOnlyRemovals := []awstypes.RemovePrefixListEntry{}
-			removals := []awstypes.RemovePrefixListEntry{}
-
-			for _, removeEntry := range input.RemoveEntries {
-				inAddAndRemove := false
-
-				for _, addEntry := range input.AddEntries {
-					if aws.ToString(addEntry.Cidr) == aws.ToString(removeEntry.Cidr) {
-						inAddAndRemove = true
-						break
-					}
-				}
-
-				if inAddAndRemove {
-					descriptionOnlyRemovals = append(descriptionOnlyRemovals, removeEntry)
-				} else {
-					removals = append(removals, removeEntry)
-				}
+		if len(input.AddEntries) > 0 || len(input.RemoveEntries) > 0 {
+			// Entry changes, including any name change, are applied against the prefix list's current version.
+			if err := modifyManagedPrefixListEntries(ctx, conn, input, managedPrefixListTimeout); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
 			}
+		} else if input.PrefixListName != nil {
+			_, err := conn.ModifyManagedPrefixList(ctx, input)
 
-			if len(descriptionOnlyRemovals) > 0 {
-				input := ec2.ModifyManagedPrefixListInput{
-					CurrentVersion: input.CurrentVersion,
-					PrefixListId:   aws.String(d.Id()),
-					RemoveEntries:  descriptionOnlyRemovals,
-				}
-				_, err := conn.ModifyManagedPrefixList(ctx, &input)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
+			}
+		}
+	}
 
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
-				}
+	// Only decrease MaxEntries after entry(s) have had opportunity to be removed
+	if maxEntryChangedDecrease {
+		err := updateMaxEntry(ctx, conn, d.Id(), newMaxEntryInt)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s) decreased MaxEntries : %s", d.Id(), err)
+		}
+	}
 
-				managedPrefixList, err := waitManagedPrefixListModified(ctx, conn, d.Id())
+	if d.HasChanges(names.AttrName, "share_with") {
+		ramConn := meta.(*conns.AWSClient).RAMClient(ctx)
+		o, n := d.GetChange("share_with")
+		name := d.Get(names.AttrName).(string)
 
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "waiting for EC2 Managed Prefix List (%s) update: %s", d.Id(), err)
-				}
+		switch os, ns := o.([]any), n.([]any); {
+		case len(os) > 0 && os[0] != nil && len(ns) > 0 && ns[0] != nil:
+			oMap, nMap := os[0].(map[string]any), ns[0].(map[string]any)
+			arn := oMap["resource_share_arn"].(string)
 
-				input.CurrentVersion = managedPrefixList.Version
+			if err := updateManagedPrefixListResourceShare(ctx, ramConn, arn, name, oMap, nMap, d.HasChange(names.AttrName)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s) sharing: %s", d.Id(), err)
 			}
+		case len(os) > 0 && os[0] != nil:
+			arn := os[0].(map[string]any)["resource_share_arn"].(string)
 
-			if len(removals) > 0 {
-				input.RemoveEntries = removals
-			} else {
-				// Prevent this error if RemoveEntries is list with no elements after removals:
-				//   InvalidRequest: The request received was invalid.
-				input.RemoveEntries = nil
+			if err := deleteManagedPrefixListResourceShare(ctx, ramConn, arn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s) sharing: %s", d.Id(), err)
 			}
-		}
-
-		_, err := conn.ModifyManagedPrefixList(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s): %s", d.Id(), err)
-		}
+		case len(ns) > 0 && ns[0] != nil:
+			tfMap := ns[0].(map[string]any)
+			arn, err := createManagedPrefixListResourceShare(ctx, ramConn, name, d.Get(names.AttrARN).(string), tfMap)
 
-		if wait {
-			if _, err := waitManagedPrefixListModified(ctx, conn, d.Id()); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for EC2 Managed Prefix List (%s) update: %s", d.Id(), err)
+			if arn != "" {
+				tfMap["resource_share_arn"] = arn
+				d.Set("share_with", []any{tfMap})
 			}
-		}
-	}
 
-	// Only decrease MaxEntries after entry(s) have had opportunity to be removed
-	if maxEntryChangedDecrease {
-		err := updateMaxEntry(ctx, conn, d.Id(), newMaxEntryInt)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s) decreased MaxEntries : %s", d.Id(), err)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List (%s) sharing: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -305,6 +369,13 @@ func resourceManagedPrefixListDelete(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	if arn := d.Get("share_with.0.resource_share_arn").(string); arn != "" {
+		if err := deleteManagedPrefixListResourceShare(ctx, meta.(*conns.AWSClient).RAMClient(ctx), arn); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Managed Prefix List (%s) sharing: %s", d.Id(), err)
+		}
+	}
 
 	log.Printf("[INFO] Deleting EC2 Managed Prefix List: %s", d.Id())
 	input := ec2.DeleteManagedPrefixListInput{
@@ -327,6 +398,96 @@ func resourceManagedPrefixListDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+// adoptManagedPrefixList brings an existing prefix list in line with the configuration and sets it as the resource's ID.
+// Entries are only reconciled if configured, and tags not in the configuration are removed.
+func adoptManagedPrefixList(ctx context.Context, conn *ec2.Client, d *schema.ResourceData, pl *awstypes.ManagedPrefixList) error {
+	id := aws.ToString(pl.PrefixListId)
+
+	if v := d.Get("address_family").(string); v != aws.ToString(pl.AddressFamily) {
+		return fmt.Errorf("adopting EC2 Managed Prefix List (%s): address family (%s) does not match configured address family (%s)", id, aws.ToString(pl.AddressFamily), v)
+	}
+
+	d.SetId(id)
+
+	// MaxEntries & Entry cannot change in the same API call.
+	maxEntries := expandManagedPrefixListMaxEntries(d)
+	if maxEntries > aws.ToInt32(pl.MaxEntries) {
+		if err := updateMaxEntry(ctx, conn, id, maxEntries); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("entry"); ok {
+		prefixListEntries, err := findManagedPrefixListEntriesByID(ctx, conn, id)
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 Managed Prefix List (%s) Entries: %w", id, err)
+		}
+
+		o := make(map[string]string, len(prefixListEntries))
+		for _, v := range prefixListEntries {
+			o[aws.ToString(v.Cidr)] = aws.ToString(v.Description)
+		}
+
+		input := &ec2.ModifyManagedPrefixListInput{
+			PrefixListId: aws.String(id),
+		}
+		input.AddEntries, input.RemoveEntries = managedPrefixListEntriesDiff(o, expandPrefixListEntriesToMap(v.(*schema.Set)))
+
+		if len(input.AddEntries) > 0 || len(input.RemoveEntries) > 0 {
+			if err := modifyManagedPrefixListEntries(ctx, conn, input, managedPrefixListTimeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if maxEntries < aws.ToInt32(pl.MaxEntries) {
+		if err := updateMaxEntry(ctx, conn, id, maxEntries); err != nil {
+			return err
+		}
+	}
+
+	if err := updateTags(ctx, conn, id, keyValueTags(ctx, pl.Tags), keyValueTags(ctx, getTagsIn(ctx))); err != nil {
+		return fmt.Errorf("updating EC2 Managed Prefix List (%s) tags: %w", id, err)
+	}
+
+	return nil
+}
+
+// resourceManagedPrefixListEffectiveMaxEntriesCustomizeDiff plans the prefix list's maximum number of entries
+// and reports entries that can't be accommodated by growing it.
+func resourceManagedPrefixListEffectiveMaxEntriesCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	autoGrow := diff.Get("auto_grow_max_entries").(bool)
+
+	if !diff.NewValueKnown("max_entries") || (autoGrow && !diff.NewValueKnown("entry")) {
+		return diff.SetNewComputed("effective_max_entries")
+	}
+
+	if autoGrow {
+		if n, limit := diff.Get("entry").(*schema.Set).Len(), diff.Get("auto_grow_max_entries_limit").(int); limit > 0 && n > limit {
+			return fmt.Errorf("%d entries exceed auto_grow_max_entries_limit (%d)", n, limit)
+		}
+	}
+
+	if o, n := diff.Get("effective_max_entries").(int), int(expandManagedPrefixListMaxEntries(diff)); n != o {
+		return diff.SetNew("effective_max_entries", n)
+	}
+
+	return nil
+}
+
+// expandManagedPrefixListMaxEntries returns the prefix list's maximum number of entries.
+// If auto_grow_max_entries is true, the configured max_entries is raised to the number of entries.
+func expandManagedPrefixListMaxEntries(d sdkv2.ResourceDiffer) int32 {
+	maxEntries := d.Get("max_entries").(int)
+
+	if d.Get("auto_grow_max_entries").(bool) {
+		maxEntries = max(maxEntries, d.Get("entry").(*schema.Set).Len())
+	}
+
+	return int32(maxEntries)
+}
+
 func updateMaxEntry(ctx context.Context, conn *ec2.Client, id string, maxEntries int32) error {
 	input := ec2.ModifyManagedPrefixListInput{
 		PrefixListId: aws.String(id),
@@ -381,36 +542,6 @@ func expandAddPrefixListEntries(tfList []any) []awstypes.AddPrefixListEntry {
 	return apiObjects
 }
 
-func expandRemovePrefixListEntry(tfMap map[string]any) awstypes.RemovePrefixListEntry {
-	apiObject := awstypes.RemovePrefixListEntry{}
-
-	if v, ok := tfMap["cidr"].(string); ok && v != "" {
-		apiObject.Cidr = aws.String(v)
-	}
-
-	return apiObject
-}
-
-func expandRemovePrefixListEntries(tfList []any) []awstypes.RemovePrefixListEntry {
-	if len(tfList) == 0 {
-		return nil
-	}
-
-	var apiObjects []awstypes.RemovePrefixListEntry
-
-	for _, tfMapRaw := range tfList {
-		tfMap, ok := tfMapRaw.(map[string]any)
-
-		if !ok {
-			continue
-		}
-
-		apiObjects = append(apiObjects, expandRemovePrefixListEntry(tfMap))
-	}
-
-	return apiObjects
-}
-
 func flattenPrefixListEntry(apiObject awstypes.PrefixListEntry) map[string]any {
 	tfMap := map[string]any{}
 
@@ -438,3 +569,19 @@ func flattenPrefixListEntries(apiObjects []awstypes.PrefixListEntry) []any {
 
 	return tfList
 }
+
+func expandPrefixListEntriesToMap(tfSet *schema.Set) map[string]string {
+	tfMap := make(map[string]string, tfSet.Len())
+
+	for _, tfMapRaw := range tfSet.List() {
+		v, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		tfMap[v["cidr"].(string)] = v[names.AttrDescription].(string)
+	}
+
+	return tfMap
+}
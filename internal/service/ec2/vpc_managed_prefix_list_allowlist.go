@@ -0,0 +1,630 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_managed_prefix_list_allowlist", name="Managed Prefix List Allowlist")
+func resourceManagedPrefixListAllowlist() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedPrefixListAllowlistCreate,
+		ReadWithoutTimeout:   resourceManagedPrefixListAllowlistRead,
+		UpdateWithoutTimeout: resourceManagedPrefixListAllowlistUpdate,
+		DeleteWithoutTimeout: resourceManagedPrefixListAllowlistDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceManagedPrefixListAllowlistCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      managedPrefixListAddressFamilyIPv4,
+				ValidateFunc: validation.StringInSlice(managedPrefixListAddressFamily_Values(), false),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entries": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				ValidateDiagFunc: verify.MapKeysAre(validation.ToDiagFunc(validation.IsCIDR)),
+			},
+			"max_entries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"from_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(-1, 65535),
+						},
+						"ip_protocol": {
+							Type:      schema.TypeString,
+							Required:  true,
+							StateFunc: protocolStateFunc,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"security_group_rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(-1, 65535),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[securityGroupRuleType](),
+						},
+					},
+				},
+				// The computed rule ID is excluded so that configured rules match their state counterparts.
+				Set: managedPrefixListAllowlistRuleHash,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceManagedPrefixListAllowlistCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	n := len(diff.Get("entries").(map[string]any))
+
+	if diff.GetRawConfig().GetAttr("max_entries").IsNull() {
+		// Size the prefix list to its entries. Each security group rule that references the prefix list
+		// counts as max_entries rules against the security group's rules quota.
+		if diff.Id() == "" || diff.HasChange("entries") {
+			return diff.SetNew("max_entries", max(n, 1))
+		}
+
+		return nil
+	}
+
+	if v := diff.Get("max_entries").(int); n > v {
+		return fmt.Errorf("number of entries (%d) exceeds max_entries (%d)", n, v)
+	}
+
+	return nil
+}
+
+func resourceManagedPrefixListAllowlistCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	name := d.Get(names.AttrName).(string)
+	input := ec2.CreateManagedPrefixListInput{
+		AddressFamily:  aws.String(d.Get("address_family").(string)),
+		ClientToken:    aws.String(id.UniqueId()),
+		MaxEntries:     aws.Int32(int32(d.Get("max_entries").(int))),
+		PrefixListName: aws.String(name),
+	}
+
+	output, err := conn.CreateManagedPrefixList(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Managed Prefix List Allowlist (%s): %s", name, err)
+	}
+
+	plID := aws.ToString(output.PrefixList.PrefixListId)
+
+	if _, err := waitManagedPrefixListCreated(ctx, conn, plID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Managed Prefix List Allowlist (%s) create: %s", plID, err)
+	}
+
+	// The prefix list and its security group rules are created as a unit: if any step fails,
+	// everything created so far is removed.
+	tfList, err := createManagedPrefixListAllowlist(ctx, conn, plID, d.Get("entries").(map[string]any), d.Get("security_group_rule").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		if err := deleteManagedPrefixListAllowlist(ctx, conn, plID, tfList); err != nil {
+			log.Printf("[WARN] rolling back EC2 Managed Prefix List Allowlist (%s): %s", plID, err)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Managed Prefix List Allowlist (%s): %s", name, err)
+	}
+
+	d.SetId(plID)
+	if err := d.Set("security_group_rule", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting security_group_rule: %s", err)
+	}
+
+	return append(diags, resourceManagedPrefixListAllowlistRead(ctx, d, meta)...)
+}
+
+func resourceManagedPrefixListAllowlistRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	pl, err := findManagedPrefixListByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Managed Prefix List Allowlist (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List Allowlist (%s): %s", d.Id(), err)
+	}
+
+	entries, err := findManagedPrefixListEntriesByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List Allowlist (%s) entries: %s", d.Id(), err)
+	}
+
+	rules, err := findManagedPrefixListAllowlistRules(ctx, conn, d.Id(), d.Get("security_group_rule").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List Allowlist (%s) security group rules: %s", d.Id(), err)
+	}
+
+	d.Set("address_family", pl.AddressFamily)
+	d.Set(names.AttrARN, pl.PrefixListArn)
+	d.Set("entries", flattenPrefixListEntriesToMap(entries))
+	d.Set("max_entries", pl.MaxEntries)
+	d.Set(names.AttrName, pl.PrefixListName)
+	d.Set("prefix_list_id", pl.PrefixListId)
+	if err := d.Set("security_group_rule", flattenManagedPrefixListAllowlistRules(rules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting security_group_rule: %s", err)
+	}
+	d.Set(names.AttrVersion, pl.Version)
+
+	return diags
+}
+
+func resourceManagedPrefixListAllowlistUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	o, n := d.GetChange("security_group_rule")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	remove, add := os.Difference(ns).List(), ns.Difference(os).List()
+
+	// Each security group rule that references the prefix list counts as max_entries rules against the
+	// security group's rules quota, so changes are ordered to keep usage as low as possible:
+	//   1. Revoke removed rules
+	//   2. Increase max_entries
+	//   3. Modify entries
+	//   4. Decrease max_entries
+	//   5. Authorize added rules
+	if err := revokeManagedPrefixListAllowlistRules(ctx, conn, remove); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List Allowlist (%s): %s", d.Id(), err)
+	}
+
+	oldMaxEntries, newMaxEntries := d.GetChange("max_entries")
+	if v := newMaxEntries.(int); v > oldMaxEntries.(int) {
+		if err := updateMaxEntry(ctx, conn, d.Id(), int32(v)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List Allowlist (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrName) {
+		input := ec2.ModifyManagedPrefixListInput{
+			PrefixListId:   aws.String(d.Id()),
+			PrefixListName: aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if err := modifyManagedPrefixListEntries(ctx, conn, &input, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List Allowlist (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("entries") {
+		o, n := d.GetChange("entries")
+
+		if err := syncManagedPrefixListEntries(ctx, conn, d.Id(), flex.ExpandStringValueMap(o.(map[string]any)), flex.ExpandStringValueMap(n.(map[string]any)), timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List Allowlist (%s): %s", d.Id(), err)
+		}
+	}
+
+	if v := newMaxEntries.(int); v < oldMaxEntries.(int) {
+		if err := updateMaxEntry(ctx, conn, d.Id(), int32(v)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List Allowlist (%s): %s", d.Id(), err)
+		}
+	}
+
+	tfList := os.Intersection(ns).List()
+	added, err := authorizeManagedPrefixListAllowlistRules(ctx, conn, d.Id(), add)
+	tfList = append(tfList, added...)
+
+	// Record the rules that were authorized, even on error, so that they're managed.
+	if err := d.Set("security_group_rule", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting security_group_rule: %s", err)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List Allowlist (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceManagedPrefixListAllowlistRead(ctx, d, meta)...)
+}
+
+func resourceManagedPrefixListAllowlistDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	log.Printf("[INFO] Deleting EC2 Managed Prefix List Allowlist: %s", d.Id())
+	if err := deleteManagedPrefixListAllowlist(ctx, conn, d.Id(), d.Get("security_group_rule").(*schema.Set).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Managed Prefix List Allowlist (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// createManagedPrefixListAllowlist adds entries to a new prefix list and authorizes the security group rules that reference it.
+// The rules that were authorized are returned with their IDs, even on error.
+func createManagedPrefixListAllowlist(ctx context.Context, conn *ec2.Client, plID string, entries map[string]any, rules []any, timeout time.Duration) ([]any, error) {
+	if err := syncManagedPrefixListEntries(ctx, conn, plID, nil, flex.ExpandStringValueMap(entries), timeout); err != nil {
+		return nil, err
+	}
+
+	return authorizeManagedPrefixListAllowlistRules(ctx, conn, plID, rules)
+}
+
+// deleteManagedPrefixListAllowlist revokes the security group rules that reference a prefix list and then deletes the prefix list.
+func deleteManagedPrefixListAllowlist(ctx context.Context, conn *ec2.Client, plID string, rules []any) error {
+	if err := revokeManagedPrefixListAllowlistRules(ctx, conn, rules); err != nil {
+		return err
+	}
+
+	input := ec2.DeleteManagedPrefixListInput{
+		PrefixListId: aws.String(plID),
+	}
+	_, err := conn.DeleteManagedPrefixList(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidPrefixListIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Managed Prefix List (%s): %w", plID, err)
+	}
+
+	if _, err := waitManagedPrefixListDeleted(ctx, conn, plID); err != nil {
+		return fmt.Errorf("waiting for EC2 Managed Prefix List (%s) delete: %w", plID, err)
+	}
+
+	return nil
+}
+
+// authorizeManagedPrefixListAllowlistRules authorizes each security group rule, referencing the prefix list as the rule's source or destination.
+// The rules that were authorized are returned with their IDs, even on error.
+func authorizeManagedPrefixListAllowlistRules(ctx context.Context, conn *ec2.Client, plID string, tfList []any) ([]any, error) {
+	var authorized []any
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		ruleID, err := authorizeManagedPrefixListAllowlistRule(ctx, conn, plID, tfMap)
+
+		if err != nil {
+			return authorized, err
+		}
+
+		tfMap["security_group_rule_id"] = ruleID
+		authorized = append(authorized, tfMap)
+	}
+
+	return authorized, nil
+}
+
+func authorizeManagedPrefixListAllowlistRule(ctx context.Context, conn *ec2.Client, plID string, tfMap map[string]any) (string, error) {
+	securityGroupID := tfMap["security_group_id"].(string)
+	ruleType := securityGroupRuleType(tfMap[names.AttrType].(string))
+	ipPermission := expandManagedPrefixListAllowlistRuleIPPermission(plID, tfMap)
+
+	conns.GlobalMutexKV.Lock(securityGroupID)
+	defer conns.GlobalMutexKV.Unlock(securityGroupID)
+
+	var rules []awstypes.SecurityGroupRule
+
+	switch ruleType {
+	case securityGroupRuleTypeIngress:
+		input := ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: []awstypes.IpPermission{ipPermission},
+		}
+		output, err := conn.AuthorizeSecurityGroupIngress(ctx, &input)
+
+		if err != nil {
+			return "", fmt.Errorf("authorizing Security Group (%s) %s rule: %w", securityGroupID, ruleType, err)
+		}
+
+		rules = output.SecurityGroupRules
+	case securityGroupRuleTypeEgress:
+		input := ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(securityGroupID),
+			IpPermissions: []awstypes.IpPermission{ipPermission},
+		}
+		output, err := conn.AuthorizeSecurityGroupEgress(ctx, &input)
+
+		if err != nil {
+			return "", fmt.Errorf("authorizing Security Group (%s) %s rule: %w", securityGroupID, ruleType, err)
+		}
+
+		rules = output.SecurityGroupRules
+	}
+
+	if len(rules) == 0 {
+		return "", fmt.Errorf("authorizing Security Group (%s) %s rule: %w", securityGroupID, ruleType, tfresource.NewEmptyResultError(nil))
+	}
+
+	return aws.ToString(rules[0].SecurityGroupRuleId), nil
+}
+
+// revokeManagedPrefixListAllowlistRules revokes each security group rule by ID. Rules that no longer exist are ignored.
+func revokeManagedPrefixListAllowlistRules(ctx context.Context, conn *ec2.Client, tfList []any) error {
+	var errs []error
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		ruleID := tfMap["security_group_rule_id"].(string)
+		if ruleID == "" {
+			continue
+		}
+
+		if err := revokeManagedPrefixListAllowlistRule(ctx, conn, tfMap["security_group_id"].(string), securityGroupRuleType(tfMap[names.AttrType].(string)), ruleID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func revokeManagedPrefixListAllowlistRule(ctx context.Context, conn *ec2.Client, securityGroupID string, ruleType securityGroupRuleType, ruleID string) error {
+	conns.GlobalMutexKV.Lock(securityGroupID)
+	defer conns.GlobalMutexKV.Unlock(securityGroupID)
+
+	var err error
+
+	switch ruleType {
+	case securityGroupRuleTypeIngress:
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: []string{ruleID},
+		}
+		_, err = conn.RevokeSecurityGroupIngress(ctx, &input)
+	case securityGroupRuleTypeEgress:
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: []string{ruleID},
+		}
+		_, err = conn.RevokeSecurityGroupEgress(ctx, &input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidPermissionNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("revoking Security Group (%s) %s rule (%s): %w", securityGroupID, ruleType, ruleID, err)
+	}
+
+	return nil
+}
+
+// findManagedPrefixListAllowlistRules returns the security group rules in state that still exist.
+// Rules are looked up in the security groups associated with the prefix list and in the security groups of
+// the rules already in state, as associations are eventually consistent.
+// Other rules that reference the prefix list, e.g. those managed by other resources, are not returned unless
+// there are no rules in state (i.e. on import), in which case all rules that reference the prefix list are returned.
+func findManagedPrefixListAllowlistRules(ctx context.Context, conn *ec2.Client, plID string, tfList []any) ([]awstypes.SecurityGroupRule, error) {
+	input := ec2.GetManagedPrefixListAssociationsInput{
+		PrefixListId: aws.String(plID),
+	}
+	associations, err := findManagedPrefixListAssociations(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var securityGroupIDs []string
+	seen, ruleIDs := make(map[string]bool), make(map[string]bool)
+	addSecurityGroupID := func(id string) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			securityGroupIDs = append(securityGroupIDs, id)
+		}
+	}
+
+	for _, v := range tfList {
+		if tfMap, ok := v.(map[string]any); ok {
+			addSecurityGroupID(tfMap["security_group_id"].(string))
+			if v := tfMap["security_group_rule_id"].(string); v != "" {
+				ruleIDs[v] = true
+			}
+		}
+	}
+	for _, v := range associations {
+		if id := aws.ToString(v.ResourceId); strings.HasPrefix(id, "sg-") {
+			addSecurityGroupID(id)
+		}
+	}
+
+	var rules []awstypes.SecurityGroupRule
+
+	for _, securityGroupID := range securityGroupIDs {
+		output, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output {
+			if aws.ToString(v.PrefixListId) != plID {
+				continue
+			}
+
+			if len(ruleIDs) > 0 && !ruleIDs[aws.ToString(v.SecurityGroupRuleId)] {
+				continue
+			}
+
+			rules = append(rules, v)
+		}
+	}
+
+	return rules, nil
+}
+
+func expandManagedPrefixListAllowlistRuleIPPermission(plID string, tfMap map[string]any) awstypes.IpPermission {
+	protocol := protocolForValue(tfMap["ip_protocol"].(string))
+	prefixListID := awstypes.PrefixListId{
+		PrefixListId: aws.String(plID),
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		prefixListID.Description = aws.String(v)
+	}
+
+	apiObject := awstypes.IpPermission{
+		IpProtocol:    aws.String(protocol),
+		PrefixListIds: []awstypes.PrefixListId{prefixListID},
+	}
+
+	if protocol != "-1" {
+		apiObject.FromPort = aws.Int32(int32(tfMap["from_port"].(int)))
+		apiObject.ToPort = aws.Int32(int32(tfMap["to_port"].(int)))
+	}
+
+	return apiObject
+}
+
+func flattenManagedPrefixListAllowlistRules(apiObjects []awstypes.SecurityGroupRule) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		protocol := protocolForValue(aws.ToString(apiObject.IpProtocol))
+		tfMap := map[string]any{
+			names.AttrDescription:    aws.ToString(apiObject.Description),
+			"ip_protocol":            protocol,
+			"security_group_id":      aws.ToString(apiObject.GroupId),
+			"security_group_rule_id": aws.ToString(apiObject.SecurityGroupRuleId),
+			names.AttrType:           string(securityGroupRuleTypeIngress),
+		}
+
+		if aws.ToBool(apiObject.IsEgress) {
+			tfMap[names.AttrType] = string(securityGroupRuleTypeEgress)
+		}
+
+		// Ports aren't meaningful for all protocols.
+		if protocol != "-1" {
+			tfMap["from_port"] = int(aws.ToInt32(apiObject.FromPort))
+			tfMap["to_port"] = int(aws.ToInt32(apiObject.ToPort))
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func managedPrefixListAllowlistRuleHash(v any) int {
+	var buf bytes.Buffer
+
+	tfMap := v.(map[string]any)
+	protocol := protocolForValue(tfMap["ip_protocol"].(string))
+
+	fmt.Fprintf(&buf, "%s-", tfMap["security_group_id"].(string))
+	fmt.Fprintf(&buf, "%s-", tfMap[names.AttrType].(string))
+	fmt.Fprintf(&buf, "%s-", protocol)
+	if protocol != "-1" {
+		fmt.Fprintf(&buf, "%d-", tfMap["from_port"].(int))
+		fmt.Fprintf(&buf, "%d-", tfMap["to_port"].(int))
+	}
+	if v, ok := tfMap[names.AttrDescription].(string); ok {
+		fmt.Fprintf(&buf, "%s-", v)
+	}
+
+	return create.StringHashcode(buf.String())
+}
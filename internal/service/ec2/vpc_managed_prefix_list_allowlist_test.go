@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCManagedPrefixListAllowlist_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_allowlist.test"
+	sgResourceName := "aws_security_group.test.0"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListAllowlistDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListAllowlistConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListAllowlistExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_family", "IPv4"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entries.10.0.0.0/24", "office"),
+					resource.TestCheckResourceAttr(resourceName, "entries.10.0.1.0/24", "vpn"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "security_group_rule.*", map[string]string{
+						"from_port":    "443",
+						"ip_protocol":  "tcp",
+						"to_port":      "443",
+						names.AttrType: "ingress",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_group_rule.*.security_group_id", sgResourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListAllowlist_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_allowlist.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListAllowlistDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListAllowlistConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListAllowlistExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceManagedPrefixListAllowlist(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListAllowlist_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_allowlist.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListAllowlistDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListAllowlistConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListAllowlistExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule.#", "1"),
+				),
+			},
+			{
+				// Grow the prefix list and move the reference to different security groups in the same apply.
+				Config: testAccVPCManagedPrefixListAllowlistConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListAllowlistExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "entries.10.0.2.0/24", "branch"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "security_group_rule.*", map[string]string{
+						"from_port":    "22",
+						"ip_protocol":  "tcp",
+						"to_port":      "22",
+						names.AttrType: "ingress",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "security_group_rule.*", map[string]string{
+						"ip_protocol":  "-1",
+						names.AttrType: "egress",
+					}),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListAllowlistConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListAllowlistExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListAllowlist_unmanagedRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityGroupRule
+	resourceName := "aws_ec2_managed_prefix_list_allowlist.test"
+	ruleResourceName := "aws_vpc_security_group_ingress_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListAllowlistDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// A rule managed by another resource that references the prefix list isn't read into state.
+				Config: acctest.ConfigCompose(testAccVPCManagedPrefixListAllowlistConfig_basic(rName), testAccVPCManagedPrefixListAllowlistConfig_unmanagedRule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListAllowlistExists(ctx, resourceName),
+					testAccCheckSecurityGroupIngressRuleExists(ctx, ruleResourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule.#", "1"),
+				),
+			},
+			{
+				// ... and isn't revoked when the resource's rules change.
+				Config: acctest.ConfigCompose(testAccVPCManagedPrefixListAllowlistConfig_updated(rName), testAccVPCManagedPrefixListAllowlistConfig_unmanagedRule),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(ruleResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListAllowlistExists(ctx, resourceName),
+					testAccCheckSecurityGroupIngressRuleExists(ctx, ruleResourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "security_group_rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListAllowlist_maxEntriesExceeded(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListAllowlistDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCManagedPrefixListAllowlistConfig_maxEntries(rName, 1),
+				ExpectError: regexache.MustCompile(`number of entries \(2\) exceeds max_entries \(1\)`),
+			},
+		},
+	})
+}
+
+func testAccCheckManagedPrefixListAllowlistDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_managed_prefix_list_allowlist" {
+				continue
+			}
+
+			_, err := tfec2.FindManagedPrefixListByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Managed Prefix List Allowlist %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckManagedPrefixListAllowlistExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindManagedPrefixListByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccVPCManagedPrefixListAllowlistConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 0), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  count = 2
+
+  name   = "%[1]s-${count.index}"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCManagedPrefixListAllowlistConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListAllowlistConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list_allowlist" "test" {
+  name = %[1]q
+
+  entries = {
+    "10.0.0.0/24" = "office"
+    "10.0.1.0/24" = "vpn"
+  }
+
+  security_group_rule {
+    security_group_id = aws_security_group.test[0].id
+    type              = "ingress"
+    ip_protocol       = "tcp"
+    from_port         = 443
+    to_port           = 443
+  }
+}
+`, rName))
+}
+
+func testAccVPCManagedPrefixListAllowlistConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListAllowlistConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list_allowlist" "test" {
+  name = %[1]q
+
+  entries = {
+    "10.0.0.0/24" = "office"
+    "10.0.1.0/24" = "vpn"
+    "10.0.2.0/24" = "branch"
+  }
+
+  security_group_rule {
+    security_group_id = aws_security_group.test[1].id
+    type              = "ingress"
+    ip_protocol       = "tcp"
+    from_port         = 22
+    to_port           = 22
+    description       = "ssh"
+  }
+
+  security_group_rule {
+    security_group_id = aws_security_group.test[1].id
+    type              = "egress"
+    ip_protocol       = "-1"
+  }
+}
+`, rName))
+}
+
+const testAccVPCManagedPrefixListAllowlistConfig_unmanagedRule = `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test[0].id
+  prefix_list_id    = aws_ec2_managed_prefix_list_allowlist.test.id
+  ip_protocol       = "tcp"
+  from_port         = 80
+  to_port           = 80
+}
+`
+
+func testAccVPCManagedPrefixListAllowlistConfig_maxEntries(rName string, maxEntries int) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListAllowlistConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list_allowlist" "test" {
+  name        = %[1]q
+  max_entries = %[2]d
+
+  entries = {
+    "10.0.0.0/24" = "office"
+    "10.0.1.0/24" = "vpn"
+  }
+
+  security_group_rule {
+    security_group_id = aws_security_group.test[0].id
+    type              = "ingress"
+    ip_protocol       = "tcp"
+    from_port         = 443
+    to_port           = 443
+  }
+}
+`, rName, maxEntries))
+}
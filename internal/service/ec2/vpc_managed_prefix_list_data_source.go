@@ -5,14 +5,18 @@ package ec2
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -36,6 +40,28 @@ func dataSourceManagedPrefixList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cidr_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cidr_chunks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_blocks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"destination_prefix_list_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"entries": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -71,6 +97,30 @@ func dataSourceManagedPrefixList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"require_route_compatible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"route_compatible": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"route_destinations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
 			names.AttrVersion: {
 				Type:     schema.TypeInt,
@@ -83,7 +133,8 @@ func dataSourceManagedPrefixList() *schema.Resource {
 func dataSourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.EC2Client(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	input := &ec2.DescribeManagedPrefixListsInput{
@@ -96,6 +147,10 @@ func dataSourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData
 		input.PrefixListIds = []string{v.(string)}
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -105,7 +160,7 @@ func dataSourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData
 		input.Filters = nil
 	}
 
-	pl, err := findManagedPrefixList(ctx, conn, input)
+	pl, err := findManagedPrefixListCached(ctx, c, input)
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Managed Prefix List", err))
@@ -113,6 +168,11 @@ func dataSourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(aws.ToString(pl.PrefixListId))
 
+	routeCompatible, reason := managedPrefixListRouteCompatible(pl)
+	if !routeCompatible && d.Get("require_route_compatible").(bool) {
+		return sdkdiag.AppendErrorf(diags, "EC2 Managed Prefix List (%s) can't be used as a route destination: %s", d.Id(), reason)
+	}
+
 	prefixListEntries, err := findManagedPrefixListEntriesByID(ctx, conn, d.Id())
 
 	if err != nil {
@@ -121,12 +181,24 @@ func dataSourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData
 
 	d.Set("address_family", pl.AddressFamily)
 	d.Set(names.AttrARN, pl.PrefixListArn)
+	if err := d.Set("cidr_chunks", flattenPrefixListEntryCIDRChunks(prefixListEntries, d.Get("cidr_chunk_size").(int))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cidr_chunks: %s", err)
+	}
+	if routeCompatible {
+		d.Set("destination_prefix_list_id", d.Id())
+	} else {
+		d.Set("destination_prefix_list_id", nil)
+	}
 	if err := d.Set("entries", flattenPrefixListEntries(prefixListEntries)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting entries: %s", err)
 	}
 	d.Set("max_entries", pl.MaxEntries)
 	d.Set(names.AttrName, pl.PrefixListName)
 	d.Set(names.AttrOwnerID, pl.OwnerId)
+	d.Set("route_compatible", routeCompatible)
+	if err := d.Set("route_destinations", flattenPrefixListEntryRouteDestinations(prefixListEntries, aws.ToString(pl.AddressFamily))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route_destinations: %s", err)
+	}
 	d.Set(names.AttrVersion, pl.Version)
 
 	if err := d.Set(names.AttrTags, keyValueTags(ctx, pl.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
@@ -135,3 +207,64 @@ func dataSourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData
 
 	return diags
 }
+
+// flattenPrefixListEntryCIDRChunks returns the entries' CIDR blocks, sorted, in chunks of at most the specified size.
+// No chunks are returned if the size is not positive.
+func flattenPrefixListEntryCIDRChunks(apiObjects []awstypes.PrefixListEntry, size int) []any {
+	if size <= 0 || len(apiObjects) == 0 {
+		return nil
+	}
+
+	cidrs := tfslices.ApplyToAll(apiObjects, func(v awstypes.PrefixListEntry) string {
+		return aws.ToString(v.Cidr)
+	})
+	slices.Sort(cidrs)
+
+	var tfList []any
+
+	for chunk := range slices.Chunk(cidrs, size) {
+		tfList = append(tfList, map[string]any{
+			"cidr_blocks": chunk,
+		})
+	}
+
+	return tfList
+}
+
+// managedPrefixListRouteCompatible returns whether the prefix list can be referenced as the destination of a route table route
+// and, if not, the reason why.
+func managedPrefixListRouteCompatible(apiObject *awstypes.ManagedPrefixList) (bool, string) {
+	// AWS-managed prefix lists (e.g. those of gateway endpoints) can't be referenced in route tables.
+	if aws.ToString(apiObject.OwnerId) == "AWS" {
+		return false, "AWS-managed prefix lists can't be referenced in route tables"
+	}
+
+	return true, ""
+}
+
+// flattenPrefixListEntryRouteDestinations returns the entries' CIDR blocks, sorted, as route destinations.
+func flattenPrefixListEntryRouteDestinations(apiObjects []awstypes.PrefixListEntry, addressFamily string) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	cidrs := tfslices.ApplyToAll(apiObjects, func(v awstypes.PrefixListEntry) string {
+		return aws.ToString(v.Cidr)
+	})
+	slices.Sort(cidrs)
+
+	return tfslices.ApplyToAll(cidrs, func(cidr string) any {
+		tfMap := map[string]any{
+			"destination_cidr_block":      "",
+			"destination_ipv6_cidr_block": "",
+		}
+
+		if addressFamily == managedPrefixListAddressFamilyIPv6 {
+			tfMap["destination_ipv6_cidr_block"] = cidr
+		} else {
+			tfMap["destination_cidr_block"] = cidr
+		}
+
+		return tfMap
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -71,6 +72,8 @@ func TestAccVPCManagedPrefixListDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceByName, "max_entries", "0"),
 					resource.TestCheckResourceAttr(resourceByName, names.AttrVersion, "0"),
 					resource.TestCheckResourceAttr(resourceByName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceByName, "destination_prefix_list_id", ""),
+					resource.TestCheckResourceAttr(resourceByName, "route_compatible", acctest.CtFalse),
 
 					resource.TestCheckResourceAttrPtr(resourceById, names.AttrID, &prefixListId),
 					resource.TestCheckResourceAttr(resourceById, names.AttrName, prefixListName),
@@ -160,6 +163,171 @@ data "aws_ec2_managed_prefix_list" "s3_by_id" {
 }
 `
 
+func TestAccVPCManagedPrefixListDataSource_cidrChunks(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_managed_prefix_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListDataSourceConfig_cidrChunks(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunk_size", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.0.cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.0.cidr_blocks.0", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.0.cidr_blocks.1", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.1.cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.1.cidr_blocks.0", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.1.cidr_blocks.1", "10.3.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.2.cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.2.cidr_blocks.0", "10.4.0.0/16"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListDataSourceConfig_cidrChunks(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunk_size", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_chunks.0.cidr_blocks.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCManagedPrefixListDataSourceConfig_cidrChunks(rName string, chunkSize int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 5
+  name           = %[1]q
+
+  dynamic "entry" {
+    for_each = [4, 3, 2, 1, 0]
+
+    content {
+      cidr = "10.${entry.value}.0.0/16"
+    }
+  }
+}
+
+data "aws_ec2_managed_prefix_list" "test" {
+  id              = aws_ec2_managed_prefix_list.test.id
+  cidr_chunk_size = %[2]d
+}
+`, rName, chunkSize)
+}
+
+func TestAccVPCManagedPrefixListDataSource_routeDestinations(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_managed_prefix_list.test"
+	resourceName := "aws_ec2_managed_prefix_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListDataSourceConfig_routeDestinations(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination_prefix_list_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "route_compatible", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "route_destinations.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_destinations.0.destination_cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_destinations.0.destination_ipv6_cidr_block", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "route_destinations.1.destination_cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttrPair("aws_route.test", "destination_prefix_list_id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListDataSource_requireRouteCompatible(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCManagedPrefixListDataSourceConfig_requireRouteCompatible,
+				ExpectError: regexache.MustCompile(`AWS-managed prefix lists can't be referenced in route tables`),
+			},
+		},
+	})
+}
+
+func testAccVPCManagedPrefixListDataSourceConfig_routeDestinations(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 2
+  name           = %[1]q
+
+  entry {
+    cidr = "10.1.0.0/16"
+  }
+
+  entry {
+    cidr = "10.0.0.0/16"
+  }
+}
+
+data "aws_ec2_managed_prefix_list" "test" {
+  id                       = aws_ec2_managed_prefix_list.test.id
+  require_route_compatible = true
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id             = aws_route_table.test.id
+  destination_prefix_list_id = data.aws_ec2_managed_prefix_list.test.destination_prefix_list_id
+  gateway_id                 = aws_internet_gateway.test.id
+}
+`, rName)
+}
+
+const testAccVPCManagedPrefixListDataSourceConfig_requireRouteCompatible = `
+data "aws_region" "current" {}
+
+data "aws_ec2_managed_prefix_list" "test" {
+  name                     = "com.amazonaws.${data.aws_region.current.region}.s3"
+  require_route_compatible = true
+}
+`
+
 func TestAccVPCManagedPrefixListDataSource_matchesTooMany(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
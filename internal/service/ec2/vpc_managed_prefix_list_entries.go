@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of entries that can be added or removed in a single ModifyManagedPrefixList call.
+	managedPrefixListEntriesModifyBatchSize = 100
+)
+
+// @SDKResource("aws_ec2_managed_prefix_list_entries", name="Managed Prefix List Entries")
+func resourceManagedPrefixListEntries() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedPrefixListEntriesCreate,
+		ReadWithoutTimeout:   resourceManagedPrefixListEntriesRead,
+		UpdateWithoutTimeout: resourceManagedPrefixListEntriesUpdate,
+		DeleteWithoutTimeout: resourceManagedPrefixListEntriesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				d.Set("prefix_list_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entries": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				ValidateDiagFunc: verify.MapKeysAre(validation.ToDiagFunc(validation.IsCIDR)),
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceManagedPrefixListEntriesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	plID := d.Get("prefix_list_id").(string)
+
+	// The resource is authoritative: any entries already in the prefix list that aren't configured are removed.
+	entries, err := findManagedPrefixListEntriesByID(ctx, conn, plID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) entries: %s", plID, err)
+	}
+
+	if err := syncManagedPrefixListEntries(ctx, conn, plID, flattenPrefixListEntriesToMap(entries), flex.ExpandStringValueMap(d.Get("entries").(map[string]any)), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Managed Prefix List Entries (%s): %s", plID, err)
+	}
+
+	d.SetId(plID)
+
+	return append(diags, resourceManagedPrefixListEntriesRead(ctx, d, meta)...)
+}
+
+func resourceManagedPrefixListEntriesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	pl, err := findManagedPrefixListByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Managed Prefix List Entries (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List Entries (%s): %s", d.Id(), err)
+	}
+
+	entries, err := findManagedPrefixListEntriesByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List Entries (%s): %s", d.Id(), err)
+	}
+
+	d.Set("entries", flattenPrefixListEntriesToMap(entries))
+	d.Set("prefix_list_id", pl.PrefixListId)
+	d.Set(names.AttrVersion, pl.Version)
+
+	return diags
+}
+
+func resourceManagedPrefixListEntriesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	if d.HasChange("entries") {
+		o, n := d.GetChange("entries")
+
+		if err := syncManagedPrefixListEntries(ctx, conn, d.Id(), flex.ExpandStringValueMap(o.(map[string]any)), flex.ExpandStringValueMap(n.(map[string]any)), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List Entries (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceManagedPrefixListEntriesRead(ctx, d, meta)...)
+}
+
+func resourceManagedPrefixListEntriesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	entries, err := findManagedPrefixListEntriesByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) entries: %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting EC2 Managed Prefix List Entries: %s", d.Id())
+	if err := syncManagedPrefixListEntries(ctx, conn, d.Id(), flattenPrefixListEntriesToMap(entries), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Managed Prefix List Entries (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// syncManagedPrefixListEntries modifies a prefix list's entries from the old set (CIDR to description) to the new set.
+// Entries are removed before any are added so that the prefix list's maximum number of entries isn't exceeded.
+// A CIDR can't be both removed and added in the same call, so entries whose description changes are removed and then re-added.
+func syncManagedPrefixListEntries(ctx context.Context, conn *ec2.Client, plID string, o, n map[string]string, timeout time.Duration) error {
+	add, remove := managedPrefixListEntriesDiff(o, n)
+
+	for chunk := range slices.Chunk(remove, managedPrefixListEntriesModifyBatchSize) {
+		input := ec2.ModifyManagedPrefixListInput{
+			PrefixListId:  aws.String(plID),
+			RemoveEntries: chunk,
+		}
+
+		if err := modifyManagedPrefixListEntries(ctx, conn, &input, timeout); err != nil {
+			return err
+		}
+	}
+
+	for chunk := range slices.Chunk(add, managedPrefixListEntriesModifyBatchSize) {
+		input := ec2.ModifyManagedPrefixListInput{
+			AddEntries:   chunk,
+			PrefixListId: aws.String(plID),
+		}
+
+		if err := modifyManagedPrefixListEntries(ctx, conn, &input, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// managedPrefixListEntriesDiff returns the entries to add and remove to modify a prefix list's entries
+// from the old set (CIDR to description) to the new set.
+// The description of an existing entry can't be modified, so an entry whose description changes is removed and re-added.
+func managedPrefixListEntriesDiff(o, n map[string]string) ([]awstypes.AddPrefixListEntry, []awstypes.RemovePrefixListEntry) {
+	var add []awstypes.AddPrefixListEntry
+	var remove []awstypes.RemovePrefixListEntry
+
+	for _, cidr := range slices.Sorted(maps.Keys(o)) {
+		if description, ok := n[cidr]; !ok || description != o[cidr] {
+			remove = append(remove, awstypes.RemovePrefixListEntry{
+				Cidr: aws.String(cidr),
+			})
+		}
+	}
+
+	for _, cidr := range slices.Sorted(maps.Keys(n)) {
+		if description, ok := o[cidr]; !ok || description != n[cidr] {
+			entry := awstypes.AddPrefixListEntry{
+				Cidr: aws.String(cidr),
+			}
+			if v := n[cidr]; v != "" {
+				entry.Description = aws.String(v)
+			}
+			add = append(add, entry)
+		}
+	}
+
+	return add, remove
+}
+
+// modifyManagedPrefixListEntries modifies a prefix list's entries against the prefix list's current version
+// and waits for the new version to be available.
+//
+// Prevent the following error on description-only updates:
+//
+//	InvalidParameterValue: Request cannot contain Cidr #.#.#.#/# in both AddPrefixListEntries and RemovePrefixListEntries
+//
+// Attempting to just delete the RemoveEntries item causes:
+//
+//	InvalidRequest: The request received was invalid.
+//
+// Therefore entries that are both removed and added are removed in a first ModifyManagedPrefixList call
+// and the remaining changes are made in a second call.
+func modifyManagedPrefixListEntries(ctx context.Context, conn *ec2.Client, input *ec2.ModifyManagedPrefixListInput, timeout time.Duration) error {
+	var descriptionOnlyRemovals, removals []awstypes.RemovePrefixListEntry
+
+	for _, removeEntry := range input.RemoveEntries {
+		if slices.ContainsFunc(input.AddEntries, func(addEntry awstypes.AddPrefixListEntry) bool {
+			return aws.ToString(addEntry.Cidr) == aws.ToString(removeEntry.Cidr)
+		}) {
+			descriptionOnlyRemovals = append(descriptionOnlyRemovals, removeEntry)
+		} else {
+			removals = append(removals, removeEntry)
+		}
+	}
+
+	if len(descriptionOnlyRemovals) > 0 {
+		removeInput := ec2.ModifyManagedPrefixListInput{
+			PrefixListId:  input.PrefixListId,
+			RemoveEntries: descriptionOnlyRemovals,
+		}
+
+		if err := modifyManagedPrefixList(ctx, conn, &removeInput, timeout); err != nil {
+			return err
+		}
+
+		// Prevent this error if RemoveEntries is list with no elements after removals:
+		//   InvalidRequest: The request received was invalid.
+		input.RemoveEntries = removals
+	}
+
+	return modifyManagedPrefixList(ctx, conn, input, timeout)
+}
+
+// modifyManagedPrefixList makes a single ModifyManagedPrefixList call against the prefix list's current version
+// and waits for the new version to be available.
+// If the prefix list is concurrently modified the current version is re-read and the call retried.
+func modifyManagedPrefixList(ctx context.Context, conn *ec2.Client, input *ec2.ModifyManagedPrefixListInput, timeout time.Duration) error {
+	plID := aws.ToString(input.PrefixListId)
+
+	var version int64
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (any, error) {
+		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		// Re-read the current version before each attempt.
+		pl, err := findManagedPrefixListByID(ctx, conn, plID)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading VPC Managed Prefix List (%s): %w", plID, err)
+		}
+
+		version = aws.ToInt64(pl.Version) + 1
+		input.CurrentVersion = pl.Version
+
+		return conn.ModifyManagedPrefixList(ctx, input)
+	}, errCodeIncorrectState, errCodePrefixListVersionMismatch)
+
+	if err != nil {
+		return fmt.Errorf("modifying VPC Managed Prefix List (%s): %w", plID, err)
+	}
+
+	if _, err := waitManagedPrefixListVersionModified(ctx, conn, plID, version); err != nil {
+		return fmt.Errorf("waiting for VPC Managed Prefix List (%s) version %d: %w", plID, version, err)
+	}
+
+	return nil
+}
+
+func flattenPrefixListEntriesToMap(apiObjects []awstypes.PrefixListEntry) map[string]string {
+	tfMap := make(map[string]string, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap[aws.ToString(apiObject.Cidr)] = aws.ToString(apiObject.Description)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCManagedPrefixListEntries_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", plResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entries.10.0.0.0/24", "first"),
+					resource.TestCheckResourceAttr(resourceName, "entries.10.0.1.0/24", ""),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entries.10.0.1.0/24", "second"),
+					resource.TestCheckResourceAttr(resourceName, "entries.10.0.2.0/24", "third"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListEntries_batched(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_count(rName, 150),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "150"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_count(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListEntries_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_entries.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListEntriesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListEntriesExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceManagedPrefixListEntries(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckManagedPrefixListEntriesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_managed_prefix_list_entries" {
+				continue
+			}
+
+			output, err := tfec2.FindManagedPrefixListEntriesByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EC2 Managed Prefix List Entries %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckManagedPrefixListEntriesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindManagedPrefixListEntriesByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := fmt.Sprint(len(output)), rs.Primary.Attributes["entries.%"]; got != want {
+			return fmt.Errorf("EC2 Managed Prefix List %s has %s entries, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCManagedPrefixListEntriesConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  entries = {
+    "10.0.0.0/24" = "first"
+    "10.0.1.0/24" = ""
+  }
+}
+`, rName)
+}
+
+func testAccVPCManagedPrefixListEntriesConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  entries = {
+    "10.0.1.0/24" = "second"
+    "10.0.2.0/24" = "third"
+  }
+}
+`, rName)
+}
+
+func testAccVPCManagedPrefixListEntriesConfig_count(rName string, n int) string {
+	entries := make([]string, 0, n)
+	for i := range n {
+		entries = append(entries, fmt.Sprintf("    %q = \"entry %d\"", fmt.Sprintf("10.1.%d.0/24", i), i))
+	}
+
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 200
+}
+
+resource "aws_ec2_managed_prefix_list_entries" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  entries = {
+%[2]s
+  }
+}
+`, rName, strings.Join(entries, "\n"))
+}
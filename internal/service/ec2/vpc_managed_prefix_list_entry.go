@@ -60,6 +60,7 @@ func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.Resourc
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
 
 	cidr := d.Get("cidr").(string)
 	plID := d.Get("prefix_list_id").(string)
@@ -71,6 +72,7 @@ func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.Resourc
 		addPrefixListEntry.Description = aws.String(v.(string))
 	}
 
+	var version int64
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (any, error) {
 		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
 		conns.GlobalMutexKV.Lock(mutexKey)
@@ -82,6 +84,8 @@ func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.Resourc
 			return nil, fmt.Errorf("reading VPC Managed Prefix List (%s): %w", plID, err)
 		}
 
+		version = aws.ToInt64(pl.Version) + 1
+
 		input := &ec2.ModifyManagedPrefixListInput{
 			AddEntries:     []awstypes.AddPrefixListEntry{addPrefixListEntry},
 			CurrentVersion: pl.Version,
@@ -97,7 +101,7 @@ func resourceManagedPrefixListEntryCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(id)
 
-	if _, err := waitManagedPrefixListModified(ctx, conn, plID); err != nil {
+	if _, err := waitManagedPrefixListVersionModified(ctx, conn, plID, version); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for VPC Managed Prefix List Entry (%s) create: %s", d.Id(), err)
 	}
 
@@ -141,6 +145,7 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
 
 	plID, cidr, err := managedPrefixListEntryParseResourceID(d.Id())
 
@@ -148,6 +153,7 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	var version int64
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate), func() (any, error) {
 		mutexKey := fmt.Sprintf("vpc-managed-prefix-list-%s", plID)
 		conns.GlobalMutexKV.Lock(mutexKey)
@@ -159,6 +165,8 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 			return nil, fmt.Errorf("reading VPC Managed Prefix List (%s): %w", plID, err)
 		}
 
+		version = aws.ToInt64(pl.Version) + 1
+
 		input := &ec2.ModifyManagedPrefixListInput{
 			CurrentVersion: pl.Version,
 			PrefixListId:   aws.String(plID),
@@ -176,7 +184,7 @@ func resourceManagedPrefixListEntryDelete(ctx context.Context, d *schema.Resourc
 		return sdkdiag.AppendErrorf(diags, "deleting VPC Managed Prefix List Entry (%s): %s", d.Id(), err)
 	}
 
-	_, err = waitManagedPrefixListModified(ctx, conn, plID)
+	_, err = waitManagedPrefixListVersionModified(ctx, conn, plID, version)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for VPC Managed Prefix List Entry (%s) delete: %s", d.Id(), err)
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_managed_prefix_list_entry", name="Managed Prefix List Entry")
+func dataSourceManagedPrefixListEntry() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceManagedPrefixListEntryRead,
+
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix_list_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func dataSourceManagedPrefixListEntryRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	plID, cidr := d.Get("prefix_list_id").(string), d.Get("cidr").(string)
+
+	pl, err := findManagedPrefixListByID(ctx, conn, plID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Managed Prefix List", err))
+	}
+
+	var entry *awstypes.PrefixListEntry
+	version := aws.ToInt64(pl.Version)
+	if v, ok := d.GetOk("prefix_list_version"); ok {
+		version = int64(v.(int))
+		entry, err = findManagedPrefixListEntryByIDCIDRAndVersion(ctx, conn, plID, cidr, version)
+	} else {
+		entry, err = findManagedPrefixListEntryByIDAndCIDR(ctx, conn, plID, cidr)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Managed Prefix List Entry", err))
+	}
+
+	d.SetId(managedPrefixListEntryCreateResourceID(plID, cidr))
+	d.Set("cidr", entry.Cidr)
+	d.Set(names.AttrDescription, entry.Description)
+	d.Set("prefix_list_id", plID)
+	d.Set("prefix_list_version", version)
+
+	return diags
+}
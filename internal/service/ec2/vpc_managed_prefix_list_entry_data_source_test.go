@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCManagedPrefixListEntryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	prefixListResourceName := "aws_ec2_managed_prefix_list.test"
+	dataSourceName1 := "data.aws_ec2_managed_prefix_list_entry.test1"
+	dataSourceName2 := "data.aws_ec2_managed_prefix_list_entry.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListEntryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName1, "cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName1, names.AttrDescription, "Corporate network"),
+					resource.TestCheckResourceAttrPair(dataSourceName1, "prefix_list_id", prefixListResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName1, "prefix_list_version", "2"),
+					resource.TestCheckResourceAttr(dataSourceName2, "cidr", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName2, names.AttrDescription, "VPN"),
+					resource.TestCheckResourceAttr(dataSourceName2, "prefix_list_version", "2"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListEntryDataSourceConfig_version(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName1, "cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName1, names.AttrDescription, "Corporate network"),
+					resource.TestCheckResourceAttr(dataSourceName1, "prefix_list_version", "1"),
+				),
+			},
+			{
+				Config:      testAccVPCManagedPrefixListEntryDataSourceConfig_versionMissing(rName),
+				ExpectError: regexache.MustCompile(`no matching EC2 Managed Prefix List Entry found`),
+			},
+			{
+				Config:      testAccVPCManagedPrefixListEntryDataSourceConfig_missing(rName),
+				ExpectError: regexache.MustCompile(`no matching EC2 Managed Prefix List Entry found`),
+			},
+		},
+	})
+}
+
+func testAccVPCManagedPrefixListEntryDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+
+  entry {
+    cidr        = "10.0.0.0/16"
+    description = "Corporate network"
+  }
+
+  lifecycle {
+    ignore_changes = [entry]
+  }
+}
+
+resource "aws_ec2_managed_prefix_list_entry" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+  cidr           = "10.1.0.0/16"
+  description    = "VPN"
+}
+`, rName)
+}
+
+func testAccVPCManagedPrefixListEntryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListEntryDataSourceConfig_base(rName), `
+data "aws_ec2_managed_prefix_list_entry" "test1" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+  cidr           = "10.0.0.0/16"
+
+  depends_on = [aws_ec2_managed_prefix_list_entry.test]
+}
+
+data "aws_ec2_managed_prefix_list_entry" "test2" {
+  prefix_list_id = aws_ec2_managed_prefix_list_entry.test.prefix_list_id
+  cidr           = aws_ec2_managed_prefix_list_entry.test.cidr
+}
+`)
+}
+
+func testAccVPCManagedPrefixListEntryDataSourceConfig_version(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListEntryDataSourceConfig_base(rName), `
+data "aws_ec2_managed_prefix_list_entry" "test1" {
+  prefix_list_id      = aws_ec2_managed_prefix_list.test.id
+  cidr                = "10.0.0.0/16"
+  prefix_list_version = 1
+
+  depends_on = [aws_ec2_managed_prefix_list_entry.test]
+}
+`)
+}
+
+func testAccVPCManagedPrefixListEntryDataSourceConfig_versionMissing(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListEntryDataSourceConfig_base(rName), `
+data "aws_ec2_managed_prefix_list_entry" "test" {
+  prefix_list_id      = aws_ec2_managed_prefix_list_entry.test.prefix_list_id
+  cidr                = aws_ec2_managed_prefix_list_entry.test.cidr
+  prefix_list_version = 1
+}
+`)
+}
+
+func testAccVPCManagedPrefixListEntryDataSourceConfig_missing(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListEntryDataSourceConfig_base(rName), `
+data "aws_ec2_managed_prefix_list_entry" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+  cidr           = "192.168.0.0/24"
+}
+`)
+}
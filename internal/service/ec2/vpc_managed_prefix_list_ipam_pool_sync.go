@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_managed_prefix_list_ipam_pool_sync", name="Managed Prefix List IPAM Pool Sync")
+func resourceManagedPrefixListIPAMPoolSync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedPrefixListIPAMPoolSyncCreate,
+		ReadWithoutTimeout:   resourceManagedPrefixListIPAMPoolSyncRead,
+		UpdateWithoutTimeout: resourceManagedPrefixListIPAMPoolSyncUpdate,
+		DeleteWithoutTimeout: resourceManagedPrefixListIPAMPoolSyncDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				plID, poolID, err := managedPrefixListIPAMPoolSyncParseResourceID(d.Id())
+
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set("cidr_source", managedPrefixListIPAMPoolSyncCIDRSourceAllocations)
+				d.Set("ipam_pool_id", poolID)
+				d.Set("prefix_list_id", plID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceManagedPrefixListIPAMPoolSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"allocation_resource_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.IpamPoolAllocationResourceType](),
+				},
+			},
+			"cidr_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      managedPrefixListIPAMPoolSyncCIDRSourceAllocations,
+				ValidateFunc: validation.StringInSlice(managedPrefixListIPAMPoolSyncCIDRSource_Values(), false),
+			},
+			"entries": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceManagedPrefixListIPAMPoolSyncCustomizeDiff re-reads the IPAM pool and plans an update
+// if the prefix list's entries no longer match the pool's CIDRs.
+func resourceManagedPrefixListIPAMPoolSyncCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	poolID := diff.Get("ipam_pool_id").(string)
+	entries, err := findIPAMPoolPrefixListEntries(ctx, conn, poolID, diff.Get("cidr_source").(string), diff.Get("allocation_resource_types").(*schema.Set))
+
+	// The IPAM pool may be replaced in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IPAM Pool (%s) CIDRs: %w", poolID, err)
+	}
+
+	if maps.Equal(entries, flex.ExpandStringValueMap(diff.Get("entries").(map[string]any))) {
+		return nil
+	}
+
+	if err := diff.SetNewComputed("entries"); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed(names.AttrVersion)
+}
+
+func resourceManagedPrefixListIPAMPoolSyncCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	plID, poolID := d.Get("prefix_list_id").(string), d.Get("ipam_pool_id").(string)
+	id := managedPrefixListIPAMPoolSyncCreateResourceID(plID, poolID)
+
+	if err := reconcileManagedPrefixListIPAMPoolSync(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Managed Prefix List IPAM Pool Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceManagedPrefixListIPAMPoolSyncRead(ctx, d, meta)...)
+}
+
+func resourceManagedPrefixListIPAMPoolSyncRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	plID, poolID, err := managedPrefixListIPAMPoolSyncParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	pl, err := findManagedPrefixListByID(ctx, conn, plID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Managed Prefix List IPAM Pool Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List IPAM Pool Sync (%s): %s", d.Id(), err)
+	}
+
+	entries, err := findManagedPrefixListEntriesByID(ctx, conn, plID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List IPAM Pool Sync (%s): %s", d.Id(), err)
+	}
+
+	d.Set("entries", flattenPrefixListEntriesToMap(entries))
+	d.Set("ipam_pool_id", poolID)
+	d.Set("prefix_list_id", pl.PrefixListId)
+	d.Set(names.AttrVersion, pl.Version)
+
+	return diags
+}
+
+func resourceManagedPrefixListIPAMPoolSyncUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	if err := reconcileManagedPrefixListIPAMPoolSync(ctx, conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Managed Prefix List IPAM Pool Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceManagedPrefixListIPAMPoolSyncRead(ctx, d, meta)...)
+}
+
+func resourceManagedPrefixListIPAMPoolSyncDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	defer invalidateManagedPrefixListsCache(ctx, meta.(*conns.AWSClient))
+
+	plID, _, err := managedPrefixListIPAMPoolSyncParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	entries, err := findManagedPrefixListEntriesByID(ctx, conn, plID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) entries: %s", plID, err)
+	}
+
+	log.Printf("[INFO] Deleting EC2 Managed Prefix List IPAM Pool Sync: %s", d.Id())
+	if err := syncManagedPrefixListEntries(ctx, conn, plID, flattenPrefixListEntriesToMap(entries), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Managed Prefix List IPAM Pool Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// reconcileManagedPrefixListIPAMPoolSync re-reads the IPAM pool and modifies the prefix list's entries to match the pool's CIDRs.
+// The resource is authoritative: any entries in the prefix list that aren't in the pool are removed.
+func reconcileManagedPrefixListIPAMPoolSync(ctx context.Context, conn *ec2.Client, d *schema.ResourceData, timeout time.Duration) error {
+	plID, poolID := d.Get("prefix_list_id").(string), d.Get("ipam_pool_id").(string)
+
+	n, err := findIPAMPoolPrefixListEntries(ctx, conn, poolID, d.Get("cidr_source").(string), d.Get("allocation_resource_types").(*schema.Set))
+
+	if err != nil {
+		return fmt.Errorf("reading IPAM Pool (%s) CIDRs: %w", poolID, err)
+	}
+
+	entries, err := findManagedPrefixListEntriesByID(ctx, conn, plID)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Managed Prefix List (%s) entries: %w", plID, err)
+	}
+
+	return syncManagedPrefixListEntries(ctx, conn, plID, flattenPrefixListEntriesToMap(entries), n, timeout)
+}
+
+// findIPAMPoolPrefixListEntries returns the prefix list entries (CIDR to description) for an IPAM pool's allocations or provisioned CIDRs.
+// Each entry's description is the ID of the allocated resource, allocation or pool CIDR.
+func findIPAMPoolPrefixListEntries(ctx context.Context, conn *ec2.Client, poolID, cidrSource string, resourceTypes *schema.Set) (map[string]string, error) {
+	entries := make(map[string]string)
+
+	switch cidrSource {
+	case managedPrefixListIPAMPoolSyncCIDRSourceProvisionedCIDRs:
+		input := ec2.GetIpamPoolCidrsInput{
+			IpamPoolId: aws.String(poolID),
+		}
+		output, err := findIPAMPoolCIDRs(ctx, conn, &input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output {
+			if v.State != awstypes.IpamPoolCidrStateProvisioned || aws.ToString(v.Cidr) == "" {
+				continue
+			}
+
+			entries[aws.ToString(v.Cidr)] = aws.ToString(v.IpamPoolCidrId)
+		}
+	default:
+		input := ec2.GetIpamPoolAllocationsInput{
+			IpamPoolId: aws.String(poolID),
+		}
+		output, err := findIPAMPoolAllocations(ctx, conn, &input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		allocationResourceTypes := flex.ExpandStringyValueSet[awstypes.IpamPoolAllocationResourceType](resourceTypes)
+		for _, v := range output {
+			if aws.ToString(v.Cidr) == "" || (len(allocationResourceTypes) > 0 && !slices.Contains(allocationResourceTypes, v.ResourceType)) {
+				continue
+			}
+
+			description := aws.ToString(v.ResourceId)
+			if description == "" {
+				description = aws.ToString(v.IpamPoolAllocationId)
+			}
+
+			entries[aws.ToString(v.Cidr)] = description
+		}
+	}
+
+	return entries, nil
+}
+
+const managedPrefixListIPAMPoolSyncIDSeparator = ","
+
+func managedPrefixListIPAMPoolSyncCreateResourceID(prefixListID, ipamPoolID string) string {
+	parts := []string{prefixListID, ipamPoolID}
+	id := strings.Join(parts, managedPrefixListIPAMPoolSyncIDSeparator)
+
+	return id
+}
+
+func managedPrefixListIPAMPoolSyncParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, managedPrefixListIPAMPoolSyncIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected prefix-list-id%[2]sipam-pool-id", id, managedPrefixListIPAMPoolSyncIDSeparator)
+}
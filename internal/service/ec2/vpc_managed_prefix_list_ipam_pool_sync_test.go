@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCManagedPrefixListIPAMPoolSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_ipam_pool_sync.test"
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	poolResourceName := "aws_vpc_ipam_pool.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListIPAMPoolSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListIPAMPoolSyncConfig_allocations(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListIPAMPoolSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr_source", "allocations"),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_pool_id", poolResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", plResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "entries.172.2.0.0/28"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCManagedPrefixListIPAMPoolSyncConfig_allocations(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListIPAMPoolSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "entries.172.2.0.0/28"),
+					resource.TestCheckResourceAttrSet(resourceName, "entries.172.2.0.16/28"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListIPAMPoolSyncConfig_provisionedCIDRs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListIPAMPoolSyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr_source", "provisioned-cidrs"),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "entries.172.2.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListIPAMPoolSync_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list_ipam_pool_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListIPAMPoolSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListIPAMPoolSyncConfig_allocations(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedPrefixListIPAMPoolSyncExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceManagedPrefixListIPAMPoolSync(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckManagedPrefixListIPAMPoolSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_managed_prefix_list_ipam_pool_sync" {
+				continue
+			}
+
+			output, err := tfec2.FindManagedPrefixListEntriesByID(ctx, conn, rs.Primary.Attributes["prefix_list_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EC2 Managed Prefix List IPAM Pool Sync %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckManagedPrefixListIPAMPoolSyncExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindManagedPrefixListEntriesByID(ctx, conn, rs.Primary.Attributes["prefix_list_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := fmt.Sprint(len(output)), rs.Primary.Attributes["entries.%"]; got != want {
+			return fmt.Errorf("EC2 Managed Prefix List %s has %s entries, want %s", rs.Primary.Attributes["prefix_list_id"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCManagedPrefixListIPAMPoolSyncConfig_base(rName string, n int) string {
+	return acctest.ConfigCompose(testAccIPAMPoolCIDRAllocationConfig_base, fmt.Sprintf(`
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  count = %[2]d
+
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = cidrsubnet(aws_vpc_ipam_pool_cidr.test.cidr, 4, count.index)
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+}
+`, rName, n))
+}
+
+func testAccVPCManagedPrefixListIPAMPoolSyncConfig_allocations(rName string, n int) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListIPAMPoolSyncConfig_base(rName, n), `
+resource "aws_ec2_managed_prefix_list_ipam_pool_sync" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+
+  allocation_resource_types = ["custom"]
+
+  depends_on = [aws_vpc_ipam_pool_cidr_allocation.test]
+}
+`)
+}
+
+func testAccVPCManagedPrefixListIPAMPoolSyncConfig_provisionedCIDRs(rName string) string {
+	return acctest.ConfigCompose(testAccVPCManagedPrefixListIPAMPoolSyncConfig_base(rName, 2), `
+resource "aws_ec2_managed_prefix_list_ipam_pool_sync" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  cidr_source    = "provisioned-cidrs"
+
+  depends_on = [aws_vpc_ipam_pool_cidr.test]
+}
+`)
+}
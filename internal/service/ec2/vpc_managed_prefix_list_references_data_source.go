@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_prefix_list_references", name="Prefix List References")
+func dataSourcePrefixListReferences() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePrefixListReferencesRead,
+
+		Schema: map[string]*schema.Schema{
+			"prefix_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"references": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrResourceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"route_table_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_group_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePrefixListReferencesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	prefixListID := d.Get("prefix_list_id").(string)
+	input := ec2.GetManagedPrefixListAssociationsInput{
+		PrefixListId: aws.String(prefixListID),
+	}
+
+	associations, err := findManagedPrefixListAssociations(ctx, conn, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) associations: %s", prefixListID, err)
+	}
+
+	// Security groups and route tables are the resources that can reference a prefix list.
+	// Any other resource is still reported in "references".
+	routeTableIDs, securityGroupIDs := []string{}, []string{}
+	for _, v := range associations {
+		switch id := aws.ToString(v.ResourceId); {
+		case strings.HasPrefix(id, "rtb-"):
+			routeTableIDs = append(routeTableIDs, id)
+		case strings.HasPrefix(id, "sg-"):
+			securityGroupIDs = append(securityGroupIDs, id)
+		}
+	}
+
+	d.SetId(prefixListID)
+	if err := d.Set("references", flattenPrefixListAssociations(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting references: %s", err)
+	}
+	d.Set("route_table_ids", routeTableIDs)
+	d.Set("security_group_ids", securityGroupIDs)
+
+	return diags
+}
+
+func flattenPrefixListAssociations(apiObjects []awstypes.PrefixListAssociation) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrResourceID: aws.ToString(apiObject.ResourceId),
+			"resource_owner":     aws.ToString(apiObject.ResourceOwner),
+		})
+	}
+
+	return tfList
+}
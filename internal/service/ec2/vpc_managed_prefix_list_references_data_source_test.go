@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCPrefixListReferencesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_prefix_list_references.test"
+	routeTableResourceName := "aws_route_table.test"
+	securityGroupResourceName := "aws_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPrefixListReferencesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "references.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "references.*", map[string]string{
+						"resource_owner": acctest.AccountID(ctx),
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "route_table_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "route_table_ids.0", routeTableResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_ids.0", securityGroupResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPrefixListReferencesDataSource_noReferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_prefix_list_references.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPrefixListReferencesDataSourceConfig_noReferences(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "references.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "route_table_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_group_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCPrefixListReferencesDataSourceConfig_noReferences(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 1
+
+  entry {
+    cidr = "10.0.0.0/16"
+  }
+}
+
+data "aws_ec2_prefix_list_references" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+}
+`, rName)
+}
+
+func testAccVPCPrefixListReferencesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 1
+
+  entry {
+    cidr = "10.0.0.0/16"
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    from_port       = 443
+    to_port         = 443
+    protocol        = "tcp"
+    prefix_list_ids = [aws_ec2_managed_prefix_list.test.id]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id             = aws_route_table.test.id
+  destination_prefix_list_id = aws_ec2_managed_prefix_list.test.id
+  gateway_id                 = aws_internet_gateway.test.id
+}
+
+data "aws_ec2_prefix_list_references" "test" {
+  prefix_list_id = aws_ec2_managed_prefix_list.test.id
+
+  depends_on = [aws_route.test, aws_security_group.test]
+}
+`, rName)
+}
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	ramtypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	managedPrefixListResourceShareTimeout = 5 * time.Minute
+)
+
+func managedPrefixListShareWithSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allow_external_principals": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"principals": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.Any(
+							verify.ValidAccountID,
+							verify.ValidARN,
+						),
+					},
+				},
+				"resource_share_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// createManagedPrefixListResourceShare creates a RAM resource share named after the prefix list,
+// associates the prefix list and the configured principals with it and returns the share's ARN.
+func createManagedPrefixListResourceShare(ctx context.Context, conn *ram.Client, name, plARN string, tfMap map[string]any) (string, error) {
+	principals := flex.ExpandStringValueSet(tfMap["principals"].(*schema.Set))
+	input := ram.CreateResourceShareInput{
+		AllowExternalPrincipals: aws.Bool(tfMap["allow_external_principals"].(bool)),
+		ClientToken:             aws.String(id.UniqueId()),
+		Name:                    aws.String(name),
+		Principals:              principals,
+		ResourceArns:            []string{plARN},
+	}
+
+	output, err := conn.CreateResourceShare(ctx, &input)
+
+	if err != nil {
+		return "", fmt.Errorf("creating RAM Resource Share (%s): %w", name, err)
+	}
+
+	arn := aws.ToString(output.ResourceShare.ResourceShareArn)
+
+	if _, err := waitManagedPrefixListResourceShareActive(ctx, conn, arn); err != nil {
+		return arn, fmt.Errorf("waiting for RAM Resource Share (%s) create: %w", arn, err)
+	}
+
+	if err := waitManagedPrefixListResourceSharePrincipalsAssociated(ctx, conn, arn, principals); err != nil {
+		return arn, fmt.Errorf("waiting for RAM Resource Share (%s) principal associations: %w", arn, err)
+	}
+
+	return arn, nil
+}
+
+// updateManagedPrefixListResourceShare brings an existing RAM resource share's settings and principals in line with configuration.
+func updateManagedPrefixListResourceShare(ctx context.Context, conn *ram.Client, arn, name string, o, n map[string]any, nameChanged bool) error {
+	if nameChanged || o["allow_external_principals"].(bool) != n["allow_external_principals"].(bool) {
+		input := ram.UpdateResourceShareInput{
+			AllowExternalPrincipals: aws.Bool(n["allow_external_principals"].(bool)),
+			Name:                    aws.String(name),
+			ResourceShareArn:        aws.String(arn),
+		}
+
+		if _, err := conn.UpdateResourceShare(ctx, &input); err != nil {
+			return fmt.Errorf("updating RAM Resource Share (%s): %w", arn, err)
+		}
+	}
+
+	os, ns := o["principals"].(*schema.Set), n["principals"].(*schema.Set)
+
+	if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+		input := ram.DisassociateResourceShareInput{
+			ClientToken:      aws.String(id.UniqueId()),
+			Principals:       del,
+			ResourceShareArn: aws.String(arn),
+		}
+
+		if _, err := conn.DisassociateResourceShare(ctx, &input); err != nil {
+			return fmt.Errorf("disassociating principals from RAM Resource Share (%s): %w", arn, err)
+		}
+	}
+
+	if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+		input := ram.AssociateResourceShareInput{
+			ClientToken:      aws.String(id.UniqueId()),
+			Principals:       add,
+			ResourceShareArn: aws.String(arn),
+		}
+
+		if _, err := conn.AssociateResourceShare(ctx, &input); err != nil {
+			return fmt.Errorf("associating principals with RAM Resource Share (%s): %w", arn, err)
+		}
+
+		if err := waitManagedPrefixListResourceSharePrincipalsAssociated(ctx, conn, arn, add); err != nil {
+			return fmt.Errorf("waiting for RAM Resource Share (%s) principal associations: %w", arn, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteManagedPrefixListResourceShare(ctx context.Context, conn *ram.Client, arn string) error {
+	input := ram.DeleteResourceShareInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		ResourceShareArn: aws.String(arn),
+	}
+
+	_, err := conn.DeleteResourceShare(ctx, &input)
+
+	if errs.IsA[*ramtypes.UnknownResourceException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RAM Resource Share (%s): %w", arn, err)
+	}
+
+	if _, err := waitManagedPrefixListResourceShareDeleted(ctx, conn, arn); err != nil {
+		return fmt.Errorf("waiting for RAM Resource Share (%s) delete: %w", arn, err)
+	}
+
+	return nil
+}
+
+func findManagedPrefixListResourceShareByARN(ctx context.Context, conn *ram.Client, arn string) (*ramtypes.ResourceShare, error) {
+	input := ram.GetResourceSharesInput{
+		ResourceOwner:     ramtypes.ResourceOwnerSelf,
+		ResourceShareArns: []string{arn},
+	}
+	var output []ramtypes.ResourceShare
+
+	pages := ram.NewGetResourceSharesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*ramtypes.ResourceArnNotFoundException](err) || errs.IsA[*ramtypes.UnknownResourceException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceShares...)
+	}
+
+	resourceShare, err := tfresource.AssertSingleValueResult(output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := resourceShare.Status; status == ramtypes.ResourceShareStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return resourceShare, nil
+}
+
+// findManagedPrefixListResourceSharePrincipalAssociations returns the RAM resource share's principal associations
+// that have not been (and are not being) disassociated, keyed by principal.
+func findManagedPrefixListResourceSharePrincipalAssociations(ctx context.Context, conn *ram.Client, arn string) (map[string]ramtypes.ResourceShareAssociation, error) {
+	input := ram.GetResourceShareAssociationsInput{
+		AssociationType:   ramtypes.ResourceShareAssociationTypePrincipal,
+		ResourceShareArns: []string{arn},
+	}
+	output := make(map[string]ramtypes.ResourceShareAssociation)
+
+	pages := ram.NewGetResourceShareAssociationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*ramtypes.ResourceArnNotFoundException](err) || errs.IsA[*ramtypes.UnknownResourceException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ResourceShareAssociations {
+			switch v.Status {
+			case ramtypes.ResourceShareAssociationStatusDisassociated, ramtypes.ResourceShareAssociationStatusDisassociating, ramtypes.ResourceShareAssociationStatusFailed:
+				continue
+			}
+
+			output[aws.ToString(v.AssociatedEntity)] = v
+		}
+	}
+
+	return output, nil
+}
+
+func statusManagedPrefixListResourceShare(ctx context.Context, conn *ram.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findManagedPrefixListResourceShareByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitManagedPrefixListResourceShareActive(ctx context.Context, conn *ram.Client, arn string) (*ramtypes.ResourceShare, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(ramtypes.ResourceShareStatusPending),
+		Target:         enum.Slice(ramtypes.ResourceShareStatusActive),
+		Refresh:        statusManagedPrefixListResourceShare(ctx, conn, arn),
+		Timeout:        managedPrefixListResourceShareTimeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ramtypes.ResourceShare); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitManagedPrefixListResourceShareDeleted(ctx context.Context, conn *ram.Client, arn string) (*ramtypes.ResourceShare, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(ramtypes.ResourceShareStatusActive, ramtypes.ResourceShareStatusDeleting),
+		Target:  []string{},
+		Refresh: statusManagedPrefixListResourceShare(ctx, conn, arn),
+		Timeout: managedPrefixListResourceShareTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ramtypes.ResourceShare); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitManagedPrefixListResourceSharePrincipalsAssociated waits for the specified principals' associations to become ASSOCIATED.
+// AWS account ID principals outside of the organization must accept the share before being associated, so they aren't waited on.
+func waitManagedPrefixListResourceSharePrincipalsAssociated(ctx context.Context, conn *ram.Client, arn string, principals []string) error {
+	return tfresource.WaitUntil(ctx, managedPrefixListResourceShareTimeout, func(ctx context.Context) (bool, error) {
+		associations, err := findManagedPrefixListResourceSharePrincipalAssociations(ctx, conn, arn)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, principal := range principals {
+			if itypes.IsAWSAccountID(principal) {
+				continue
+			}
+
+			association, ok := associations[principal]
+
+			if !ok || association.Status != ramtypes.ResourceShareAssociationStatusAssociated {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	})
+}
+
+func flattenManagedPrefixListResourceShare(apiObject *ramtypes.ResourceShare, associations map[string]ramtypes.ResourceShareAssociation) []any {
+	principals := make([]string, 0, len(associations))
+	for principal := range associations {
+		principals = append(principals, principal)
+	}
+
+	tfMap := map[string]any{
+		"allow_external_principals": aws.ToBool(apiObject.AllowExternalPrincipals),
+		"principals":                principals,
+		"resource_share_arn":        aws.ToString(apiObject.ResourceShareArn),
+	}
+
+	return []any{tfMap}
+}
@@ -9,10 +9,15 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -39,6 +44,7 @@ func TestAccVPCManagedPrefixList_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "address_family", "IPv4"),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`prefix-list/pl-[[:xdigit:]]+`)),
 					resource.TestCheckResourceAttr(resourceName, "entry.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "effective_max_entries", "1"),
 					resource.TestCheckResourceAttr(resourceName, "max_entries", "1"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrOwnerID),
@@ -63,6 +69,63 @@ func TestAccVPCManagedPrefixList_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCManagedPrefixList_adoptExisting(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var existingID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+					input := ec2.CreateManagedPrefixListInput{
+						AddressFamily:  aws.String("IPv4"),
+						Entries:        []awstypes.AddPrefixListEntry{{Cidr: aws.String("10.0.0.0/8")}},
+						MaxEntries:     aws.Int32(1),
+						PrefixListName: aws.String(rName),
+					}
+					output, err := conn.CreateManagedPrefixList(ctx, &input)
+
+					if err != nil {
+						t.Fatalf("creating EC2 Managed Prefix List (%s): %s", rName, err)
+					}
+
+					existingID = aws.ToString(output.PrefixList.PrefixListId)
+				},
+				Config: testAccVPCManagedPrefixListConfig_adoptExisting(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr": "1.0.0.0/8",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"cidr": "2.0.0.0/8",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttrPtr(resourceName, names.AttrID, &existingID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
 func TestAccVPCManagedPrefixList_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_managed_prefix_list.test"
@@ -271,6 +334,55 @@ func TestAccVPCManagedPrefixList_updateEntryAndMaxEntry(t *testing.T) {
 	})
 }
 
+func TestAccVPCManagedPrefixList_autoGrowMaxEntries(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedPrefixListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListConfig_autoGrowMaxEntries(rName, 2, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_grow_max_entries", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "effective_max_entries", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "1"),
+				),
+			},
+			{
+				Config: testAccVPCManagedPrefixListConfig_autoGrowMaxEntries(rName, 3, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("effective_max_entries"), knownvalue.Int64Exact(3)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "effective_max_entries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "1"),
+				),
+			},
+			{
+				Config:      testAccVPCManagedPrefixListConfig_autoGrowMaxEntries(rName, 3, 2),
+				ExpectError: regexache.MustCompile(`3 entries exceed auto_grow_max_entries_limit \(2\)`),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_grow_max_entries", "auto_grow_max_entries_limit", "max_entries"},
+			},
+		},
+	})
+}
+
 func TestAccVPCManagedPrefixList_name(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_managed_prefix_list.test"
@@ -310,6 +422,49 @@ func TestAccVPCManagedPrefixList_name(t *testing.T) {
 	})
 }
 
+func TestAccVPCManagedPrefixList_shareWith(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheckManagedPrefixList(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckManagedPrefixListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListConfig_shareWith(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "share_with.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "share_with.0.allow_external_principals", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "share_with.0.principals.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "share_with.0.principals.*", "data.aws_caller_identity.receiver", names.AttrAccountID),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "share_with.0.resource_share_arn", "ram", regexache.MustCompile(`resource-share/.+`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"share_with"},
+			},
+			{
+				Config: testAccVPCManagedPrefixListConfig_name(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccManagedPrefixListExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "share_with.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCManagedPrefixList_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_managed_prefix_list.test"
@@ -429,6 +584,29 @@ resource "aws_ec2_managed_prefix_list" "test" {
 `, rName, addressFamily)
 }
 
+func testAccVPCManagedPrefixListConfig_adoptExisting(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  adopt_existing = true
+  max_entries    = 2
+  name           = %[1]q
+
+  entry {
+    cidr = "1.0.0.0/8"
+  }
+
+  entry {
+    cidr = "2.0.0.0/8"
+  }
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName)
+}
+
 func testAccVPCManagedPrefixListConfig_entryCIDR1(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_managed_prefix_list" "test" {
@@ -489,6 +667,28 @@ resource "aws_ec2_managed_prefix_list" "test" {
 `, rName, description)
 }
 
+func testAccVPCManagedPrefixListConfig_autoGrowMaxEntries(rName string, entries, limit int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+
+  auto_grow_max_entries       = true
+  auto_grow_max_entries_limit = %[3]d
+
+  dynamic entry {
+    for_each = toset(slice(["1.0.0.0/8", "2.0.0.0/8", "3.0.0.0/8"], 0, %[2]d))
+
+    content {
+      cidr        = entry.key
+      description = entry.key
+    }
+  }
+}
+`, rName, entries, limit)
+}
+
 func testAccVPCManagedPrefixListConfig_entryMaxEntry(rName string, maxEntryLength int) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_managed_prefix_list" "test" {
@@ -518,6 +718,25 @@ resource "aws_ec2_managed_prefix_list" "test" {
 `, rName)
 }
 
+func testAccVPCManagedPrefixListConfig_shareWith(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "receiver" {
+  provider = "awsalternate"
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+
+  share_with {
+    allow_external_principals = true
+    principals                = [data.aws_caller_identity.receiver.account_id]
+  }
+}
+`, rName))
+}
+
 func testAccVPCManagedPrefixListConfig_updated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_managed_prefix_list" "test" {
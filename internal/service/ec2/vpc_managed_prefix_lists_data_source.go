@@ -36,7 +36,7 @@ func dataSourceManagedPrefixLists() *schema.Resource {
 func dataSourceManagedPrefixListsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
 
 	input := &ec2.DescribeManagedPrefixListsInput{}
 
@@ -44,6 +44,10 @@ func dataSourceManagedPrefixListsRead(ctx context.Context, d *schema.ResourceDat
 		svcTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))),
 	)...)
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -53,7 +57,7 @@ func dataSourceManagedPrefixListsRead(ctx context.Context, d *schema.ResourceDat
 		input.Filters = nil
 	}
 
-	prefixLists, err := findManagedPrefixLists(ctx, conn, input)
+	prefixLists, err := findManagedPrefixListsCached(ctx, c, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix Lists: %s", err)
@@ -63,6 +63,10 @@ func dataSourceNATGatewaysRead(ctx context.Context, d *schema.ResourceData, meta
 		)...)
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filter = append(input.Filter, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
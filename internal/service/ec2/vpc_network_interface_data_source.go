@@ -173,6 +173,10 @@ func dataSourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData,
 	input := &ec2.DescribeNetworkInterfacesInput{}
 
 	if v, ok := d.GetOk(names.AttrFilter); ok {
+		if err := validateCustomFilters(v.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = newCustomFilterList(v.(*schema.Set))
 	}
 
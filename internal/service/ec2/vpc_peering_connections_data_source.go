@@ -47,6 +47,10 @@ func dataSourceVPCPeeringConnectionsRead(ctx context.Context, d *schema.Resource
 	input.Filters = append(input.Filters, newTagFilterList(
 		svcTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))),
 	)...)
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -48,7 +48,7 @@ func dataSourcePrefixList() *schema.Resource {
 
 func dataSourcePrefixListRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+	c := meta.(*conns.AWSClient)
 
 	input := &ec2.DescribePrefixListsInput{}
 
@@ -62,11 +62,15 @@ func dataSourcePrefixListRead(ctx context.Context, d *schema.ResourceData, meta
 		input.PrefixListIds = []string{v.(string)}
 	}
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
 
-	pl, err := findPrefixList(ctx, conn, input)
+	pl, err := findPrefixListCached(ctx, c, input)
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Prefix List", err))
@@ -4,6 +4,7 @@
 package ec2_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -58,6 +59,30 @@ func TestAccVPCPrefixListDataSource_filter(t *testing.T) {
 	})
 }
 
+func TestAccVPCPrefixListDataSource_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_prefix_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPrefixListDataSourceConfig_region(acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "cidr_blocks.#", 0),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, fmt.Sprintf("com.amazonaws.%s.s3", acctest.AlternateRegion())),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, acctest.AlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCPrefixListDataSource_nameDoesNotOverrideFilter(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -115,3 +140,12 @@ data "aws_prefix_list" "test" {
   }
 }
 `
+
+func testAccVPCPrefixListDataSourceConfig_region(region string) string {
+	return fmt.Sprintf(`
+data "aws_prefix_list" "test" {
+  region = %[1]q
+  name   = "com.amazonaws.%[1]s.s3"
+}
+`, region)
+}
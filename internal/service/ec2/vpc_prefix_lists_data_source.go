@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_prefix_lists", name="Prefix Lists")
+func dataSourcePrefixLists() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePrefixListsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: customFiltersSchema(),
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"prefix_lists": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrServiceName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePrefixListsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+
+	input := &ec2.DescribePrefixListsInput{}
+
+	// Prefix list names are of the form "com.amazonaws.<region>.<service>".
+	prefix := fmt.Sprintf("%s.%s.", c.ReverseDNSPrefix(ctx), c.Region(ctx))
+
+	if v, ok := d.GetOk("service_names"); ok && v.(*schema.Set).Len() > 0 {
+		var prefixListNames []string
+		for _, v := range flex.ExpandStringValueSet(v.(*schema.Set)) {
+			prefixListNames = append(prefixListNames, prefix+v)
+		}
+
+		input.Filters = append(input.Filters, newFilter("prefix-list-name", prefixListNames))
+	}
+
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input.Filters = append(input.Filters, newCustomFilterList(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	prefixLists, err := findPrefixListsCached(ctx, c, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Prefix Lists: %s", err)
+	}
+
+	var prefixListIDs, prefixListNames []string
+	var tfList []any
+
+	for _, v := range prefixLists {
+		id, name := aws.ToString(v.PrefixListId), aws.ToString(v.PrefixListName)
+		prefixListIDs = append(prefixListIDs, id)
+		prefixListNames = append(prefixListNames, name)
+		tfList = append(tfList, map[string]any{
+			names.AttrID:          id,
+			names.AttrName:        name,
+			names.AttrServiceName: strings.TrimPrefix(name, prefix),
+		})
+	}
+
+	d.SetId(c.Region(ctx))
+	d.Set(names.AttrIDs, prefixListIDs)
+	d.Set(names.AttrNames, prefixListNames)
+	if err := d.Set("prefix_lists", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting prefix_lists: %s", err)
+	}
+
+	return diags
+}
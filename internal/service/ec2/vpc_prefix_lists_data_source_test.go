@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCPrefixListsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPrefixListsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "ids.#", 1),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "names.#", 1),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "prefix_lists.#", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPrefixListsDataSource_serviceNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_prefix_lists.test"
+	s3DataSourceName := "data.aws_prefix_list.s3"
+	dynamoDBDataSourceName := "data.aws_prefix_list.dynamodb"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPrefixListsDataSourceConfig_serviceNames,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", s3DataSourceName, names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", dynamoDBDataSourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", s3DataSourceName, names.AttrName),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", dynamoDBDataSourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "prefix_lists.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "prefix_lists.*", map[string]string{
+						names.AttrServiceName: "s3",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "prefix_lists.*", map[string]string{
+						names.AttrServiceName: "dynamodb",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPrefixListsDataSource_noMatches(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_prefix_lists.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPrefixListsDataSourceConfig_noMatches,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "prefix_lists.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVPCPrefixListsDataSourceConfig_basic = `
+data "aws_prefix_lists" "test" {}
+`
+
+const testAccVPCPrefixListsDataSourceConfig_serviceNames = `
+data "aws_region" "current" {}
+
+data "aws_prefix_lists" "test" {
+  service_names = ["dynamodb", "s3"]
+}
+
+data "aws_prefix_list" "s3" {
+  name = "com.amazonaws.${data.aws_region.current.region}.s3"
+}
+
+data "aws_prefix_list" "dynamodb" {
+  name = "com.amazonaws.${data.aws_region.current.region}.dynamodb"
+}
+`
+
+const testAccVPCPrefixListsDataSourceConfig_noMatches = `
+data "aws_prefix_lists" "test" {
+  filter {
+    name   = "prefix-list-name"
+    values = ["no-match"]
+  }
+}
+`
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -66,6 +67,8 @@ func resourceRoute() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: resourceRouteCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Type:     schema.TypeString,
@@ -181,6 +184,21 @@ func resourceRoute() *schema.Resource {
 	}
 }
 
+func resourceRouteCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	prefixListID, ok := knownStringValue(diff.GetRawConfig().GetAttr(routeDestinationPrefixListID))
+	if !ok {
+		return nil
+	}
+
+	for _, key := range routeValidTargets {
+		if _, ok := knownStringValue(diff.GetRawConfig().GetAttr(key)); ok {
+			return validPrefixListRouteTarget(ctx, meta.(*conns.AWSClient), prefixListID, key)
+		}
+	}
+
+	return nil
+}
+
 func resourceRouteCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -528,3 +546,49 @@ func routeTargetAttribute(d *schema.ResourceData) (string, string, error) {
 
 	return "", "", fmt.Errorf("route target attribute not specified")
 }
+
+// validPrefixListRouteTarget returns an error if the specified target does not support the address family of the specified prefix list.
+// Errors reading the prefix list are left for the EC2 API to report at apply time.
+func validPrefixListRouteTarget(ctx context.Context, c *conns.AWSClient, prefixListID, targetKey string) error {
+	var addressFamily string
+	switch targetKey {
+	case "carrier_gateway_id": // IPv4 destinations only.
+		addressFamily = "IPv4"
+	case "egress_only_gateway_id": // IPv6 destinations only.
+		addressFamily = "IPv6"
+	default:
+		return nil
+	}
+
+	input := ec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{prefixListID},
+	}
+	pl, err := findManagedPrefixListCached(ctx, c, &input)
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Managed Prefix List (%s): %w", prefixListID, err)
+	}
+
+	if v := aws.ToString(pl.AddressFamily); v != addressFamily {
+		return fmt.Errorf("%s supports only %s prefix lists, but %s (%s) is %s", targetKey, addressFamily, routeDestinationPrefixListID, prefixListID, v)
+	}
+
+	return nil
+}
+
+// knownStringValue returns the value of a known, non-null and non-empty string.
+func knownStringValue(v cty.Value) (string, bool) {
+	if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return "", false
+	}
+
+	if s := v.AsString(); s != "" {
+		return s, true
+	}
+
+	return "", false
+}
@@ -69,6 +69,8 @@ func resourceRouteTable() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: resourceRouteTableCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -166,6 +168,58 @@ func resourceRouteTable() *schema.Resource {
 	}
 }
 
+func resourceRouteTableCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	routes := diff.GetRawConfig().GetAttr("route")
+	if !routes.IsKnown() || routes.IsNull() || !routes.CanIterateElements() {
+		return nil
+	}
+
+	for it := routes.ElementIterator(); it.Next(); {
+		_, route := it.Element()
+		if !route.IsKnown() || route.IsNull() {
+			continue
+		}
+
+		prefixListID, ok := knownStringValue(route.GetAttr("destination_prefix_list_id"))
+		if !ok {
+			continue
+		}
+
+		// Only validate the route's targets once they are all known.
+		tfMap := make(map[string]any)
+		allKnown := true
+		for _, key := range routeTableValidTargets {
+			v := route.GetAttr(key)
+			if !v.IsWhollyKnown() {
+				allKnown = false
+				break
+			}
+			if v, ok := knownStringValue(v); ok {
+				tfMap[key] = v
+			}
+		}
+		if !allKnown {
+			continue
+		}
+
+		if err := validNestedExactlyOneOf(tfMap, routeTableValidTargets); err != nil {
+			return fmt.Errorf("route with destination_prefix_list_id (%s): %w", prefixListID, err)
+		}
+
+		// "Cannot create or replace a prefix list route targeting a VPC Endpoint."
+		if _, ok := tfMap[names.AttrVPCEndpointID]; ok {
+			return fmt.Errorf("route with destination_prefix_list_id (%s): %s cannot be specified", prefixListID, names.AttrVPCEndpointID)
+		}
+
+		targetKey, _ := routeTableRouteTargetAttribute(tfMap)
+		if err := validPrefixListRouteTarget(ctx, meta.(*conns.AWSClient), prefixListID, targetKey); err != nil {
+			return fmt.Errorf("route with destination_prefix_list_id (%s): %w", prefixListID, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
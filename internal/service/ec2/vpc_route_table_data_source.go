@@ -213,6 +213,10 @@ func dataSourceRouteTableRead(ctx context.Context, d *schema.ResourceData, meta
 	req.Filters = append(req.Filters, newTagFilterList(
 		svcTags(tftags.New(ctx, tags.(map[string]any))),
 	)...)
+	if err := validateCustomFilters(filter.(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	req.Filters = append(req.Filters, newCustomFilterList(
 		filter.(*schema.Set),
 	)...)
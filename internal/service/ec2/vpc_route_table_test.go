@@ -997,6 +997,24 @@ func TestAccVPCRouteTable_prefixListToInternetGateway(t *testing.T) {
 	})
 }
 
+func TestAccVPCRouteTable_prefixListMultipleTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCRouteTableConfig_prefixListMultipleTargets(rName),
+				ExpectError: regexache.MustCompile(`route with destination_prefix_list_id \(pl-[0-9a-z]+\): only one of`),
+			},
+		},
+	})
+}
+
 func TestAccVPCRouteTable_localRoute(t *testing.T) {
 	ctx := acctest.Context(t)
 	var routeTable awstypes.RouteTable
@@ -2356,6 +2374,54 @@ resource "aws_route_table" "test" {
 `, rName)
 }
 
+func testAccVPCRouteTableConfig_prefixListMultipleTargets(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_egress_only_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    destination_prefix_list_id = aws_ec2_managed_prefix_list.test.id
+    gateway_id                 = aws_internet_gateway.test.id
+    egress_only_gateway_id     = aws_egress_only_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
 func testAccVPCRouteTableConfig_ipv4Local(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
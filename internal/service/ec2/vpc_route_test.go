@@ -2249,6 +2249,24 @@ func TestAccVPCRoute_prefixListToEgressOnlyInternetGateway(t *testing.T) {
 	})
 }
 
+func TestAccVPCRoute_prefixListToEgressOnlyInternetGatewayIPv4(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCRouteConfig_prefixListEgressOnlyInternetGatewayAddressFamily(rName, "IPv4"),
+				ExpectError: regexache.MustCompile(`egress_only_gateway_id supports only IPv6 prefix lists`),
+			},
+		},
+	})
+}
+
 func TestAccVPCRoute_duplicate(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -4479,6 +4497,10 @@ resource "aws_route" "test" {
 }
 
 func testAccVPCRouteConfig_prefixListEgressOnlyInternetGateway(rName string) string {
+	return testAccVPCRouteConfig_prefixListEgressOnlyInternetGatewayAddressFamily(rName, "IPv6")
+}
+
+func testAccVPCRouteConfig_prefixListEgressOnlyInternetGatewayAddressFamily(rName, addressFamily string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
   cidr_block                       = "10.1.0.0/16"
@@ -4498,7 +4520,7 @@ resource "aws_egress_only_internet_gateway" "test" {
 }
 
 resource "aws_ec2_managed_prefix_list" "test" {
-  address_family = "IPv6"
+  address_family = %[2]q
   max_entries    = 1
   name           = %[1]q
 }
@@ -4516,7 +4538,7 @@ resource "aws_route" "test" {
   destination_prefix_list_id = aws_ec2_managed_prefix_list.test.id
   egress_only_gateway_id     = aws_egress_only_internet_gateway.test.id
 }
-`, rName)
+`, rName, addressFamily)
 }
 
 func testAccVPCRouteConfig_ipv4CarrierGateway(rName, destinationCidr string) string {
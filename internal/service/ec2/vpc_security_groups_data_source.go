@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -34,7 +35,7 @@ func dataSourceSecurityGroups() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			names.AttrFilter: customFiltersSchema(),
+			names.AttrFilter: customFiltersWithExclusionSchema(),
 			names.AttrIDs: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -61,6 +62,10 @@ func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		svcTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))),
 	)...)
 
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -75,6 +80,18 @@ func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Security Groups: %s", err)
 	}
 
+	output, err = excludeByCustomFilters(ctx, output, input.Filters, newCustomExclusionFilterList(d.Get(names.AttrFilter).(*schema.Set)), func(ctx context.Context, filters []awstypes.Filter) ([]awstypes.SecurityGroup, error) {
+		return findSecurityGroups(ctx, conn, &ec2.DescribeSecurityGroupsInput{
+			Filters: filters,
+		})
+	}, func(v *awstypes.SecurityGroup) string {
+		return aws.ToString(v.GroupId)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Security Groups: excluding filtered resources: %s", err)
+	}
+
 	var arns, securityGroupIDs, vpcIDs []string
 
 	for _, v := range output {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -27,7 +28,7 @@ func dataSourceSubnets() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			names.AttrFilter: customFiltersSchema(),
+			names.AttrFilter: customFiltersWithExclusionSchema(),
 			names.AttrIDs: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -51,6 +52,10 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	if filters, filtersOk := d.GetOk(names.AttrFilter); filtersOk {
+		if err := validateCustomFilters(filters.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = append(input.Filters,
 			newCustomFilterList(filters.(*schema.Set))...)
 	}
@@ -65,6 +70,18 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta any
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Subnets: %s", err)
 	}
 
+	output, err = excludeByCustomFilters(ctx, output, input.Filters, newCustomExclusionFilterList(d.Get(names.AttrFilter).(*schema.Set)), func(ctx context.Context, filters []awstypes.Filter) ([]awstypes.Subnet, error) {
+		return findSubnets(ctx, conn, &ec2.DescribeSubnetsInput{
+			Filters: filters,
+		})
+	}, func(v *awstypes.Subnet) string {
+		return aws.ToString(v.SubnetId)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Subnets: excluding filtered resources: %s", err)
+	}
+
 	var subnetIDs []string
 
 	for _, v := range output {
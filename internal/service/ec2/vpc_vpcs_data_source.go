@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -27,7 +28,7 @@ func dataSourceVPCs() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			names.AttrFilter: customFiltersSchema(),
+			names.AttrFilter: customFiltersWithExclusionSchema(),
 			names.AttrIDs: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -51,6 +52,10 @@ func dataSourceVPCsRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	}
 
 	if filters, filtersOk := d.GetOk(names.AttrFilter); filtersOk {
+		if err := validateCustomFilters(filters.(*schema.Set)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input.Filters = append(input.Filters,
 			newCustomFilterList(filters.(*schema.Set))...)
 	}
@@ -65,6 +70,18 @@ func dataSourceVPCsRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "reading EC2 VPCs: %s", err)
 	}
 
+	output, err = excludeByCustomFilters(ctx, output, input.Filters, newCustomExclusionFilterList(d.Get(names.AttrFilter).(*schema.Set)), func(ctx context.Context, filters []awstypes.Filter) ([]awstypes.Vpc, error) {
+		return findVPCs(ctx, conn, &ec2.DescribeVpcsInput{
+			Filters: filters,
+		})
+	}, func(v *awstypes.Vpc) string {
+		return aws.ToString(v.VpcId)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPCs: excluding filtered resources: %s", err)
+	}
+
 	var vpcIDs []string
 
 	for _, v := range output {
@@ -70,6 +70,26 @@ func TestAccVPCsDataSource_filters(t *testing.T) {
 	})
 }
 
+func TestAccVPCsDataSource_filterTagsAndExclude(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCVPCsDataSourceConfig_filterTagsAndExclude(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpcs.test", "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.aws_vpcs.test", "ids.*", "aws_vpc.test.0", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCsDataSource_empty(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -151,3 +171,34 @@ data "aws_vpcs" "test" {
 }
 `, rName)
 }
+
+func testAccVPCVPCsDataSourceConfig_filterTagsAndExclude(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/24"
+
+  tags = {
+    Name  = %[1]q
+    Index = count.index
+  }
+}
+
+data "aws_vpcs" "test" {
+  filter {
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  filter {
+    name    = "vpc-id"
+    values  = [aws_vpc.test[1].id]
+    exclude = true
+  }
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
@@ -103,6 +103,10 @@ func dataSourceVPNGatewayRead(ctx context.Context, d *schema.ResourceData, meta
 	input.Filters = append(input.Filters, newTagFilterList(
 		svcTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))),
 	)...)
+	if err := validateCustomFilters(d.Get(names.AttrFilter).(*schema.Set)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
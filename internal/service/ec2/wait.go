@@ -1248,6 +1248,31 @@ func waitManagedPrefixListModified(ctx context.Context, conn *ec2.Client, id str
 	return nil, err
 }
 
+// waitManagedPrefixListVersionModified waits for a managed prefix list modification to complete and for
+// the specified version (or a later one) to be consistently visible, so that resources referencing the
+// prefix list in the same apply observe the new entries.
+func waitManagedPrefixListVersionModified(ctx context.Context, conn *ec2.Client, id string, version int64) (*awstypes.ManagedPrefixList, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(strconv.FormatBool(false)),
+		Target:                    enum.Slice(strconv.FormatBool(true)),
+		Timeout:                   managedPrefixListTimeout,
+		Refresh:                   statusManagedPrefixListVersion(ctx, conn, id, version),
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ManagedPrefixList); ok {
+		if output.State == awstypes.PrefixListStateModifyFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitNATGatewayAddressAssigned(ctx context.Context, conn *ec2.Client, natGatewayID, privateIP string, timeout time.Duration) (*awstypes.NatGatewayAddress, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.NatGatewayAddressStatusAssigning),
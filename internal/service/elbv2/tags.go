@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// AddTags and RemoveTags accept up to 20 resource ARNs.
+const tagsBatchMaxResources = 20
+
+// updateTagsBatched updates elbv2 service tags.
+// It is equivalent to updateTags except that removed and updated tags are applied concurrently and
+// the AddTags and RemoveTags calls are combined with those for other resources receiving identical changes.
+func updateTagsBatched(ctx context.Context, c *conns.AWSClient, identifier string, oldTagsMap, newTagsMap any) error {
+	conn := c.ELBV2Client(ctx)
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	ctx = tflog.SetField(ctx, logging.KeyResourceId, identifier)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.ELBV2)
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.ELBV2)
+
+	untag := func(ctx context.Context, identifiers []string, tags tftags.KeyValueTags) error {
+		input := elasticloadbalancingv2.RemoveTagsInput{
+			ResourceArns: identifiers,
+			TagKeys:      tags.Keys(),
+		}
+
+		_, err := conn.RemoveTags(ctx, &input)
+
+		return err
+	}
+	tag := func(ctx context.Context, identifiers []string, tags tftags.KeyValueTags) error {
+		input := elasticloadbalancingv2.AddTagsInput{
+			ResourceArns: identifiers,
+			Tags:         svcTags(tags),
+		}
+
+		_, err := conn.AddTags(ctx, &input)
+
+		return err
+	}
+
+	// Tag operations for different clients or Regions must never be combined.
+	keyPrefix := fmt.Sprintf("%p/%s", conn, conn.Options().Region)
+
+	return c.TagsBatcher(ctx, names.ELBV2, tagsBatchMaxResources).Update(ctx, keyPrefix, identifier, removedTags, updatedTags, untag, tag)
+}
@@ -151,5 +151,5 @@ func updateTags(ctx context.Context, conn *elasticloadbalancingv2.Client, identi
 // UpdateTags updates elbv2 service tags.
 // It is called from outside this package.
 func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
-	return updateTags(ctx, meta.(*conns.AWSClient).ELBV2Client(ctx), identifier, oldTags, newTags)
+	return updateTagsBatched(ctx, meta.(*conns.AWSClient), identifier, oldTags, newTags)
 }
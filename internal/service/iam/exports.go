@@ -8,6 +8,7 @@ var (
 	ResourceRole = resourceRole
 
 	DeleteServiceLinkedRole = deleteServiceLinkedRole
+	FindPolicyByARN         = findPolicyByARN
 	FindRoleByName          = findRoleByName
 	ListGroupsForUserPages  = listGroupsForUserPages
 	AttachPolicyToUser      = attachPolicyToUser
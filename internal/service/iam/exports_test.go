@@ -47,7 +47,6 @@ var (
 	FindInstanceProfileByName           = findInstanceProfileByName
 	FindOpenIDConnectProviderByARN      = findOpenIDConnectProviderByARN
 	FindOrganizationsFeatures           = findOrganizationsFeatures
-	FindPolicyByARN                     = findPolicyByARN
 	FindRolePolicyByTwoPartKey          = findRolePolicyByTwoPartKey
 	FindRolePoliciesByName              = findRolePoliciesByName
 	FindRolePolicyAttachmentsByName     = findRolePolicyAttachmentsByName
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpreview"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -64,7 +65,36 @@ func resourceAlias() *schema.Resource {
 				DiffSuppressFunc: suppressEquivalentKeyARNOrID,
 			},
 		},
+
+		CustomizeDiff: aliasPlanOperationsCustomizeDiff,
+	}
+}
+
+// aliasPlanOperationsCustomizeDiff records the AWS API operations that applying the planned change will make.
+// A replacement is recorded as the deletion of the existing alias followed by the creation of a new alias.
+func aliasPlanOperationsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+	if !planpreview.Enabled(ctx) {
+		return nil
 	}
+
+	switch {
+	case planpreview.ResourceDiffRequiresReplacement(d, names.AttrName, names.AttrNamePrefix):
+		planpreview.Record(ctx, "kms", "DeleteAlias", map[string]any{
+			"AliasName": d.Id(),
+		})
+	case d.Id() == "":
+		planpreview.Record(ctx, "kms", "CreateAlias", map[string]any{
+			"AliasName":   planpreview.ResourceDiffValue(d, names.AttrName),
+			"TargetKeyId": planpreview.ResourceDiffValue(d, "target_key_id"),
+		})
+	case d.HasChange("target_key_id"):
+		planpreview.Record(ctx, "kms", "UpdateAlias", map[string]any{
+			"AliasName":   d.Id(),
+			"TargetKeyId": planpreview.ResourceDiffValue(d, "target_key_id"),
+		})
+	}
+
+	return nil
 }
 
 func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_kms_aliases", name="Aliases")
+func dataSourceAliases() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAliasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"aliases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_key_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrKeyID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAliasesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	input := &kms.ListAliasesInput{}
+
+	if v, ok := d.GetOk(names.AttrKeyID); ok {
+		input.KeyId = aws.String(v.(string))
+	}
+
+	aliases, err := findAliases(ctx, conn, input, tfslices.PredicateTrue[*awstypes.AliasListEntry]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Aliases: %s", err)
+	}
+
+	var tfList []any
+	var aliasNames []string
+
+	for _, alias := range aliases {
+		aliasARN := aws.ToString(alias.AliasArn)
+		tfMap := map[string]any{
+			names.AttrARN:  aliasARN,
+			names.AttrName: aws.ToString(alias.AliasName),
+		}
+
+		// ListAliases doesn't return a TargetKeyId for an AWS managed key alias that hasn't been used yet.
+		if targetKeyID := aws.ToString(alias.TargetKeyId); targetKeyID != "" {
+			targetKeyARN, err := aliasARNToKeyARN(aliasARN, targetKeyID)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading KMS Aliases: %s", err)
+			}
+
+			tfMap["target_key_arn"] = targetKeyARN
+			tfMap["target_key_id"] = targetKeyID
+		}
+
+		tfList = append(tfList, tfMap)
+		aliasNames = append(aliasNames, aws.ToString(alias.AliasName))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	if err := d.Set("aliases", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aliases: %s", err)
+	}
+	d.Set(names.AttrNames, aliasNames)
+
+	return diags
+}
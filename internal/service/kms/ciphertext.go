@@ -5,15 +5,22 @@ package kms
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -22,9 +29,11 @@ import (
 func resourceCiphertext() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCiphertextCreate,
-		ReadWithoutTimeout:   schema.NoopContext,
+		ReadWithoutTimeout:   resourceCiphertextRead,
 		DeleteWithoutTimeout: schema.NoopContext,
 
+		CustomizeDiff: resourceCiphertextKeyUsageCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"ciphertext_blob": {
 				Type:     schema.TypeString,
@@ -36,6 +45,10 @@ func resourceCiphertext() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"context_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrKeyID: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -75,5 +88,77 @@ func resourceCiphertextCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(time.Now().UTC().String())
 	d.Set("ciphertext_blob", itypes.Base64Encode(output.CiphertextBlob))
 
+	return append(diags, resourceCiphertextRead(ctx, d, meta)...)
+}
+
+func resourceCiphertextRead(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The ciphertext can't be read back; only the encryption context hash is (re)computed.
+	contextHash, err := encryptionContextHash(flex.ExpandStringValueMap(d.Get("context").(map[string]any)))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("context_hash", contextHash)
+
 	return diags
 }
+
+func resourceCiphertextKeyUsageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" || !d.NewValueKnown(names.AttrKeyID) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+	keyID := d.Get(names.AttrKeyID).(string)
+
+	err := validateKeyUsageEncryptDecrypt(ctx, conn, keyID)
+
+	// The key may be created in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// validateKeyUsageEncryptDecrypt returns an error if the specified KMS key can't be used to encrypt and decrypt data.
+// The check is best-effort: a caller allowed to encrypt with the key isn't necessarily allowed to describe it.
+func validateKeyUsageEncryptDecrypt(ctx context.Context, conn *kms.Client, keyID string) error {
+	key, err := findKeyByID(ctx, conn, keyID)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading KMS Key (%s): %w", keyID, err)
+	}
+
+	if usage := key.KeyUsage; usage != awstypes.KeyUsageTypeEncryptDecrypt {
+		return fmt.Errorf("KMS Key (%s) has key usage %s; encryption requires a key with key usage %s", keyID, usage, awstypes.KeyUsageTypeEncryptDecrypt)
+	}
+
+	return nil
+}
+
+// encryptionContextHash returns the hex-encoded SHA-256 hash of the canonical JSON encoding of an encryption context.
+// Unlike ciphertext, which differs on every encryption, the hash is stable for a given encryption context.
+func encryptionContextHash(encryptionContext map[string]string) (string, error) {
+	if encryptionContext == nil {
+		encryptionContext = map[string]string{}
+	}
+
+	// encoding/json sorts map keys.
+	b, err := json.Marshal(encryptionContext)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding KMS encryption context: %w", err)
+	}
+
+	hash := sha256.Sum256(b)
+
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -32,6 +32,10 @@ func dataSourceCiphertext() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"context_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrKeyID: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -59,14 +63,25 @@ func dataSourceCiphertextRead(ctx context.Context, d *schema.ResourceData, meta
 		input.EncryptionContext = flex.ExpandStringValueMap(v.(map[string]any))
 	}
 
+	if err := validateKeyUsageEncryptDecrypt(ctx, conn, keyID); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	output, err := conn.Encrypt(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "encrypting with KMS Key (%s): %s", keyID, err)
 	}
 
+	contextHash, err := encryptionContextHash(input.EncryptionContext)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	d.SetId(aws.ToString(output.KeyId))
 	d.Set("ciphertext_blob", itypes.Base64Encode(output.CiphertextBlob))
+	d.Set("context_hash", contextHash)
 
 	return diags
 }
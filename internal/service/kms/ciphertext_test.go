@@ -4,8 +4,11 @@
 package kms_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -25,6 +28,7 @@ func TestAccKMSCiphertext_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
 						"aws_kms_ciphertext.test", "ciphertext_blob"),
+					resource.TestCheckResourceAttr("aws_kms_ciphertext.test", "context_hash", "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a"),
 				),
 			},
 		},
@@ -68,6 +72,7 @@ func TestAccKMSCiphertext_Validate_withContext(t *testing.T) {
 				Config: testAccCiphertextConfig_validateContext,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "ciphertext_blob"),
+					resource.TestCheckResourceAttr(resourceName, "context_hash", "ae1fca77a81ea8b568ef60cdad1dee6bae1faaf716cddca2f5750b7cdc9b6ed4"),
 					resource.TestCheckResourceAttrPair(resourceName, "plaintext", kmsSecretsDataSource, "plaintext.plaintext"),
 				),
 			},
@@ -75,6 +80,27 @@ func TestAccKMSCiphertext_Validate_withContext(t *testing.T) {
 	})
 }
 
+func TestAccKMSCiphertext_keyUsageSignVerify(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCiphertextConfig_keySignVerify(rName),
+			},
+			{
+				Config:      testAccCiphertextConfig_keyUsageSignVerify(rName),
+				ExpectError: regexache.MustCompile(`has key usage SIGN_VERIFY; encryption requires a key with key usage ENCRYPT_DECRYPT`),
+			},
+		},
+	})
+}
+
 const testAccCiphertextConfig_basic = `
 resource "aws_kms_key" "test" {
   description             = "tf-test-acc-data-source-aws-kms-ciphertext-basic"
@@ -141,3 +167,24 @@ data "aws_kms_secrets" "test" {
   }
 }
 `
+
+func testAccCiphertextConfig_keySignVerify(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description              = %[1]q
+  customer_master_key_spec = "ECC_NIST_P256"
+  key_usage                = "SIGN_VERIFY"
+  deletion_window_in_days  = 7
+}
+`, rName)
+}
+
+func testAccCiphertextConfig_keyUsageSignVerify(rName string) string {
+	return acctest.ConfigCompose(testAccCiphertextConfig_keySignVerify(rName), `
+resource "aws_kms_ciphertext" "test" {
+  key_id = aws_kms_key.test.key_id
+
+  plaintext = "Super secret data"
+}
+`)
+}
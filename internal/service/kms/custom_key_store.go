@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -62,6 +64,21 @@ func resourceCustomKeyStore() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(7, 32)),
+				ConflictsWith:    []string{"key_store_password_wo"},
+			},
+			"key_store_password_wo": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				WriteOnly:        true,
+				Sensitive:        true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(7, 32)),
+				ConflictsWith:    []string{"key_store_password"},
+				RequiredWith:     []string{"key_store_password_wo_version"},
+			},
+			"key_store_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"key_store_password_wo"},
 			},
 			"trust_anchor_certificate": {
 				Type:     schema.TypeString,
@@ -79,8 +96,22 @@ func resourceCustomKeyStore() *schema.Resource {
 							Required: true,
 						},
 						"raw_secret_access_key": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"xks_proxy_authentication_credential.0.raw_secret_access_key", "xks_proxy_authentication_credential.0.raw_secret_access_key_wo"},
+						},
+						"raw_secret_access_key_wo": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							WriteOnly:    true,
+							Sensitive:    true,
+							ExactlyOneOf: []string{"xks_proxy_authentication_credential.0.raw_secret_access_key", "xks_proxy_authentication_credential.0.raw_secret_access_key_wo"},
+							RequiredWith: []string{"xks_proxy_authentication_credential.0.raw_secret_access_key_wo_version"},
+						},
+						"raw_secret_access_key_wo_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"xks_proxy_authentication_credential.0.raw_secret_access_key_wo"},
 						},
 					},
 				},
@@ -127,12 +158,28 @@ func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, m
 		input.KeyStorePassword = aws.String(v.(string))
 	}
 
+	keyStorePasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("key_store_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if keyStorePasswordWO != "" {
+		input.KeyStorePassword = aws.String(keyStorePasswordWO)
+	}
+
 	if v, ok := d.GetOk("trust_anchor_certificate"); ok {
 		input.TrustAnchorCertificate = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-		input.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]any))
+		apiObject, di := expandXksProxyAuthenticationCredentialWithWriteOnly(d, v.([]any))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.XksProxyAuthenticationCredential = apiObject
 	}
 
 	if v, ok := d.GetOk("xks_proxy_connectivity"); ok {
@@ -214,8 +261,26 @@ func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 		input.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
 	}
 
+	if d.HasChange("key_store_password_wo_version") {
+		keyStorePasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("key_store_password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if keyStorePasswordWO != "" {
+			input.KeyStorePassword = aws.String(keyStorePasswordWO)
+		}
+	}
+
 	if d.HasChange("xks_proxy_authentication_credential") {
-		input.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(d.Get("xks_proxy_authentication_credential").(*schema.Set).List())
+		apiObject, di := expandXksProxyAuthenticationCredentialWithWriteOnly(d, d.Get("xks_proxy_authentication_credential").([]any))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.XksProxyAuthenticationCredential = apiObject
 	}
 
 	if d.HasChange("xks_proxy_connectivity") {
@@ -321,9 +386,30 @@ func expandXksProxyAuthenticationCredential(tfList []any) *awstypes.XksProxyAuth
 		apiObject.AccessKeyId = aws.String(v)
 	}
 
-	if v, ok := tfMap["raw_secret_access_key"].(string); ok {
+	if v, ok := tfMap["raw_secret_access_key"].(string); ok && v != "" {
 		apiObject.RawSecretAccessKey = aws.String(v)
 	}
 
 	return apiObject
 }
+
+// expandXksProxyAuthenticationCredentialWithWriteOnly expands the XKS proxy authentication credential,
+// taking the secret access key from the write-only attribute in the config if it is set.
+func expandXksProxyAuthenticationCredentialWithWriteOnly(d *schema.ResourceData, tfList []any) (*awstypes.XksProxyAuthenticationCredentialType, diag.Diagnostics) {
+	apiObject := expandXksProxyAuthenticationCredential(tfList)
+
+	if apiObject == nil {
+		return nil, nil
+	}
+
+	rawSecretAccessKeyWO, diags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("xks_proxy_authentication_credential").IndexInt(0).GetAttr("raw_secret_access_key_wo"))
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if rawSecretAccessKeyWO != "" {
+		apiObject.RawSecretAccessKey = aws.String(rawSecretAccessKeyWO)
+	}
+
+	return apiObject, diags
+}
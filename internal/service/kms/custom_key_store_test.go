@@ -13,6 +13,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
@@ -99,6 +100,52 @@ func testAccCustomKeyStore_update(t *testing.T) {
 	})
 }
 
+func testAccCustomKeyStore_writeOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	clusterID := acctest.SkipIfEnvVarNotSet(t, "CLOUD_HSM_CLUSTER_ID")
+	trustAnchorCertificate := acctest.SkipIfEnvVarNotSet(t, "TRUST_ANCHOR_CERTIFICATE")
+	var customkeystore awstypes.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KMSEndpointID)
+			testAccCustomKeyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckCustomKeyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_writeOnly(rName, clusterID, trustAnchorCertificate, "noplaintextpasswords1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckNoResourceAttr(resourceName, "key_store_password"),
+					resource.TestCheckNoResourceAttr(resourceName, "key_store_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "key_store_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_writeOnly(rName, clusterID, trustAnchorCertificate, "noplaintextpasswords2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckNoResourceAttr(resourceName, "key_store_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "key_store_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCustomKeyStore_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -205,3 +252,16 @@ resource "aws_kms_custom_key_store" "test" {
 }
 `, rName, clusterId, anchorCertificate)
 }
+
+func testAccCustomKeyStoreConfig_writeOnly(rName, clusterId, anchorCertificate, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id          = %[2]q
+  custom_key_store_name         = %[1]q
+  key_store_password_wo         = %[4]q
+  key_store_password_wo_version = %[5]d
+
+  trust_anchor_certificate = file(%[3]q)
+}
+`, rName, clusterId, anchorCertificate, password, passwordVersion)
+}
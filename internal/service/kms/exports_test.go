@@ -15,20 +15,31 @@ var (
 	ResourceReplicaExternalKey = resourceReplicaExternalKey
 	ResourceReplicaKey         = resourceReplicaKey
 
-	AliasARNToKeyARN          = aliasARNToKeyARN
-	AliasNamePrefix           = aliasNamePrefix
-	FindCustomKeyStoreByID    = findCustomKeyStoreByID
-	FindGrantByTwoPartKey     = findGrantByTwoPartKey
-	FindKeyByID               = findKeyByID
-	FindKeyPolicyByTwoPartKey = findKeyPolicyByTwoPartKey
-	GrantParseResourceID      = grantParseResourceID
-	KeyARNOrIDEqual           = keyARNOrIDEqual
-	PropagationTimeout        = propagationTimeout
-	PolicyNameDefault         = policyNameDefault
-	SecretRemovedMessage      = secretRemovedMessage
+	AliasARNToKeyARN                     = aliasARNToKeyARN
+	AliasNamePrefix                      = aliasNamePrefix
+	FindCustomKeyStoreByID               = findCustomKeyStoreByID
+	FindGrantByTwoPartKey                = findGrantByTwoPartKey
+	FindKeyByID                          = findKeyByID
+	FindKeyPolicyByTwoPartKey            = findKeyPolicyByTwoPartKey
+	FlattenKeyServiceUsages              = flattenKeyServiceUsages
+	GrantParseResourceID                 = grantParseResourceID
+	KeyARNOrIDEqual                      = keyARNOrIDEqual
+	KeyPolicyWithAccountRootStatement    = keyPolicyWithAccountRootStatement
+	KeyPolicyWithoutAccountRootStatement = keyPolicyWithoutAccountRootStatement
+	KeyServiceUsages                     = keyServiceUsages
+	MergeKeyPolicyStatements             = mergeKeyPolicyStatements
+	MultiRegionKeyARNInRegion            = multiRegionKeyARNInRegion
+	OwnedKeyPolicy                       = ownedKeyPolicy
+	PropagationTimeout                   = propagationTimeout
+	PolicyNameDefault                    = policyNameDefault
+	SecretRemovedMessage                 = secretRemovedMessage
 
-	ValidNameForResource   = validNameForResource
-	ValidateKeyARN         = validateKeyARN
-	ValidGrantName         = validGrantName
-	ValidNameForDataSource = validNameForDataSource
+	ValidKeyPolicyPrincipal = validKeyPolicyPrincipal
+	ValidNameForResource    = validNameForResource
+	ValidateKeyARN          = validateKeyARN
+	ValidGrantName          = validGrantName
+	ValidNameForDataSource  = validNameForDataSource
+	ValidateKeyPolicy       = validateKeyPolicy
+
+	ValidateKeySpecKeyUsageOrigin = validateKeySpecKeyUsageOrigin
 )
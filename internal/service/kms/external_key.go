@@ -16,7 +16,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,7 +25,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -39,6 +37,7 @@ import (
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kms/types;awstypes;awstypes.KeyMetadata")
 // @Testing(importIgnore="deletion_window_in_days;bypass_policy_lockout_safety_check")
+// @Testing(useVCR=true)
 func resourceExternalKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceExternalKeyCreate,
@@ -47,10 +46,19 @@ func resourceExternalKey() *schema.Resource {
 		DeleteWithoutTimeout: resourceExternalKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importKeyWithDefaults,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -60,6 +68,10 @@ func resourceExternalKey() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"current_key_material_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"deletion_window_in_days": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -83,9 +95,48 @@ func resourceExternalKey() *schema.Resource {
 			"key_material_base64": {
 				Type:      schema.TypeString,
 				Optional:  true,
-				ForceNew:  true,
 				Sensitive: true,
 			},
+			"key_material_rotations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration_model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_material_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_material_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_material_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rotation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rotation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"key_state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -100,7 +151,12 @@ func resourceExternalKey() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			names.AttrPolicy:  sdkv2.IAMPolicyDocumentSchemaOptionalComputed(),
+			names.AttrPolicy: sdkv2.IAMPolicyDocumentSchemaOptionalComputed(),
+			"skip_propagation_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"valid_to": {
@@ -109,6 +165,8 @@ func resourceExternalKey() *schema.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 		},
+
+		CustomizeDiff: externalKeyMaterialCustomizeDiff,
 	}
 }
 
@@ -155,12 +213,12 @@ func resourceExternalKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(output.KeyMetadata.KeyId))
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), aws.ToString(output.KeyMetadata.Arn))
 
 	if v, ok := d.GetOk("key_material_base64"); ok {
 		validTo := d.Get("valid_to").(string)
 
-		if err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), v.(string), validTo); err != nil {
+		if _, err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), v.(string), validTo); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -182,15 +240,9 @@ func resourceExternalKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// Wait for propagation since KMS is eventually consistent.
-	if v, ok := d.GetOk(names.AttrPolicy); ok {
-		if err := waitKeyPolicyPropagated(ctx, conn, d.Id(), v.(string)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for KMS External Key (%s) policy update: %s", d.Id(), err)
-		}
-	}
-
-	if tags := keyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
-		if err := waitTagsPropagated(ctx, conn, d.Id(), tags); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for KMS External Key (%s) tag update: %s", d.Id(), err)
+	if !d.Get("skip_propagation_wait").(bool) {
+		if err := waitKeyPolicyAndTagsPropagated(ctx, conn, d.Id(), d.Get(names.AttrPolicy).(string), keyValueTags(ctx, getTagsIn(ctx))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS External Key (%s) propagation: %s", d.Id(), err)
 		}
 	}
 
@@ -201,7 +253,7 @@ func resourceExternalKeyRead(ctx context.Context, d *schema.ResourceData, meta a
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	key, err := findKeyInfo(ctx, conn, d.Id(), d.IsNewResource())
 
@@ -228,9 +280,27 @@ func resourceExternalKeyRead(ctx context.Context, d *schema.ResourceData, meta a
 	}
 
 	d.Set(names.AttrARN, key.metadata.Arn)
+	d.Set("current_key_material_id", key.metadata.CurrentKeyMaterialId)
 	d.Set(names.AttrDescription, key.metadata.Description)
 	d.Set(names.AttrEnabled, key.metadata.Enabled)
 	d.Set("expiration_model", key.metadata.ExpirationModel)
+	if key.metadata.KeyState != awstypes.KeyStatePendingImport {
+		input := kms.ListKeyRotationsInput{
+			IncludeKeyMaterial: awstypes.IncludeKeyMaterialAllKeyMaterial,
+			KeyId:              aws.String(d.Id()),
+		}
+		rotations, err := findKeyRotations(ctx, conn, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading KMS External Key (%s) key material rotations: %s", d.Id(), err)
+		}
+
+		if err := d.Set("key_material_rotations", flattenKeyMaterialRotationsListEntries(rotations)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting key_material_rotations: %s", err)
+		}
+	} else {
+		d.Set("key_material_rotations", nil)
+	}
 	d.Set("key_state", key.metadata.KeyState)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
@@ -254,7 +324,7 @@ func resourceExternalKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	if hasChange, enabled, state := d.HasChange(names.AttrEnabled), d.Get(names.AttrEnabled).(bool), awstypes.KeyState(d.Get("key_state").(string)); hasChange && enabled && state != awstypes.KeyStatePendingImport {
 		// Enable before any attributes are modified.
@@ -270,15 +340,58 @@ func resourceExternalKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.HasChange(names.AttrPolicy) {
-		if err := updateKeyPolicy(ctx, conn, "KMS External Key", d.Id(), d.Get(names.AttrPolicy).(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		update := updateKeyPolicy
+		if d.Get("skip_propagation_wait").(bool) {
+			update = putKeyPolicy
+		}
+
+		if err := update(ctx, conn, "KMS External Key", d.Id(), d.Get(names.AttrPolicy).(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
-	if d.HasChange("valid_to") {
+	// Keep the prior key material in state unless the key material is successfully imported.
+	d.Partial(true)
+
+	// After import the key material isn't known, so the configured key material is assumed to be the current key material.
+	isPendingImport := awstypes.KeyState(d.Get("key_state").(string)) == awstypes.KeyStatePendingImport
+	if o, _ := d.GetChange("key_material_base64"); d.HasChange("key_material_base64") && (isPendingImport || o.(string) != "") {
+		keyMaterialBase64, validTo := d.Get("key_material_base64").(string), d.Get("valid_to").(string)
+
+		if isPendingImport {
+			if _, err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), keyMaterialBase64, validTo); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if _, err := waitKeyMaterialImported(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for KMS External Key (%s) material import: %s", d.Id(), err)
+			}
+		} else {
+			// Import the new key material alongside the existing key material and then make it current.
+			if _, err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), keyMaterialBase64, validTo, func(input *kms.ImportKeyMaterialInput) {
+				input.ImportType = awstypes.ImportTypeNewKeyMaterial
+			}); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if err := rotateKeyOnDemand(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		if err := waitKeyValidToPropagated(ctx, conn, d.Id(), validTo); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS External Key (%s) valid_to update: %s", d.Id(), err)
+		}
+	} else if d.HasChange("valid_to") {
 		validTo := d.Get("valid_to").(string)
 
-		if err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), d.Get("key_material_base64").(string), validTo); err != nil {
+		// Re-import the current key material with the new expiration.
+		if _, err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), d.Get("key_material_base64").(string), validTo, func(input *kms.ImportKeyMaterialInput) {
+			if v := d.Get("current_key_material_id").(string); v != "" {
+				input.ImportType = awstypes.ImportTypeExistingKeyMaterial
+				input.KeyMaterialId = aws.String(v)
+			}
+		}); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -291,6 +404,8 @@ func resourceExternalKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	d.Partial(false)
+
 	if hasChange, enabled, state := d.HasChange(names.AttrEnabled), d.Get(names.AttrEnabled).(bool), awstypes.KeyState(d.Get("key_state").(string)); hasChange && !enabled && state != awstypes.KeyStatePendingImport {
 		// Only disable after all attributes have been modified because we cannot modify disabled keys.
 		if err := updateKeyEnabled(ctx, conn, "KMS External Key", d.Id(), enabled); err != nil {
@@ -298,6 +413,12 @@ func resourceExternalKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange(names.AttrTagsAll) {
+		if err := waitKeyTagsUpdatePropagated(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS External Key (%s) tag update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceExternalKeyRead(ctx, d, meta)...)
 }
 
@@ -305,7 +426,11 @@ func resourceExternalKeyDelete(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	if err := checkKeyAllowDestroy(ctx, d, meta, "KMS External Key"); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	input := kms.ScheduleKeyDeletionInput{
 		KeyId: aws.String(d.Id()),
@@ -336,7 +461,10 @@ func resourceExternalKeyDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
-func importExternalKeyMaterial(ctx context.Context, conn *kms.Client, resourceTypeName, keyID, keyMaterialBase64, validTo string) error {
+// importExternalKeyMaterial imports key material into a key and returns the ID of the imported key material.
+func importExternalKeyMaterial(ctx context.Context, conn *kms.Client, resourceTypeName, keyID, keyMaterialBase64, validTo string, optFns ...func(*kms.ImportKeyMaterialInput)) (string, error) {
+	ctx = withOperationLogFields(ctx, conn, keyID, "importExternalKeyMaterial")
+
 	inputGPFI := kms.GetParametersForImportInput{
 		KeyId:             aws.String(keyID),
 		WrappingAlgorithm: awstypes.AlgorithmSpecRsaesOaepSha256,
@@ -349,24 +477,24 @@ func importExternalKeyMaterial(ctx context.Context, conn *kms.Client, resourceTy
 	})
 
 	if err != nil {
-		return fmt.Errorf("reading %s (%s) parameters for import: %w", resourceTypeName, keyID, err)
+		return "", fmt.Errorf("reading %s (%s) parameters for import: %w", resourceTypeName, keyID, err)
 	}
 
 	keyMaterial, err := inttypes.Base64Decode(keyMaterialBase64)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	output := outputRaw.(*kms.GetParametersForImportOutput)
 
 	publicKey, err := x509.ParsePKIXPublicKey(output.PublicKey)
 	if err != nil {
-		return fmt.Errorf("parsing %s (%s) public key (PKIX): %w", resourceTypeName, keyID, err)
+		return "", fmt.Errorf("parsing %s (%s) public key (PKIX): %w", resourceTypeName, keyID, err)
 	}
 
 	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, publicKey.(*rsa.PublicKey), keyMaterial, []byte{})
 	if err != nil {
-		return fmt.Errorf("encrypting %s (%s) key material (RSA-OAEP): %w", resourceTypeName, keyID, err)
+		return "", fmt.Errorf("encrypting %s (%s) key material (RSA-OAEP): %w", resourceTypeName, keyID, err)
 	}
 
 	inputIKM := kms.ImportKeyMaterialInput{
@@ -379,26 +507,32 @@ func importExternalKeyMaterial(ctx context.Context, conn *kms.Client, resourceTy
 	if validTo != "" {
 		t, err := time.Parse(time.RFC3339, validTo)
 		if err != nil {
-			return err
+			return "", err
 		}
 
 		inputIKM.ExpirationModel = awstypes.ExpirationModelTypeKeyMaterialExpires
 		inputIKM.ValidTo = aws.Time(t)
 	}
 
+	for _, optFn := range optFns {
+		optFn(&inputIKM)
+	}
+
 	// Wait for propagation since KMS is eventually consistent.
-	_, err = tfresource.RetryWhenIsA[*awstypes.NotFoundException](ctx, propagationTimeout, func() (any, error) {
+	outputRaw, err = tfresource.RetryWhenIsA[*awstypes.NotFoundException](ctx, propagationTimeout, func() (any, error) {
 		return conn.ImportKeyMaterial(ctx, &inputIKM)
 	})
 
 	if err != nil {
-		return fmt.Errorf("importing %s (%s) key material: %w", resourceTypeName, keyID, err)
+		return "", fmt.Errorf("importing %s (%s) key material: %w", resourceTypeName, keyID, err)
 	}
 
-	return nil
+	return aws.ToString(outputRaw.(*kms.ImportKeyMaterialOutput).KeyMaterialId), nil
 }
 
 func waitKeyMaterialImported(ctx context.Context, conn *kms.Client, id string) (*awstypes.KeyMetadata, error) { //nolint:unparam
+	ctx = withOperationLogFields(ctx, conn, id, "waitKeyMaterialImported")
+
 	const (
 		timeout = 10 * time.Minute
 	)
@@ -419,6 +553,8 @@ func waitKeyMaterialImported(ctx context.Context, conn *kms.Client, id string) (
 }
 
 func waitKeyValidToPropagated(ctx context.Context, conn *kms.Client, id string, validTo string) error {
+	ctx = withOperationLogFields(ctx, conn, id, "waitKeyValidToPropagated")
+
 	checkFunc := func(ctx context.Context) (bool, error) {
 		output, err := findKeyByID(ctx, conn, id)
 
@@ -446,3 +582,43 @@ func waitKeyValidToPropagated(ctx context.Context, conn *kms.Client, id string,
 
 	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
 }
+
+func externalKeyMaterialCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || !d.HasChange("key_material_base64") {
+		return nil
+	}
+
+	// Key material can't be removed from a key, and imported key material can only be rotated for single-Region keys.
+	if o, n := d.GetChange("key_material_base64"); n.(string) == "" || (o.(string) != "" && d.Get("multi_region").(bool)) {
+		return d.ForceNew("key_material_base64")
+	}
+
+	return nil
+}
+
+func flattenKeyMaterialRotationsListEntries(apiObjects []awstypes.RotationsListEntry) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"expiration_model":         string(apiObject.ExpirationModel),
+			"import_state":             string(apiObject.ImportState),
+			"key_material_description": aws.ToString(apiObject.KeyMaterialDescription),
+			"key_material_id":          aws.ToString(apiObject.KeyMaterialId),
+			"key_material_state":       string(apiObject.KeyMaterialState),
+			"rotation_type":            string(apiObject.RotationType),
+		}
+
+		if v := apiObject.RotationDate; v != nil {
+			tfMap["rotation_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.ValidTo; v != nil {
+			tfMap["valid_to"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
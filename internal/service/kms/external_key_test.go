@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
-	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -31,16 +30,16 @@ func TestAccKMSExternalKey_basic(t *testing.T) {
 	var key awstypes.KeyMetadata
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "kms", regexache.MustCompile(`key/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "30"),
@@ -76,16 +75,16 @@ func TestAccKMSExternalKey_disappears(t *testing.T) {
 	var key awstypes.KeyMetadata
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkms.ResourceExternalKey(), resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -97,19 +96,19 @@ func TestAccKMSExternalKey_disappears(t *testing.T) {
 func TestAccKMSExternalKey_multiRegion(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key awstypes.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_multiRegion(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key),
 					resource.TestCheckResourceAttr(resourceName, "multi_region", acctest.CtTrue),
 				),
 			},
@@ -129,19 +128,19 @@ func TestAccKMSExternalKey_multiRegion(t *testing.T) {
 func TestAccKMSExternalKey_deletionWindowInDays(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key1, key2 awstypes.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_deletionWindowInDays(rName, 8),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key1),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key1),
 					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "8"),
 				),
 			},
@@ -157,7 +156,7 @@ func TestAccKMSExternalKey_deletionWindowInDays(t *testing.T) {
 			{
 				Config: testAccExternalKeyConfig_deletionWindowInDays(rName, 7),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key2),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key2),
 					testAccCheckExternalKeyNotRecreated(&key1, &key2),
 					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "7"),
 				),
@@ -169,19 +168,19 @@ func TestAccKMSExternalKey_deletionWindowInDays(t *testing.T) {
 func TestAccKMSExternalKey_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key1, key2 awstypes.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_description(rName + "-1"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key1),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key1),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName+"-1"),
 				),
 			},
@@ -197,7 +196,7 @@ func TestAccKMSExternalKey_description(t *testing.T) {
 			{
 				Config: testAccExternalKeyConfig_description(rName + "-2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key2),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key2),
 					testAccCheckExternalKeyNotRecreated(&key1, &key2),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName+"-2"),
 				),
@@ -209,19 +208,19 @@ func TestAccKMSExternalKey_description(t *testing.T) {
 func TestAccKMSExternalKey_enabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key1, key2, key3 awstypes.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_enabled(rName, false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key1),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key1),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
 				),
 			},
@@ -238,7 +237,7 @@ func TestAccKMSExternalKey_enabled(t *testing.T) {
 			{
 				Config: testAccExternalKeyConfig_enabled(rName, true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key2),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key2),
 					testAccCheckExternalKeyNotRecreated(&key1, &key2),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
 				),
@@ -246,7 +245,7 @@ func TestAccKMSExternalKey_enabled(t *testing.T) {
 			{
 				Config: testAccExternalKeyConfig_enabled(rName, false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key3),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key3),
 					testAccCheckExternalKeyNotRecreated(&key2, &key3),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
 				),
@@ -257,22 +256,25 @@ func TestAccKMSExternalKey_enabled(t *testing.T) {
 
 func TestAccKMSExternalKey_keyMaterialBase64(t *testing.T) {
 	ctx := acctest.Context(t)
-	var key1, key2 awstypes.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var key1, key2, key3 awstypes.KeyMetadata
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				// ACCEPTANCE TESTING ONLY -- NEVER EXPOSE YOUR KEY MATERIAL
 				Config: testAccExternalKeyConfig_materialBase64(rName, "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key1),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key1),
 					resource.TestCheckResourceAttr(resourceName, "key_material_base64", "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="),
+					resource.TestCheckResourceAttrSet(resourceName, "current_key_material_id"),
+					resource.TestCheckResourceAttr(resourceName, "key_material_rotations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "key_material_rotations.0.key_material_state", "CURRENT"),
 				),
 			},
 			{
@@ -289,9 +291,25 @@ func TestAccKMSExternalKey_keyMaterialBase64(t *testing.T) {
 				// ACCEPTANCE TESTING ONLY -- NEVER EXPOSE YOUR KEY MATERIAL
 				Config: testAccExternalKeyConfig_materialBase64(rName, "O1zsg06cKRCsZnoT5oizMlwHEtnk0HoOmBLkFtwh2Vw="),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key2),
-					testAccCheckExternalKeyRecreated(&key1, &key2),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key2),
+					testAccCheckExternalKeyNotRecreated(&key1, &key2),
 					resource.TestCheckResourceAttr(resourceName, "key_material_base64", "O1zsg06cKRCsZnoT5oizMlwHEtnk0HoOmBLkFtwh2Vw="),
+					resource.TestCheckResourceAttrWith(resourceName, "current_key_material_id", func(value string) error {
+						if value == aws.ToString(key1.CurrentKeyMaterialId) {
+							return fmt.Errorf("current_key_material_id not rotated: %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "key_material_rotations.#", "2"),
+				),
+			},
+			{
+				Config: testAccExternalKeyConfig_description(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key3),
+					testAccCheckExternalKeyRecreated(&key2, &key3),
+					resource.TestCheckNoResourceAttr(resourceName, "key_material_base64"),
+					resource.TestCheckResourceAttr(resourceName, "key_material_rotations.#", "0"),
 				),
 			},
 		},
@@ -301,21 +319,21 @@ func TestAccKMSExternalKey_keyMaterialBase64(t *testing.T) {
 func TestAccKMSExternalKey_policy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key1, key2 awstypes.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	policy1 := `{"Id":"kms-tf-1","Statement":[{"Action":"kms:*","Effect":"Allow","Principal":{"AWS":"*"},"Resource":"*","Sid":"Enable IAM User Permissions 1"}],"Version":"2012-10-17"}`
 	policy2 := `{"Id":"kms-tf-1","Statement":[{"Action":"kms:*","Effect":"Allow","Principal":{"AWS":"*"},"Resource":"*","Sid":"Enable IAM User Permissions 2"}],"Version":"2012-10-17"}`
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_policy(rName, policy1),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key1),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key1),
 					testAccCheckExternalKeyHasPolicy(ctx, resourceName, policy1),
 				),
 			},
@@ -331,7 +349,7 @@ func TestAccKMSExternalKey_policy(t *testing.T) {
 			{
 				Config: testAccExternalKeyConfig_policy(rName, policy2),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key2),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key2),
 					testAccCheckExternalKeyNotRecreated(&key1, &key2),
 					testAccCheckExternalKeyHasPolicy(ctx, resourceName, policy2),
 				),
@@ -343,20 +361,20 @@ func TestAccKMSExternalKey_policy(t *testing.T) {
 func TestAccKMSExternalKey_policyBypass(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key awstypes.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	policy := `{"Id":"kms-tf-1","Statement":[{"Action":"kms:*","Effect":"Allow","Principal":{"AWS":"*"},"Resource":"*","Sid":"Enable IAM User Permissions 1"}],"Version":"2012-10-17"}`
 	resourceName := "aws_kms_external_key.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_policyBypass(rName, policy),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key),
 					testAccCheckExternalKeyHasPolicy(ctx, resourceName, policy),
 					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", acctest.CtTrue),
 				),
@@ -377,21 +395,21 @@ func TestAccKMSExternalKey_policyBypass(t *testing.T) {
 func TestAccKMSExternalKey_validTo(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key1, key2, key3, key4 awstypes.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_external_key.test"
 	validTo1 := time.Now().UTC().Add(1 * time.Hour).Format(time.RFC3339)
 	validTo2 := time.Now().UTC().Add(2 * time.Hour).Format(time.RFC3339)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx),
+		CheckDestroy:             testAccCheckExternalKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccExternalKeyConfig_validTo(rName, validTo1),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key1),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key1),
 					resource.TestCheckResourceAttr(resourceName, "expiration_model", "KEY_MATERIAL_EXPIRES"),
 					resource.TestCheckResourceAttr(resourceName, "valid_to", validTo1),
 				),
@@ -409,7 +427,7 @@ func TestAccKMSExternalKey_validTo(t *testing.T) {
 			{
 				Config: testAccExternalKeyConfig_enabled(rName, true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key2),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key2),
 					testAccCheckExternalKeyNotRecreated(&key1, &key2),
 					resource.TestCheckResourceAttr(resourceName, "expiration_model", "KEY_MATERIAL_DOES_NOT_EXPIRE"),
 					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
@@ -418,7 +436,7 @@ func TestAccKMSExternalKey_validTo(t *testing.T) {
 			{
 				Config: testAccExternalKeyConfig_validTo(rName, validTo1),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key3),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key3),
 					testAccCheckExternalKeyNotRecreated(&key2, &key3),
 					resource.TestCheckResourceAttr(resourceName, "expiration_model", "KEY_MATERIAL_EXPIRES"),
 					resource.TestCheckResourceAttr(resourceName, "valid_to", validTo1),
@@ -427,7 +445,7 @@ func TestAccKMSExternalKey_validTo(t *testing.T) {
 			{
 				Config: testAccExternalKeyConfig_validTo(rName, validTo2),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckExternalKeyExists(ctx, resourceName, &key4),
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key4),
 					testAccCheckExternalKeyNotRecreated(&key3, &key4),
 					resource.TestCheckResourceAttr(resourceName, "expiration_model", "KEY_MATERIAL_EXPIRES"),
 					resource.TestCheckResourceAttr(resourceName, "valid_to", validTo2),
@@ -467,9 +485,9 @@ func testAccCheckExternalKeyHasPolicy(ctx context.Context, name string, expected
 	}
 }
 
-func testAccCheckExternalKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckExternalKeyDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+		conn := acctest.ProviderMeta(ctx, t).KMSClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_kms_external_key" {
@@ -493,14 +511,14 @@ func testAccCheckExternalKeyDestroy(ctx context.Context) resource.TestCheckFunc
 	}
 }
 
-func testAccCheckExternalKeyExists(ctx context.Context, n string, v *awstypes.KeyMetadata) resource.TestCheckFunc {
+func testAccCheckExternalKeyExists(ctx context.Context, t *testing.T, n string, v *awstypes.KeyMetadata) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+		conn := acctest.ProviderMeta(ctx, t).KMSClient(ctx)
 
 		outputRaw, err := tfresource.RetryWhenNotFound(ctx, tfkms.PropagationTimeout, func() (any, error) {
 			return tfkms.FindKeyByID(ctx, conn, rs.Primary.ID)
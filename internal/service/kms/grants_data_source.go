@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_kms_grants", name="Grants")
+func dataSourceGrants() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGrantsRead,
+
+		Schema: map[string]*schema.Schema{
+			"grants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"constraints": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_context_equals": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"encryption_context_subset": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrCreationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"grant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"grantee_principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuing_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"retiring_principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"key_arns": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"retiring_principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceGrantsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	filter := tfslices.PredicateTrue[*awstypes.GrantListEntry]()
+	if v, ok := d.GetOk("retiring_principal"); ok {
+		retiringPrincipal := v.(string)
+		filter = func(v *awstypes.GrantListEntry) bool {
+			return aws.ToString(v.RetiringPrincipal) == retiringPrincipal
+		}
+	}
+
+	keyARNs := flex.ExpandStringValueList(d.Get("key_arns").([]any))
+	var tfList []any
+
+	for _, keyARN := range keyARNs {
+		input := kms.ListGrantsInput{
+			KeyId: aws.String(keyARN),
+		}
+		grants, err := findGrants(ctx, conn, &input, filter)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) Grants: %s", keyARN, err)
+		}
+
+		for _, grant := range grants {
+			tfList = append(tfList, flattenGrantListEntry(&grant, keyARN))
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	if err := d.Set("grants", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting grants: %s", err)
+	}
+
+	return diags
+}
+
+func flattenGrantListEntry(apiObject *awstypes.GrantListEntry, keyARN string) map[string]any {
+	tfMap := map[string]any{
+		"grant_id":           aws.ToString(apiObject.GrantId),
+		"grantee_principal":  aws.ToString(apiObject.GranteePrincipal),
+		"issuing_account":    aws.ToString(apiObject.IssuingAccount),
+		"key_arn":            keyARN,
+		names.AttrName:       aws.ToString(apiObject.Name),
+		"operations":         flex.FlattenStringyValueList(apiObject.Operations),
+		"retiring_principal": aws.ToString(apiObject.RetiringPrincipal),
+	}
+
+	if v := apiObject.Constraints; v != nil {
+		tfMap["constraints"] = flattenGrantConstraints(v).List()
+	}
+
+	if v := apiObject.CreationDate; v != nil {
+		tfMap[names.AttrCreationDate] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
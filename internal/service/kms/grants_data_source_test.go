@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSGrantsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kms_grants.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "grants.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "grants.*", map[string]string{
+						names.AttrName: rName + "-0",
+						"operations.#": "2",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "grants.*.key_arn", "aws_kms_key.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "grants.*.key_arn", "aws_kms_key.test.1", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "grants.*.grant_id", "aws_kms_grant.retiring", "grant_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "grants.*.grantee_principal", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSGrantsDataSource_retiringPrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kms_grants.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsDataSourceConfig_retiringPrincipal(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "grants.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grants.0.grant_id", "aws_kms_grant.retiring", "grant_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grants.0.key_arn", "aws_kms_key.test.1", names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grants.0.retiring_principal", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "grants.0.creation_date"),
+					acctest.CheckResourceAttrAccountID(ctx, dataSourceName, "grants.0.issuing_account"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGrantsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  count = 2
+
+  description             = "%[1]s-${count.index}"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    effect  = "Allow"
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ec2.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  path               = "/service-role/"
+  assume_role_policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_kms_grant" "test" {
+  count = 2
+
+  name              = "%[1]s-${count.index}"
+  key_id            = aws_kms_key.test[count.index].key_id
+  grantee_principal = aws_iam_role.test.arn
+  operations        = ["Encrypt", "Decrypt"]
+}
+
+resource "aws_kms_grant" "retiring" {
+  name               = "%[1]s-retiring"
+  key_id             = aws_kms_key.test[1].key_id
+  grantee_principal  = aws_iam_role.test.arn
+  retiring_principal = aws_iam_role.test.arn
+  operations         = ["Encrypt"]
+}
+`, rName)
+}
+
+func testAccGrantsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGrantsDataSourceConfig_base(rName), `
+data "aws_kms_grants" "test" {
+  key_arns = aws_kms_key.test[*].arn
+
+  depends_on = [aws_kms_grant.test, aws_kms_grant.retiring]
+}
+`)
+}
+
+func testAccGrantsDataSourceConfig_retiringPrincipal(rName string) string {
+	return acctest.ConfigCompose(testAccGrantsDataSourceConfig_base(rName), `
+data "aws_kms_grants" "test" {
+  key_arns           = aws_kms_key.test[*].arn
+  retiring_principal = aws_iam_role.test.arn
+
+  depends_on = [aws_kms_grant.test, aws_kms_grant.retiring]
+}
+`)
+}
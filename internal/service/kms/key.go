@@ -5,16 +5,18 @@ package kms
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -23,8 +25,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpreview"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -34,7 +37,8 @@ import (
 // @SDKResource("aws_kms_key", name="Key")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kms/types;awstypes;awstypes.KeyMetadata")
-// @Testing(importIgnore="deletion_window_in_days;bypass_policy_lockout_safety_check")
+// @Testing(importIgnore="deletion_window_in_days;bypass_policy_lockout_safety_check;recover_pending_deletion_key_id")
+// @Testing(useVCR=true)
 func resourceKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeyCreate,
@@ -43,7 +47,7 @@ func resourceKey() *schema.Resource {
 		DeleteWithoutTimeout: resourceKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importKeyWithDefaults,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -51,6 +55,11 @@ func resourceKey() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -89,6 +98,11 @@ func resourceKey() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"include_account_root_statement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"is_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -112,6 +126,10 @@ func resourceKey() *schema.Resource {
 				ForceNew: true,
 			},
 			names.AttrPolicy: sdkv2.IAMPolicyDocumentSchemaOptionalComputed(),
+			"recover_pending_deletion_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"rotation_period_in_days": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -119,8 +137,18 @@ func resourceKey() *schema.Resource {
 				ValidateFunc: validation.IntBetween(90, 2560),
 				RequiredWith: []string{"enable_key_rotation"},
 			},
+			"skip_propagation_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"validate_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"xks_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -129,13 +157,174 @@ func resourceKey() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			validateKeySpecCustomizeDiff,
+			validateKeyPolicyCustomizeDiff,
+			keyPlanOperationsCustomizeDiff,
+		),
+	}
+}
+
+func validateKeySpecCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for _, k := range []string{"custom_key_store_id", "customer_master_key_spec", "key_usage", "xks_key_id"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	origin := awstypes.OriginTypeAwsKms
+	if d.Get("custom_key_store_id").(string) != "" {
+		origin = awstypes.OriginTypeAwsCloudhsm
+	}
+	if d.Get("xks_key_id").(string) != "" {
+		origin = awstypes.OriginTypeExternalKeyStore
+	}
+
+	if err := validateKeySpecKeyUsageOrigin(awstypes.KeySpec(d.Get("customer_master_key_spec").(string)), awstypes.KeyUsageType(d.Get("key_usage").(string)), origin); err != nil {
+		return fmt.Errorf("validating customer_master_key_spec and key_usage: %w", err)
+	}
+
+	return nil
+}
+
+// keyPlanOperationsCustomizeDiff records the AWS API operations that applying the planned change will make,
+// in the order that resourceKeyCreate and resourceKeyUpdate make them.
+// A replacement is recorded as the deletion of the existing key followed by the creation of a new key.
+func keyPlanOperationsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+	if !planpreview.Enabled(ctx) {
+		return nil
+	}
+
+	if d.Id() == "" {
+		planpreview.Record(ctx, "kms", "CreateKey", map[string]any{
+			"BypassPolicyLockoutSafetyCheck": planpreview.ResourceDiffValue(d, "bypass_policy_lockout_safety_check"),
+			"CustomKeyStoreId":               planpreview.ResourceDiffValue(d, "custom_key_store_id"),
+			"Description":                    planpreview.ResourceDiffValue(d, names.AttrDescription),
+			"KeySpec":                        planpreview.ResourceDiffValue(d, "customer_master_key_spec"),
+			"KeyUsage":                       planpreview.ResourceDiffValue(d, "key_usage"),
+			"MultiRegion":                    planpreview.ResourceDiffValue(d, "multi_region"),
+			"Policy":                         planpreview.ResourceDiffValue(d, names.AttrPolicy),
+			"Tags":                           planpreview.ResourceDiffValue(d, names.AttrTagsAll),
+			"XksKeyId":                       planpreview.ResourceDiffValue(d, "xks_key_id"),
+		})
+
+		if !d.NewValueKnown("enable_key_rotation") || d.Get("enable_key_rotation").(bool) {
+			planpreview.Record(ctx, "kms", "EnableKeyRotation", map[string]any{
+				"KeyId":                planpreview.Unknown,
+				"RotationPeriodInDays": planpreview.ResourceDiffValue(d, "rotation_period_in_days"),
+			})
+		}
+
+		if !d.NewValueKnown("is_enabled") || !d.Get("is_enabled").(bool) {
+			planpreview.Record(ctx, "kms", "DisableKey", map[string]any{
+				"KeyId": planpreview.Unknown,
+			})
+		}
+
+		return nil
+	}
+
+	keyID := d.Id()
+
+	if planpreview.ResourceDiffRequiresReplacement(d, "custom_key_store_id", "customer_master_key_spec", "key_usage", "multi_region", "xks_key_id") {
+		planpreview.Record(ctx, "kms", "ScheduleKeyDeletion", map[string]any{
+			"KeyId":               keyID,
+			"PendingWindowInDays": planpreview.ResourceDiffValue(d, "deletion_window_in_days"),
+		})
+
+		return nil
+	}
+
+	if d.HasChange("is_enabled") && d.Get("is_enabled").(bool) {
+		planpreview.Record(ctx, "kms", "EnableKey", map[string]any{
+			"KeyId": keyID,
+		})
+	}
+
+	if enable := d.Get("enable_key_rotation").(bool); d.HasChange("enable_key_rotation") || (enable && d.HasChange("rotation_period_in_days")) {
+		if enable {
+			planpreview.Record(ctx, "kms", "EnableKeyRotation", map[string]any{
+				"KeyId":                keyID,
+				"RotationPeriodInDays": planpreview.ResourceDiffValue(d, "rotation_period_in_days"),
+			})
+		} else {
+			planpreview.Record(ctx, "kms", "DisableKeyRotation", map[string]any{
+				"KeyId": keyID,
+			})
+		}
+	}
+
+	if d.HasChange(names.AttrDescription) {
+		planpreview.Record(ctx, "kms", "UpdateKeyDescription", map[string]any{
+			"Description": planpreview.ResourceDiffValue(d, names.AttrDescription),
+			"KeyId":       keyID,
+		})
+	}
+
+	if d.HasChange(names.AttrPolicy) {
+		planpreview.Record(ctx, "kms", "PutKeyPolicy", map[string]any{
+			"BypassPolicyLockoutSafetyCheck": planpreview.ResourceDiffValue(d, "bypass_policy_lockout_safety_check"),
+			"KeyId":                          keyID,
+			"Policy":                         planpreview.ResourceDiffValue(d, names.AttrPolicy),
+		})
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		if updated, removed, ok := planpreview.ResourceDiffTagsChange(ctx, d); !ok {
+			planpreview.Record(ctx, "kms", "TagResource", map[string]any{
+				"KeyId": keyID,
+				"Tags":  planpreview.Unknown,
+			})
+		} else {
+			if len(removed) > 0 {
+				planpreview.Record(ctx, "kms", "UntagResource", map[string]any{
+					"KeyId":   keyID,
+					"TagKeys": removed,
+				})
+			}
+			if len(updated) > 0 {
+				planpreview.Record(ctx, "kms", "TagResource", map[string]any{
+					"KeyId": keyID,
+					"Tags":  updated,
+				})
+			}
+		}
+	}
+
+	if d.HasChange("is_enabled") && !d.Get("is_enabled").(bool) {
+		planpreview.Record(ctx, "kms", "DisableKey", map[string]any{
+			"KeyId": keyID,
+		})
 	}
+
+	return nil
 }
 
 func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
+	// Only the explicitly identified key is a candidate for recovery.
+	if v, ok := d.GetOk("recover_pending_deletion_key_id"); ok {
+		key, err := findPendingDeletionKey(ctx, conn, v.(string), func(v *awstypes.KeyMetadata) bool {
+			return v.MultiRegionConfiguration == nil || v.MultiRegionConfiguration.MultiRegionKeyType == awstypes.MultiRegionKeyTypePrimary
+		})
+
+		if err == nil {
+			if key.KeySpec != awstypes.KeySpec(d.Get("customer_master_key_spec").(string)) || key.KeyUsage != awstypes.KeyUsageType(d.Get("key_usage").(string)) ||
+				aws.ToString(key.CustomKeyStoreId) != d.Get("custom_key_store_id").(string) || aws.ToBool(key.MultiRegion) != d.Get("multi_region").(bool) {
+				return sdkdiag.AppendErrorf(diags, "KMS Key (%s) pending deletion does not match configuration; recovery is not possible", aws.ToString(key.KeyId))
+			}
+
+			return append(diags, resourceKeyRecover(ctx, d, meta, aws.ToString(key.KeyId))...)
+		}
+
+		if !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "finding KMS Key pending deletion: %s", err)
+		}
+	}
+
 	input := kms.CreateKeyInput{
 		BypassPolicyLockoutSafetyCheck: d.Get("bypass_policy_lockout_safety_check").(bool),
 		KeySpec:                        awstypes.KeySpec(d.Get("customer_master_key_spec").(string)),
@@ -151,8 +340,13 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		input.MultiRegion = aws.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk(names.AttrPolicy); ok {
-		v, err := structure.NormalizeJsonString(v.(string))
+	policy, err := effectiveKeyPolicy(ctx, d, meta)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if policy != "" {
+		v, err := structure.NormalizeJsonString(policy)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -184,7 +378,7 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	d.SetId(aws.ToString(output.KeyMetadata.KeyId))
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), aws.ToString(output.KeyMetadata.Arn))
 
 	if enableKeyRotation, rotationPeriod := d.Get("enable_key_rotation").(bool), d.Get("rotation_period_in_days").(int); enableKeyRotation {
 		if err := updateKeyRotationEnabled(ctx, conn, "KMS Key", d.Id(), enableKeyRotation, rotationPeriod); err != nil {
@@ -199,15 +393,59 @@ func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 
 	// Wait for propagation since KMS is eventually consistent.
-	if v, ok := d.GetOk(names.AttrPolicy); ok {
-		if err := waitKeyPolicyPropagated(ctx, conn, d.Id(), v.(string)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for KMS Key (%s) policy update: %s", d.Id(), err)
+	if !d.Get("skip_propagation_wait").(bool) {
+		if err := waitKeyPolicyAndTagsPropagated(ctx, conn, d.Id(), policy, keyValueTags(ctx, getTagsIn(ctx))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS Key (%s) propagation: %s", d.Id(), err)
 		}
 	}
 
-	if tags := keyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
-		if err := waitTagsPropagated(ctx, conn, d.Id(), tags); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for KMS Key (%s) tag update: %s", d.Id(), err)
+	diags = appendKeyPolicyLockoutWarnings(ctx, diags, d, meta, d.Id())
+
+	return append(diags, resourceKeyRead(ctx, d, meta)...)
+}
+
+// resourceKeyRecover cancels the deletion of an existing key and brings it in line with the configuration.
+func resourceKeyRecover(ctx context.Context, d *schema.ResourceData, meta any, keyID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	if err := cancelKeyDeletion(ctx, conn, "KMS Key", keyID); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(keyID)
+
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
+
+	if key, err := findKeyByID(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", d.Id(), err)
+	} else if key.Origin == awstypes.OriginTypeAwsKms && key.KeySpec == awstypes.KeySpecSymmetricDefault {
+		if err := updateKeyRotationEnabled(ctx, conn, "KMS Key", d.Id(), d.Get("enable_key_rotation").(bool), d.Get("rotation_period_in_days").(int)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		if err := updateKeyDescription(ctx, conn, "KMS Key", d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if policy, err := effectiveKeyPolicy(ctx, d, meta); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	} else if policy != "" {
+		if err := updateKeyPolicy(ctx, conn, "KMS Key", d.Id(), policy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if err := updateRecoveredKeyTags(ctx, conn, d.Id(), keyValueTags(ctx, getTagsIn(ctx)), d.Get("skip_propagation_wait").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating KMS Key (%s) tags: %s", d.Id(), err)
+	}
+
+	if enabled := d.Get("is_enabled").(bool); !enabled {
+		if err := updateKeyEnabled(ctx, conn, "KMS Key", d.Id(), enabled); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
@@ -218,7 +456,7 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	key, err := findKeyInfo(ctx, conn, d.Id(), d.IsNewResource())
 
@@ -245,7 +483,15 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 	d.Set(names.AttrKeyID, key.metadata.KeyId)
 	d.Set("key_usage", key.metadata.KeyUsage)
 	d.Set("multi_region", key.metadata.MultiRegion)
-	policyToSet, err := verify.PolicyToSet(d.Get(names.AttrPolicy).(string), key.policy)
+	policy := key.policy
+	if d.Get("include_account_root_statement").(bool) {
+		// The added account root statement isn't compared with the configuration.
+		policy, err = keyPolicyWithoutAccountRootStatement(policy, d.Get(names.AttrPolicy).(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+	policyToSet, err := verify.PolicyToSet(d.Get(names.AttrPolicy).(string), policy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -259,6 +505,8 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 
 	setTagsOut(ctx, key.tags)
 
+	diags = appendKeyPolicyAccountRootWarnings(ctx, diags, d, meta, d.Id())
+
 	return diags
 }
 
@@ -266,7 +514,7 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	if hasChange, enabled := d.HasChange("is_enabled"), d.Get("is_enabled").(bool); hasChange && enabled {
 		// Enable before any attributes are modified.
@@ -289,10 +537,22 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 		}
 	}
 
-	if hasChange, policy, bypass := d.HasChange(names.AttrPolicy), d.Get(names.AttrPolicy).(string), d.Get("bypass_policy_lockout_safety_check").(bool); hasChange {
-		if err := updateKeyPolicy(ctx, conn, "KMS Key", d.Id(), policy, bypass); err != nil {
+	if hasChange, bypass := d.HasChanges(names.AttrPolicy, "include_account_root_statement"), d.Get("bypass_policy_lockout_safety_check").(bool); hasChange {
+		policy, err := effectiveKeyPolicy(ctx, d, meta)
+		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+
+		update := updateKeyPolicy
+		if d.Get("skip_propagation_wait").(bool) {
+			update = putKeyPolicy
+		}
+
+		if err := update(ctx, conn, "KMS Key", d.Id(), policy, bypass); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		diags = appendKeyPolicyLockoutWarnings(ctx, diags, d, meta, d.Id())
 	}
 
 	if hasChange, enabled := d.HasChange("is_enabled"), d.Get("is_enabled").(bool); hasChange && !enabled {
@@ -302,6 +562,12 @@ func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 		}
 	}
 
+	if d.HasChange(names.AttrTagsAll) {
+		if err := waitKeyTagsUpdatePropagated(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS Key (%s) tag update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceKeyRead(ctx, d, meta)...)
 }
 
@@ -309,7 +575,11 @@ func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	if err := checkKeyAllowDestroy(ctx, d, meta, "KMS Key"); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	input := kms.ScheduleKeyDeletionInput{
 		KeyId: aws.String(d.Id()),
@@ -340,6 +610,50 @@ func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 	return diags
 }
 
+// checkKeyAllowDestroy returns an error if the provider's kms_prevent_destroy_enforced setting prevents the key from being destroyed.
+func checkKeyAllowDestroy(ctx context.Context, d *schema.ResourceData, meta any, typ string) error {
+	if !meta.(*conns.AWSClient).KMSPreventDestroyEnforced(ctx) || d.Get("allow_destroy").(bool) {
+		return nil
+	}
+
+	return fmt.Errorf("deleting %s (%s): destroy prevented by the provider's kms_prevent_destroy_enforced setting, set allow_destroy = true on the resource to allow it", typ, d.Id())
+}
+
+// importKeyByIDOrAlias imports a key by key ID, key ARN, alias name or alias ARN.
+// Aliases are resolved to the ID of their target key.
+func importKeyByIDOrAlias(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if id := d.Id(); isAliasName(id) || isAliasARN(id) {
+		conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+		key, err := findKeyByID(ctx, conn, id)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading KMS Key (%s): %w", id, err)
+		}
+
+		d.SetId(aws.ToString(key.KeyId))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importKeyWithDefaults imports a key by key ID, key ARN, alias name or alias ARN
+// and sets the default values of arguments that are not returned by the API.
+func importKeyWithDefaults(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	output, err := importKeyByIDOrAlias(ctx, d, meta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("allow_destroy", false)
+	d.Set("include_account_root_statement", false)
+	d.Set("skip_propagation_wait", false)
+	d.Set("validate_policy", false)
+
+	return output, nil
+}
+
 type kmsKeyInfo struct {
 	metadata             *awstypes.KeyMetadata
 	policy               string
@@ -445,6 +759,29 @@ func findKey(ctx context.Context, conn *kms.Client, input *kms.DescribeKeyInput,
 	return output.KeyMetadata, nil
 }
 
+// findPendingDeletionKey returns the customer managed key in the PendingDeletion state that is identified by the specified
+// key ID, key ARN, alias name or alias ARN and satisfies the filter.
+func findPendingDeletionKey(ctx context.Context, conn *kms.Client, keyID string, filter tfslices.Predicate[*awstypes.KeyMetadata]) (*awstypes.KeyMetadata, error) {
+	input := kms.DescribeKeyInput{
+		KeyId: aws.String(keyID),
+	}
+
+	output, err := findKey(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.KeyState != awstypes.KeyStatePendingDeletion || output.KeyManager != awstypes.KeyManagerTypeCustomer || !filter(output) {
+		return nil, &retry.NotFoundError{
+			Message:     string(output.KeyState),
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
 func findDefaultKeyARNForService(ctx context.Context, conn *kms.Client, service, region string) (string, error) {
 	keyID := fmt.Sprintf("alias/aws/%s", service)
 	key, err := findKeyByID(ctx, conn, keyID, func(o *kms.Options) {
@@ -485,6 +822,16 @@ func findKeyPolicyByTwoPartKey(ctx context.Context, conn *kms.Client, keyID, pol
 }
 
 func findKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.Client, keyID string) (*bool, *int32, error) {
+	output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return aws.Bool(output.KeyRotationEnabled), output.RotationPeriodInDays, nil
+}
+
+func findKeyRotationStatusByKeyID(ctx context.Context, conn *kms.Client, keyID string) (*kms.GetKeyRotationStatusOutput, error) {
 	input := kms.GetKeyRotationStatusInput{
 		KeyId: aws.String(keyID),
 	}
@@ -492,24 +839,44 @@ func findKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.Client, keyID
 	output, err := conn.GetKeyRotationStatus(ctx, &input)
 
 	if errs.IsA[*awstypes.NotFoundException](err) {
-		return nil, nil, &retry.NotFoundError{
+		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
 	}
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if output == nil {
-		return nil, nil, tfresource.NewEmptyResultError(input)
+		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return aws.Bool(output.KeyRotationEnabled), output.RotationPeriodInDays, nil
+	return output, nil
+}
+
+// cancelKeyDeletion cancels the scheduled deletion of a key and re-enables it.
+func cancelKeyDeletion(ctx context.Context, conn *kms.Client, resourceTypeName, keyID string) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "cancelKeyDeletion")
+
+	input := kms.CancelKeyDeletionInput{
+		KeyId: aws.String(keyID),
+	}
+
+	_, err := conn.CancelKeyDeletion(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("cancelling %s (%s) deletion: %w", resourceTypeName, keyID, err)
+	}
+
+	// Keys are disabled once their deletion has been cancelled.
+	return updateKeyEnabled(ctx, conn, resourceTypeName, keyID, true)
 }
 
 func updateKeyDescription(ctx context.Context, conn *kms.Client, resourceTypeName, keyID, description string) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "updateKeyDescription")
+
 	input := kms.UpdateKeyDescriptionInput{
 		Description: aws.String(description),
 		KeyId:       aws.String(keyID),
@@ -530,6 +897,8 @@ func updateKeyDescription(ctx context.Context, conn *kms.Client, resourceTypeNam
 }
 
 func updateKeyEnabled(ctx context.Context, conn *kms.Client, resourceTypeName, keyID string, enabled bool) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "updateKeyEnabled")
+
 	var action string
 
 	updateFunc := func() (any, error) {
@@ -567,6 +936,22 @@ func updateKeyEnabled(ctx context.Context, conn *kms.Client, resourceTypeName, k
 }
 
 func updateKeyPolicy(ctx context.Context, conn *kms.Client, resourceTypeName, keyID, policy string, bypassPolicyLockoutSafetyCheck bool) error {
+	if err := putKeyPolicy(ctx, conn, resourceTypeName, keyID, policy, bypassPolicyLockoutSafetyCheck); err != nil {
+		return err
+	}
+
+	// Wait for propagation since KMS is eventually consistent.
+	if err := waitKeyPolicyPropagated(ctx, conn, keyID, policy); err != nil {
+		return fmt.Errorf("waiting for %s (%s) policy update: %w", resourceTypeName, keyID, err)
+	}
+
+	return nil
+}
+
+// putKeyPolicy updates a key's policy without waiting for the change to propagate.
+func putKeyPolicy(ctx context.Context, conn *kms.Client, resourceTypeName, keyID, policy string, bypassPolicyLockoutSafetyCheck bool) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "putKeyPolicy")
+
 	policy, err := structure.NormalizeJsonString(policy)
 	if err != nil {
 		return err
@@ -590,15 +975,44 @@ func updateKeyPolicy(ctx context.Context, conn *kms.Client, resourceTypeName, ke
 		return fmt.Errorf("updating %s (%s) policy: %w", resourceTypeName, keyID, err)
 	}
 
-	// Wait for propagation since KMS is eventually consistent.
-	if err := waitKeyPolicyPropagated(ctx, conn, keyID, policy); err != nil {
-		return fmt.Errorf("waiting for %s (%s) policy update: %w", resourceTypeName, keyID, err)
+	return nil
+}
+
+// updateRecoveredKeyTags replaces a recovered key's tags with the specified tags.
+// Unless skipPropagationWait is set, it waits for the tags to propagate.
+func updateRecoveredKeyTags(ctx context.Context, conn *kms.Client, keyID string, tags tftags.KeyValueTags, skipPropagationWait bool) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "updateRecoveredKeyTags")
+
+	oldTags, err := listTags(ctx, conn, keyID)
+
+	if err != nil {
+		return err
 	}
 
-	return nil
+	if err := updateTags(ctx, conn, keyID, oldTags.Map(), tags.Map()); err != nil {
+		return err
+	}
+
+	if skipPropagationWait || oldTags.IgnoreSystem(names.KMS).Equal(tags) {
+		return nil
+	}
+
+	return waitTagsPropagated(ctx, conn, keyID, tags)
+}
+
+// waitKeyTagsUpdatePropagated waits for a key's updated tags to propagate unless skip_propagation_wait is set.
+// Tags are updated by transparent tagging, which doesn't wait for propagation, before the resource's Update handler is called.
+func waitKeyTagsUpdatePropagated(ctx context.Context, d *schema.ResourceData, meta any) error {
+	if d.Get("skip_propagation_wait").(bool) {
+		return nil
+	}
+
+	return waitTagsPropagated(ctx, meta.(*conns.AWSClient).KMSClient(ctx), d.Id(), keyValueTags(ctx, getTagsIn(ctx)))
 }
 
 func updateKeyRotationEnabled(ctx context.Context, conn *kms.Client, resourceTypeName, keyID string, enabled bool, rotationPeriod int) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "updateKeyRotationEnabled")
+
 	var action string
 
 	updateFunc := func() (any, error) {
@@ -655,6 +1069,8 @@ func statusKeyState(ctx context.Context, conn *kms.Client, keyID string) retry.S
 }
 
 func waitKeyDescriptionPropagated(ctx context.Context, conn *kms.Client, keyID string, description string) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "waitKeyDescriptionPropagated")
+
 	checkFunc := func(ctx context.Context) (bool, error) {
 		output, err := findKeyByID(ctx, conn, keyID)
 
@@ -680,6 +1096,8 @@ func waitKeyDescriptionPropagated(ctx context.Context, conn *kms.Client, keyID s
 }
 
 func waitKeyDeleted(ctx context.Context, conn *kms.Client, keyID string) (*awstypes.KeyMetadata, error) { //nolint:unparam
+	ctx = withOperationLogFields(ctx, conn, keyID, "waitKeyDeleted")
+
 	const (
 		timeout = 20 * time.Minute
 	)
@@ -700,6 +1118,8 @@ func waitKeyDeleted(ctx context.Context, conn *kms.Client, keyID string) (*awsty
 }
 
 func waitKeyPolicyPropagated(ctx context.Context, conn *kms.Client, keyID, policy string) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "waitKeyPolicyPropagated")
+
 	checkFunc := func(ctx context.Context) (bool, error) {
 		output, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
 
@@ -730,7 +1150,42 @@ func waitKeyPolicyPropagated(ctx context.Context, conn *kms.Client, keyID, polic
 	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
 }
 
+// waitKeyPolicyAndTagsPropagated waits for a key's policy and tags to propagate.
+// The propagation checks run concurrently. An empty policy or no tags skips the corresponding check.
+func waitKeyPolicyAndTagsPropagated(ctx context.Context, conn *kms.Client, keyID, policy string, tags tftags.KeyValueTags) error {
+	var policyErr, tagsErr error
+	var wg sync.WaitGroup
+
+	if policy != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := waitKeyPolicyPropagated(ctx, conn, keyID, policy); err != nil {
+				policyErr = fmt.Errorf("policy update: %w", err)
+			}
+		}()
+	}
+
+	if len(tags) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := waitTagsPropagated(ctx, conn, keyID, tags); err != nil {
+				tagsErr = fmt.Errorf("tag update: %w", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(policyErr, tagsErr)
+}
+
 func waitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.Client, keyID string, enabled bool, rotationPeriodWant int) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "waitKeyRotationEnabledPropagated")
+
 	checkFunc := func(ctx context.Context) (bool, error) {
 		rotation, rotationPeriodGot, err := findKeyRotationEnabledByKeyID(ctx, conn, keyID)
 
@@ -757,6 +1212,8 @@ func waitKeyRotationEnabledPropagated(ctx context.Context, conn *kms.Client, key
 }
 
 func waitKeyStatePropagated(ctx context.Context, conn *kms.Client, keyID string, enabled bool) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "waitKeyStatePropagated")
+
 	checkFunc := func(ctx context.Context) (bool, error) {
 		output, err := findKeyByID(ctx, conn, keyID)
 
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// keyPolicyAccountRootStatementSID is the Sid of the default key policy's statement that gives the account's root principal full access to the key.
+	keyPolicyAccountRootStatementSID = "Enable IAM User Permissions"
+)
+
+// keyPolicyAccountRootPrincipal returns the ARN of the account's root principal.
+func keyPolicyAccountRootPrincipal(partition, accountID string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   "iam",
+		AccountID: accountID,
+		Resource:  "root",
+	}.String()
+}
+
+// keyPolicyAllowsAccountRoot returns whether a key policy unconditionally allows the account's root principal all KMS actions,
+// which lets IAM policies in the account grant access to the key.
+func keyPolicyAllowsAccountRoot(policy, partition, accountID string) (bool, error) {
+	statements, err := parseKeyPolicyStatements(policy)
+
+	if err != nil {
+		return false, err
+	}
+
+	principal := keyPolicyAccountRootPrincipal(partition, accountID)
+
+	return slices.ContainsFunc(statements, func(statement *tfiam.IAMPolicyStatement) bool {
+		if statement.Effect != "Allow" || len(statement.Conditions) > 0 {
+			return false
+		}
+
+		if !slices.ContainsFunc(keyPolicyValues(statement.Actions), func(v string) bool {
+			return v == "*" || strings.EqualFold(v, "kms:*")
+		}) {
+			return false
+		}
+
+		return slices.ContainsFunc(statement.Principals, func(v tfiam.IAMPolicyStatementPrincipal) bool {
+			return v.Type == "AWS" && slices.ContainsFunc(keyPolicyValues(v.Identifiers), func(v string) bool {
+				return v == principal || v == accountID
+			})
+		})
+	}), nil
+}
+
+// keyPolicyWithAccountRootStatement returns the key policy with a statement giving the account's root principal full access to the key,
+// unless the policy already allows it or has a statement with the same Sid.
+// An empty policy is returned unchanged, as the default key policy includes the statement.
+func keyPolicyWithAccountRootStatement(policy, partition, accountID string) (string, error) {
+	if policy == "" {
+		return policy, nil
+	}
+
+	if ok, err := keyPolicyAllowsAccountRoot(policy, partition, accountID); err != nil {
+		return "", err
+	} else if ok {
+		return policy, nil
+	}
+
+	doc, err := parseKeyPolicyDocument(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	if statements, err := filterKeyPolicyStatements(doc, []string{keyPolicyAccountRootStatementSID}, false); err != nil {
+		return "", err
+	} else if len(statements) > 0 {
+		return policy, nil
+	}
+
+	statement, err := json.Marshal(map[string]any{
+		"Sid":    keyPolicyAccountRootStatementSID,
+		"Effect": "Allow",
+		"Principal": map[string]any{
+			"AWS": keyPolicyAccountRootPrincipal(partition, accountID),
+		},
+		"Action":   "kms:*",
+		"Resource": "*",
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	doc.Statement = append(doc.Statement, statement)
+
+	return doc.String()
+}
+
+// keyPolicyWithoutAccountRootStatement returns the existing key policy without the statement added by keyPolicyWithAccountRootStatement,
+// so that the result is comparable with the configured policy.
+func keyPolicyWithoutAccountRootStatement(existing, configured string) (string, error) {
+	if configured == "" {
+		return existing, nil
+	}
+
+	sids := []string{keyPolicyAccountRootStatementSID}
+
+	configuredDoc, err := parseKeyPolicyDocument(configured)
+
+	if err != nil {
+		return "", err
+	}
+
+	// The configured policy owns the statement.
+	if statements, err := filterKeyPolicyStatements(configuredDoc, sids, false); err != nil {
+		return "", err
+	} else if len(statements) > 0 {
+		return existing, nil
+	}
+
+	doc, err := parseKeyPolicyDocument(existing)
+
+	if err != nil {
+		return "", err
+	}
+
+	doc.Statement, err = filterKeyPolicyStatements(doc, sids, true)
+
+	if err != nil {
+		return "", err
+	}
+
+	return doc.String()
+}
+
+// effectiveKeyPolicy returns the key policy to apply: the configured policy,
+// with the account root statement added if include_account_root_statement is true.
+func effectiveKeyPolicy(ctx context.Context, d sdkv2.ResourceDiffer, meta any) (string, error) {
+	policy := d.Get(names.AttrPolicy).(string)
+
+	if v, ok := d.GetOk("include_account_root_statement"); !ok || !v.(bool) {
+		return policy, nil
+	}
+
+	c := meta.(*conns.AWSClient)
+
+	return keyPolicyWithAccountRootStatement(policy, c.Partition(ctx), c.AccountID(ctx))
+}
+
+// keyPolicyMissingAccountRoot returns whether the key policy doesn't allow the account's root principal to administer the key
+// and include_account_root_statement isn't set.
+func keyPolicyMissingAccountRoot(ctx context.Context, d sdkv2.ResourceDiffer, meta any) bool {
+	if d.Get("include_account_root_statement").(bool) {
+		return false
+	}
+
+	policy := d.Get(names.AttrPolicy).(string)
+	if policy == "" {
+		return false
+	}
+
+	c := meta.(*conns.AWSClient)
+	ok, err := keyPolicyAllowsAccountRoot(policy, c.Partition(ctx), c.AccountID(ctx))
+
+	// Invalid policies are reported by KMS or validate_policy.
+	return err == nil && !ok
+}
+
+// appendKeyPolicyAccountRootWarnings warns about a key policy that doesn't allow the account's root principal to administer the key.
+// Without such a statement, the key may become unmanageable if the principals named in the policy are deleted.
+// The warning is reported only for keys already in state, when they are refreshed (e.g. when planning) or updated;
+// SDKv2 can't report warnings when planning a new key, and reporting one after the key is created would be too late to act on.
+func appendKeyPolicyAccountRootWarnings(ctx context.Context, diags diag.Diagnostics, d *schema.ResourceData, meta any, keyID string) diag.Diagnostics {
+	if d.IsNewResource() {
+		return diags
+	}
+
+	if keyPolicyMissingAccountRoot(ctx, d, meta) {
+		c := meta.(*conns.AWSClient)
+		diags = sdkdiag.AppendWarningf(diags, "KMS Key (%s) policy does not allow the account root principal (%s) to administer the key; set include_account_root_statement to add a statement that does",
+			keyID, keyPolicyAccountRootPrincipal(c.Partition(ctx), c.AccountID(ctx)))
+	}
+
+	return diags
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"testing"
+
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+)
+
+func TestKeyPolicyWithAccountRootStatement(t *testing.T) {
+	t.Parallel()
+
+	const (
+		rootStatement = `{"Action":"kms:*","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Resource":"*","Sid":"Enable IAM User Permissions"}` // lintignore:AWSAT005
+	)
+
+	testcases := map[string]struct {
+		policy string
+		want   string
+	}{
+		"no policy": {},
+		"missing": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"Admin","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/admin"},"Action":"kms:*","Resource":"*"}]}`,                       // lintignore:AWSAT005
+			want:   `{"Version":"2012-10-17","Statement":[{"Sid":"Admin","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/admin"},"Action":"kms:*","Resource":"*"},` + rootStatement + `]}`, // lintignore:AWSAT005
+		},
+		"root ARN": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`, // lintignore:AWSAT005
+			want:   `{"Version":"2012-10-17","Statement":[{"Sid":"Root","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`, // lintignore:AWSAT005
+		},
+		"account ID": {
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"AWS":["123456789012"]},"Action":"*","Resource":"*"}}`,
+			want:   `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"AWS":["123456789012"]},"Action":"*","Resource":"*"}}`,
+		},
+		"conditional root": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"true"}}}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"true"}}},` + rootStatement + `]}`,
+		},
+		"Sid in use": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/admin"},"Action":"kms:*","Resource":"*"}]}`, // lintignore:AWSAT005
+			want:   `{"Version":"2012-10-17","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/admin"},"Action":"kms:*","Resource":"*"}]}`, // lintignore:AWSAT005
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfkms.KeyPolicyWithAccountRootStatement(testcase.policy, "aws", "123456789012")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testcase.want {
+				t.Errorf("KeyPolicyWithAccountRootStatement = %s, want %s", got, testcase.want)
+			}
+
+			if got == testcase.policy {
+				return
+			}
+
+			// The added statement isn't compared with the configured policy.
+			got, err = tfkms.KeyPolicyWithoutAccountRootStatement(got, testcase.policy)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testcase.policy {
+				t.Errorf("KeyPolicyWithoutAccountRootStatement = %s, want %s", got, testcase.policy)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -146,6 +147,10 @@ func dataSourceKey() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"resolve_multi_region_replica": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"valid_to": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -179,12 +184,42 @@ func dataSourceKeyRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		input.GrantTokens = flex.ExpandStringValueList(v.([]any))
 	}
 
-	output, err := findKey(ctx, conn, &input)
+	var optFns []func(*kms.Options)
+	region := meta.(*conns.AWSClient).Region(ctx)
+	resolveReplica := d.Get("resolve_multi_region_replica").(bool)
+
+	// A key or alias ARN in another Region must be described in that Region.
+	if resolveReplica {
+		if v, err := arn.Parse(keyID); err == nil && v.Region != "" && v.Region != region {
+			optFns = append(optFns, func(o *kms.Options) {
+				o.Region = v.Region
+			})
+		}
+	}
+
+	output, err := findKey(ctx, conn, &input, optFns...)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", keyID, err)
 	}
 
+	if resolveReplica && aws.ToBool(output.MultiRegion) {
+		if v, err := arn.Parse(aws.ToString(output.Arn)); err == nil && v.Region != region {
+			relatedKeyARN, ok := multiRegionKeyARNInRegion(output.MultiRegionConfiguration, region)
+
+			if !ok {
+				return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): multi-Region key (%s) has no related key in Region (%s)", keyID, aws.ToString(output.Arn), region)
+			}
+
+			input.KeyId = aws.String(relatedKeyARN)
+			output, err = findKey(ctx, conn, &input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", relatedKeyARN, err)
+			}
+		}
+	}
+
 	d.SetId(aws.ToString(output.KeyId))
 	d.Set(names.AttrARN, output.Arn)
 	d.Set(names.AttrAWSAccountID, output.AWSAccountId)
@@ -226,6 +261,26 @@ func dataSourceKeyRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	return diags
 }
 
+// multiRegionKeyARNInRegion returns the ARN of the multi-Region key, primary or replica, related by the
+// specified multi-Region configuration that is in the specified Region.
+func multiRegionKeyARNInRegion(apiObject *awstypes.MultiRegionConfiguration, region string) (string, bool) {
+	if apiObject == nil {
+		return "", false
+	}
+
+	if v := apiObject.PrimaryKey; v != nil && aws.ToString(v.Region) == region {
+		return aws.ToString(v.Arn), true
+	}
+
+	for _, v := range apiObject.ReplicaKeys {
+		if aws.ToString(v.Region) == region {
+			return aws.ToString(v.Arn), true
+		}
+	}
+
+	return "", false
+}
+
 func flattenMultiRegionConfiguration(apiObject *awstypes.MultiRegionConfiguration) map[string]any {
 	if apiObject == nil {
 		return nil
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -273,6 +276,97 @@ func TestAccKMSKeyDataSource_multiRegionConfigurationByID(t *testing.T) {
 	})
 }
 
+func TestAccKMSKeyDataSource_resolveMultiRegionReplica(t *testing.T) {
+	ctx := acctest.Context(t)
+	primaryKeyResourceName := "aws_kms_key.test"
+	replicaKeyResourceName := "aws_kms_replica_key.test"
+	dataSourceName := "data.aws_kms_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyDataSourceConfig_resolveMultiRegionReplica(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, replicaKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, replicaKeyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region_configuration.0.multi_region_key_type", "REPLICA"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "multi_region_configuration.0.primary_key.0.arn", primaryKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region_configuration.0.primary_key.0.region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region_configuration.0.replica_keys.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "multi_region_configuration.0.replica_keys.0.arn", replicaKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "multi_region_configuration.0.replica_keys.0.region", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+func TestMultiRegionKeyARNInRegion(t *testing.T) {
+	t.Parallel()
+
+	configuration := &awstypes.MultiRegionConfiguration{
+		MultiRegionKeyType: awstypes.MultiRegionKeyTypePrimary,
+		PrimaryKey: &awstypes.MultiRegionKey{
+			Arn:    aws.String("arn:aws:kms:us-east-1:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab"), //lintignore:AWSAT003,AWSAT005
+			Region: aws.String("us-east-1"),                                                                   //lintignore:AWSAT003
+		},
+		ReplicaKeys: []awstypes.MultiRegionKey{
+			{
+				Arn:    aws.String("arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab"), //lintignore:AWSAT003,AWSAT005
+				Region: aws.String("us-west-2"),                                                                   //lintignore:AWSAT003
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		configuration *awstypes.MultiRegionConfiguration
+		region        string
+		expectedARN   string
+		expectedOK    bool
+	}{
+		"nil configuration": {
+			region: "us-east-1", //lintignore:AWSAT003
+		},
+		"primary": {
+			configuration: configuration,
+			region:        "us-east-1",                                                                   //lintignore:AWSAT003
+			expectedARN:   "arn:aws:kms:us-east-1:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab", //lintignore:AWSAT003,AWSAT005
+			expectedOK:    true,
+		},
+		"replica": {
+			configuration: configuration,
+			region:        "us-west-2",                                                                   //lintignore:AWSAT003
+			expectedARN:   "arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab", //lintignore:AWSAT003,AWSAT005
+			expectedOK:    true,
+		},
+		"no related key": {
+			configuration: configuration,
+			region:        "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotARN, gotOK := tfkms.MultiRegionKeyARNInRegion(testCase.configuration, testCase.region)
+
+			if gotARN != testCase.expectedARN || gotOK != testCase.expectedOK {
+				t.Errorf("MultiRegionKeyARNInRegion() = (%q, %t), want (%q, %t)", gotARN, gotOK, testCase.expectedARN, testCase.expectedOK)
+			}
+		})
+	}
+}
+
 func testAccKeyDataSourceConfig_byKeyARN(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -384,3 +478,35 @@ data "aws_kms_key" "test" {
 }
 `, rName)
 }
+
+func testAccKeyDataSourceConfig_resolveMultiRegionReplica(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  region = %[2]q
+
+  description             = %[1]q
+  multi_region            = true
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_kms_alias" "test" {
+  region = %[2]q
+
+  name          = "alias/%[1]s"
+  target_key_id = aws_kms_key.test.key_id
+}
+
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn         = aws_kms_key.test.arn
+  deletion_window_in_days = 7
+}
+
+data "aws_kms_key" "test" {
+  key_id                       = aws_kms_alias.test.arn
+  resolve_multi_region_replica = true
+
+  depends_on = [aws_kms_replica_key.test]
+}
+`, rName, acctest.AlternateRegion())
+}
@@ -4,10 +4,17 @@
 package kms
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"slices"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -27,7 +34,7 @@ func resourceKeyPolicy() *schema.Resource {
 		DeleteWithoutTimeout: resourceKeyPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importKeyPolicy,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,8 +48,23 @@ func resourceKeyPolicy() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 2048),
 			},
+			"merge_statements": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrPolicy: sdkv2.IAMPolicyDocumentSchemaRequired(),
+			"validate_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			validateKeyPolicyCustomizeDiff,
+			validateKeyPolicyMergeStatementsCustomizeDiff,
+		),
 	}
 }
 
@@ -51,13 +73,28 @@ func resourceKeyPolicyCreate(ctx context.Context, d *schema.ResourceData, meta a
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
 	keyID := d.Get(names.AttrKeyID).(string)
+	policy := d.Get(names.AttrPolicy).(string)
 
-	if err := updateKeyPolicy(ctx, conn, "KMS Key Policy", keyID, d.Get(names.AttrPolicy).(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+	if d.Get("merge_statements").(bool) {
+		sids, err := keyPolicyStatementSIDs(policy)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		policy, err = mergeKeyPolicy(ctx, conn, keyID, policy, sids)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if err := updateKeyPolicy(ctx, conn, "KMS Key Policy", keyID, policy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	d.SetId(keyID)
 
+	diags = appendKeyPolicyLockoutWarnings(ctx, diags, d, meta, keyID)
+
 	return append(diags, resourceKeyPolicyRead(ctx, d, meta)...)
 }
 
@@ -78,7 +115,15 @@ func resourceKeyPolicyRead(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	d.Set(names.AttrKeyID, key.metadata.KeyId)
-	policyToSet, err := verify.PolicyToSet(d.Get(names.AttrPolicy).(string), key.policy)
+	policy := key.policy
+	if configured := d.Get(names.AttrPolicy).(string); d.Get("merge_statements").(bool) && configured != "" {
+		// Only the statements owned by this resource are compared with the configuration.
+		policy, err = ownedKeyPolicy(policy, configured)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+	policyToSet, err := verify.PolicyToSet(d.Get(names.AttrPolicy).(string), policy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -91,10 +136,32 @@ func resourceKeyPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta a
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	if d.HasChange(names.AttrPolicy) {
-		if err := updateKeyPolicy(ctx, conn, "KMS Key Policy", d.Id(), d.Get(names.AttrPolicy).(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+	if d.HasChanges(names.AttrPolicy, "merge_statements") {
+		policy := d.Get(names.AttrPolicy).(string)
+
+		if d.Get("merge_statements").(bool) {
+			sids, err := keyPolicyStatementSIDs(policy)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			// Statements removed from the configuration are removed from the key policy.
+			o, _ := d.GetChange(names.AttrPolicy)
+			if oldSIDs, err := keyPolicyStatementSIDs(o.(string)); err == nil {
+				sids = append(sids, oldSIDs...)
+			}
+
+			policy, err = mergeKeyPolicy(ctx, conn, d.Id(), policy, sids)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		if err := updateKeyPolicy(ctx, conn, "KMS Key Policy", d.Id(), policy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+
+		diags = appendKeyPolicyLockoutWarnings(ctx, diags, d, meta, d.Id())
 	}
 
 	return append(diags, resourceKeyPolicyRead(ctx, d, meta)...)
@@ -105,7 +172,42 @@ func resourceKeyPolicyDelete(ctx context.Context, d *schema.ResourceData, meta a
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
 	if !d.Get("bypass_policy_lockout_safety_check").(bool) {
-		if err := updateKeyPolicy(ctx, conn, "KMS Key Policy", d.Get(names.AttrKeyID).(string), meta.(*conns.AWSClient).DefaultKMSKeyPolicy(ctx), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		keyID := d.Get(names.AttrKeyID).(string)
+		policy := meta.(*conns.AWSClient).DefaultKMSKeyPolicy(ctx)
+
+		if d.Get("merge_statements").(bool) {
+			// Remove only the statements owned by this resource, restoring the default policy if no other statements remain.
+			existing, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+			if tfresource.NotFound(err) {
+				return diags
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) policy: %s", keyID, err)
+			}
+
+			doc, err := parseKeyPolicyDocument(aws.ToString(existing))
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			sids, _ := keyPolicyStatementSIDs(d.Get(names.AttrPolicy).(string))
+			statements, err := filterKeyPolicyStatements(doc, sids, true)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if len(statements) > 0 {
+				doc.Statement = statements
+				policy, err = doc.String()
+				if err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+		}
+
+		if err := updateKeyPolicy(ctx, conn, "KMS Key Policy", keyID, policy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		} else {
 			log.Printf("[WARN] KMS Key Policy for Key (%s) does not allow PutKeyPolicy. Default Policy cannot be restored. Removing from state", d.Id())
@@ -114,3 +216,221 @@ func resourceKeyPolicyDelete(ctx context.Context, d *schema.ResourceData, meta a
 
 	return diags
 }
+
+func importKeyPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	output, err := importKeyByIDOrAlias(ctx, d, meta)
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("merge_statements", false)
+	d.Set("validate_policy", false)
+
+	return output, nil
+}
+
+// mergeKeyPolicy returns the key's current policy with the owned statements replaced by the statements of the configured policy.
+func mergeKeyPolicy(ctx context.Context, conn *kms.Client, keyID, configured string, ownedSIDs []string) (string, error) {
+	existing, err := findKeyPolicyByTwoPartKey(ctx, conn, keyID, policyNameDefault)
+
+	if err != nil {
+		return "", fmt.Errorf("reading KMS Key (%s) policy: %w", keyID, err)
+	}
+
+	return mergeKeyPolicyStatements(aws.ToString(existing), configured, ownedSIDs)
+}
+
+// keyPolicyDocument is a key policy document whose statements are kept verbatim.
+type keyPolicyDocument struct {
+	Version   string            `json:",omitempty"`
+	ID        string            `json:"Id,omitempty"`
+	Statement []json.RawMessage `json:",omitempty"`
+}
+
+func parseKeyPolicyDocument(policy string) (*keyPolicyDocument, error) {
+	var doc struct {
+		Version   string
+		ID        string `json:"Id"`
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	output := &keyPolicyDocument{
+		Version: doc.Version,
+		ID:      doc.ID,
+	}
+
+	// Statement can be a single object or an array of objects.
+	if v := bytes.TrimSpace(doc.Statement); len(v) > 0 && v[0] == '{' {
+		output.Statement = []json.RawMessage{v}
+	} else if len(v) > 0 {
+		if err := json.Unmarshal(v, &output.Statement); err != nil {
+			return nil, fmt.Errorf("parsing policy statements: %w", err)
+		}
+	}
+
+	return output, nil
+}
+
+func (doc *keyPolicyDocument) String() (string, error) {
+	output, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+func keyPolicyStatementSID(statement json.RawMessage) (string, error) {
+	var v struct {
+		Sid string
+	}
+
+	if err := json.Unmarshal(statement, &v); err != nil {
+		return "", fmt.Errorf("parsing policy statement: %w", err)
+	}
+
+	return v.Sid, nil
+}
+
+// keyPolicyStatementSIDs returns the statement IDs of a key policy.
+// Every statement must have a unique Sid.
+func keyPolicyStatementSIDs(policy string) ([]string, error) {
+	doc, err := parseKeyPolicyDocument(policy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var sids []string
+	for i, statement := range doc.Statement {
+		sid, err := keyPolicyStatementSID(statement)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if sid == "" {
+			return nil, fmt.Errorf("policy statement %d has no Sid", i)
+		}
+
+		if slices.Contains(sids, sid) {
+			return nil, fmt.Errorf("policy statement Sid %q is not unique", sid)
+		}
+
+		sids = append(sids, sid)
+	}
+
+	return sids, nil
+}
+
+// filterKeyPolicyStatements returns the statements of a key policy whose Sid is (or, if exclude is true, is not) one of the specified statement IDs.
+func filterKeyPolicyStatements(doc *keyPolicyDocument, sids []string, exclude bool) ([]json.RawMessage, error) {
+	var statements []json.RawMessage
+
+	for _, statement := range doc.Statement {
+		sid, err := keyPolicyStatementSID(statement)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if (sid != "" && slices.Contains(sids, sid)) != exclude {
+			statements = append(statements, statement)
+		}
+	}
+
+	return statements, nil
+}
+
+// mergeKeyPolicyStatements returns the key policy resulting from replacing the owned statements of the existing policy with the statements of the configured policy.
+// Statements of the existing policy with other statement IDs are preserved.
+func mergeKeyPolicyStatements(existing, configured string, ownedSIDs []string) (string, error) {
+	existingDoc, err := parseKeyPolicyDocument(existing)
+
+	if err != nil {
+		return "", err
+	}
+
+	configuredDoc, err := parseKeyPolicyDocument(configured)
+
+	if err != nil {
+		return "", err
+	}
+
+	statements, err := filterKeyPolicyStatements(existingDoc, ownedSIDs, true)
+
+	if err != nil {
+		return "", err
+	}
+
+	doc := &keyPolicyDocument{
+		Version:   existingDoc.Version,
+		ID:        existingDoc.ID,
+		Statement: append(statements, configuredDoc.Statement...),
+	}
+	if doc.Version == "" {
+		doc.Version = configuredDoc.Version
+	}
+
+	return doc.String()
+}
+
+// ownedKeyPolicy returns the statements of the existing key policy that are owned by the configured policy,
+// using the configured policy's Version and Id so that the result is comparable with the configured policy.
+func ownedKeyPolicy(existing, configured string) (string, error) {
+	existingDoc, err := parseKeyPolicyDocument(existing)
+
+	if err != nil {
+		return "", err
+	}
+
+	configuredDoc, err := parseKeyPolicyDocument(configured)
+
+	if err != nil {
+		return "", err
+	}
+
+	sids, err := keyPolicyStatementSIDs(configured)
+
+	if err != nil {
+		return "", err
+	}
+
+	statements, err := filterKeyPolicyStatements(existingDoc, sids, false)
+
+	if err != nil {
+		return "", err
+	}
+
+	doc := &keyPolicyDocument{
+		Version:   configuredDoc.Version,
+		ID:        configuredDoc.ID,
+		Statement: statements,
+	}
+
+	return doc.String()
+}
+
+// validateKeyPolicyMergeStatementsCustomizeDiff validates that every statement of the planned key policy has a unique Sid when merge_statements is true.
+func validateKeyPolicyMergeStatementsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.Get("merge_statements").(bool) || !d.NewValueKnown(names.AttrPolicy) {
+		return nil
+	}
+
+	policy := d.Get(names.AttrPolicy).(string)
+	if policy == "" {
+		return nil
+	}
+
+	if _, err := keyPolicyStatementSIDs(policy); err != nil {
+		return fmt.Errorf("merge_statements requires every %s statement to have a unique Sid: %w", names.AttrPolicy, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	// Actions granted to key administrators by the default key policy created by the AWS KMS console.
+	keyPolicyAdministratorActions = []string{
+		"kms:CancelKeyDeletion",
+		"kms:Create*",
+		"kms:Delete*",
+		"kms:Describe*",
+		"kms:Disable*",
+		"kms:Enable*",
+		"kms:Get*",
+		"kms:List*",
+		"kms:Put*",
+		"kms:Revoke*",
+		"kms:RotateKeyOnDemand",
+		"kms:ScheduleKeyDeletion",
+		"kms:TagResource",
+		"kms:UntagResource",
+		"kms:Update*",
+	}
+	// Additional actions granted to key administrators of multi-Region keys.
+	keyPolicyMultiRegionAdministratorActions = []string{
+		"kms:ReplicateKey",
+		"kms:UpdatePrimaryRegion",
+	}
+	// Actions granted to key users by the default key policy created by the AWS KMS console.
+	keyPolicyUserActions = []string{
+		"kms:Decrypt",
+		"kms:DescribeKey",
+		"kms:Encrypt",
+		"kms:GenerateDataKey*",
+		"kms:ReEncrypt*",
+	}
+	// Actions granted by default to principals using the key via an AWS service.
+	keyPolicyViaServiceActions = []string{
+		"kms:CreateGrant",
+		"kms:Decrypt",
+		"kms:DescribeKey",
+		"kms:Encrypt",
+		"kms:GenerateDataKey*",
+		"kms:ListGrants",
+		"kms:ReEncrypt*",
+	}
+)
+
+// @SDKDataSource("aws_kms_key_policy_document", name="Key Policy Document")
+func dataSourceKeyPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceKeyPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"enable_root_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_administrators": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validKeyPolicyPrincipal,
+				},
+			},
+			"key_users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validKeyPolicyPrincipal,
+				},
+			},
+			"multi_region": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"multi_region_replication_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validKeyPolicyPrincipal,
+				},
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"via_service": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrActions: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principals": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validKeyPolicyPrincipal,
+							},
+						},
+						"services": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeyPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+
+	accountID := c.AccountID(ctx)
+	if v, ok := d.GetOk(names.AttrAccountID); ok {
+		accountID = v.(string)
+	}
+
+	input := keyPolicyDocumentInput{
+		accountID:                accountID,
+		enableRootAccess:         d.Get("enable_root_access").(bool),
+		keyAdministrators:        flex.ExpandStringValueSet(d.Get("key_administrators").(*schema.Set)),
+		keyUsers:                 flex.ExpandStringValueSet(d.Get("key_users").(*schema.Set)),
+		multiRegion:              d.Get("multi_region").(bool),
+		multiRegionReplicators:   flex.ExpandStringValueSet(d.Get("multi_region_replication_principals").(*schema.Set)),
+		partition:                c.Partition(ctx),
+		policyID:                 d.Get("policy_id").(string),
+		viaServiceConfigurations: expandKeyPolicyViaServices(d.Get("via_service").([]any)),
+	}
+
+	doc := buildKeyPolicyDocument(input)
+
+	if len(doc.Statements) == 0 {
+		return sdkdiag.AppendErrorf(diags, "KMS key policy document has no statements")
+	}
+
+	output, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing KMS key policy document: %s", err)
+	}
+
+	jsonString := string(output)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrAccountID, accountID)
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+type keyPolicyViaService struct {
+	actions    []string
+	principals []string
+	services   []string
+}
+
+type keyPolicyDocumentInput struct {
+	accountID                string
+	enableRootAccess         bool
+	keyAdministrators        []string
+	keyUsers                 []string
+	multiRegion              bool
+	multiRegionReplicators   []string
+	partition                string
+	policyID                 string
+	viaServiceConfigurations []keyPolicyViaService
+}
+
+// buildKeyPolicyDocument builds a key policy from the common statements requested.
+// Actions and principals are sorted so that equivalent inputs always produce the same JSON.
+func buildKeyPolicyDocument(input keyPolicyDocumentInput) *tfiam.IAMPolicyDoc {
+	doc := &tfiam.IAMPolicyDoc{
+		Id:      input.policyID,
+		Version: "2012-10-17",
+	}
+
+	if input.enableRootAccess {
+		doc.Statements = append(doc.Statements, keyPolicyStatement("EnableRootAccess", []string{fmt.Sprintf("arn:%s:iam::%s:root", input.partition, input.accountID)}, []string{"kms:*"}, nil))
+	}
+
+	if len(input.keyAdministrators) > 0 {
+		actions := slices.Clone(keyPolicyAdministratorActions)
+		if input.multiRegion {
+			actions = append(actions, keyPolicyMultiRegionAdministratorActions...)
+		}
+
+		doc.Statements = append(doc.Statements, keyPolicyStatement("AllowKeyAdministrators", input.keyAdministrators, actions, nil))
+	}
+
+	if len(input.keyUsers) > 0 {
+		doc.Statements = append(doc.Statements, keyPolicyStatement("AllowKeyUsers", input.keyUsers, keyPolicyUserActions, nil))
+	}
+
+	for i, v := range input.viaServiceConfigurations {
+		actions := v.actions
+		if len(actions) == 0 {
+			actions = keyPolicyViaServiceActions
+		}
+
+		conditions := tfiam.IAMPolicyStatementConditionSet{
+			{
+				Test:     "StringEquals",
+				Variable: "kms:CallerAccount",
+				Values:   input.accountID,
+			},
+			{
+				Test:     "StringEquals",
+				Variable: "kms:ViaService",
+				Values:   keyPolicyStringOrSlice(v.services),
+			},
+		}
+
+		doc.Statements = append(doc.Statements, keyPolicyStatement(fmt.Sprintf("AllowViaService%d", i), v.principals, actions, conditions))
+	}
+
+	if len(input.multiRegionReplicators) > 0 {
+		doc.Statements = append(doc.Statements, keyPolicyStatement("AllowMultiRegionReplication", input.multiRegionReplicators, keyPolicyMultiRegionAdministratorActions, nil))
+	}
+
+	return doc
+}
+
+func keyPolicyStatement(sid string, principals, actions []string, conditions tfiam.IAMPolicyStatementConditionSet) *tfiam.IAMPolicyStatement {
+	return &tfiam.IAMPolicyStatement{
+		Actions:    keyPolicyStringOrSlice(actions),
+		Conditions: conditions,
+		Effect:     "Allow",
+		Principals: tfiam.IAMPolicyStatementPrincipalSet{
+			{
+				Type:        "AWS",
+				Identifiers: keyPolicyStringOrSlice(principals),
+			},
+		},
+		Resources: "*",
+		Sid:       sid,
+	}
+}
+
+// keyPolicyStringOrSlice returns a sorted copy of the specified values, or the value itself if there is only one.
+// This matches how AWS KMS returns key policies.
+func keyPolicyStringOrSlice(values []string) any {
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	values = slices.Clone(values)
+	slices.Sort(values)
+
+	return slices.Compact(values)
+}
+
+func expandKeyPolicyViaServices(tfList []any) []keyPolicyViaService {
+	var apiObjects []keyPolicyViaService
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, keyPolicyViaService{
+			actions:    flex.ExpandStringValueSet(tfMap[names.AttrActions].(*schema.Set)),
+			principals: flex.ExpandStringValueSet(tfMap["principals"].(*schema.Set)),
+			services:   flex.ExpandStringValueSet(tfMap["services"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
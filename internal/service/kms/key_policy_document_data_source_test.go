@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSKeyPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kms_key_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyDocumentDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrAccountID, "123456789012"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccKeyPolicyDocumentExpectedJSON()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyPolicyDocumentDataSource_key(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kms_key_policy_document.test"
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyPolicyDocumentDataSourceConfig_key(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAccountID, "data.aws_caller_identity.current", names.AttrAccountID),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, names.AttrPolicy, fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Id": %[1]q,
+  "Statement": [
+    {
+      "Sid": "EnableRootAccess",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:%[2]s:iam::%[3]s:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`, rName, acctest.Partition(), acctest.AccountID(ctx))),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyPolicyDocumentExpectedJSON() string {
+	return fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "EnableRootAccess",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:%[1]s:iam::123456789012:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "AllowKeyAdministrators",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:%[1]s:iam::123456789012:role/Admin"},
+      "Action": [
+        "kms:CancelKeyDeletion",
+        "kms:Create*",
+        "kms:Delete*",
+        "kms:Describe*",
+        "kms:Disable*",
+        "kms:Enable*",
+        "kms:Get*",
+        "kms:List*",
+        "kms:Put*",
+        "kms:ReplicateKey",
+        "kms:Revoke*",
+        "kms:RotateKeyOnDemand",
+        "kms:ScheduleKeyDeletion",
+        "kms:TagResource",
+        "kms:UntagResource",
+        "kms:Update*",
+        "kms:UpdatePrimaryRegion"
+      ],
+      "Resource": "*"
+    },
+    {
+      "Sid": "AllowKeyUsers",
+      "Effect": "Allow",
+      "Principal": {"AWS": [
+        "arn:%[1]s:iam::123456789012:role/App2",
+        "arn:%[1]s:iam::123456789012:role/App1"
+      ]},
+      "Action": [
+        "kms:Decrypt",
+        "kms:DescribeKey",
+        "kms:Encrypt",
+        "kms:GenerateDataKey*",
+        "kms:ReEncrypt*"
+      ],
+      "Resource": "*"
+    },
+    {
+      "Sid": "AllowViaService0",
+      "Effect": "Allow",
+      "Principal": {"AWS": "123456789012"},
+      "Action": [
+        "kms:CreateGrant",
+        "kms:Decrypt",
+        "kms:DescribeKey",
+        "kms:Encrypt",
+        "kms:GenerateDataKey*",
+        "kms:ListGrants",
+        "kms:ReEncrypt*"
+      ],
+      "Resource": "*",
+      "Condition": {
+        "StringEquals": {
+          "kms:CallerAccount": "123456789012",
+          "kms:ViaService": "rds.%[2]s.amazonaws.com"
+        }
+      }
+    },
+    {
+      "Sid": "AllowMultiRegionReplication",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:%[1]s:iam::123456789012:role/Admin"},
+      "Action": [
+        "kms:ReplicateKey",
+        "kms:UpdatePrimaryRegion"
+      ],
+      "Resource": "*"
+    }
+  ]
+}`, acctest.Partition(), acctest.Region())
+}
+
+func testAccKeyPolicyDocumentDataSourceConfig_basic() string {
+	return `
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_kms_key_policy_document" "test" {
+  account_id   = "123456789012"
+  multi_region = true
+
+  key_administrators = ["arn:${data.aws_partition.current.partition}:iam::123456789012:role/Admin"]
+
+  key_users = [
+    "arn:${data.aws_partition.current.partition}:iam::123456789012:role/App1",
+    "arn:${data.aws_partition.current.partition}:iam::123456789012:role/App2",
+  ]
+
+  via_service {
+    principals = ["123456789012"]
+    services   = ["rds.${data.aws_region.current.region}.amazonaws.com"]
+  }
+
+  multi_region_replication_principals = ["arn:${data.aws_partition.current.partition}:iam::123456789012:role/Admin"]
+}
+`
+}
+
+func testAccKeyPolicyDocumentDataSourceConfig_key(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_kms_key_policy_document" "test" {
+  policy_id = %[1]q
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  multi_region            = true
+  policy                  = data.aws_kms_key_policy_document.test.json
+}
+`, rName)
+}
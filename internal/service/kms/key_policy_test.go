@@ -4,14 +4,20 @@
 package kms_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,7 +39,7 @@ func TestAccKMSKeyPolicy_basic(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policy(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 					testAccCheckKeyHasPolicy(ctx, keyResourceName, expectedPolicyText),
 				),
 			},
@@ -46,7 +52,7 @@ func TestAccKMSKeyPolicy_basic(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_removedPolicy(keyResourceName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 				),
 			},
 		},
@@ -67,7 +73,7 @@ func TestAccKMSKeyPolicy_disappears(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policy(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, attachmentResourceName, &key),
+					testAccCheckKeyExists(ctx, t, attachmentResourceName, &key),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkms.ResourceKey(), attachmentResourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -96,7 +102,7 @@ func TestAccKMSKeyPolicy_bypass(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policyBypass(rName, true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 					resource.TestCheckResourceAttr(attachmentResourceName, "bypass_policy_lockout_safety_check", acctest.CtTrue),
 				),
 			},
@@ -126,14 +132,14 @@ func TestAccKMSKeyPolicy_bypassUpdate(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policy(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &before),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &before),
 					resource.TestCheckResourceAttr(attachmentResourceName, "bypass_policy_lockout_safety_check", acctest.CtFalse),
 				),
 			},
 			{
 				Config: testAccKeyPolicyConfig_policyBypass(rName, true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &after),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &after),
 					resource.TestCheckResourceAttr(attachmentResourceName, "bypass_policy_lockout_safety_check", acctest.CtTrue),
 				),
 			},
@@ -156,13 +162,13 @@ func TestAccKMSKeyPolicy_keyIsEnabled(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_keyIsEnabled(rName, true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &before),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &before),
 				),
 			},
 			{
 				Config: testAccKeyPolicyConfig_keyIsEnabled(rName, false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &after),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &after),
 				),
 			},
 		},
@@ -184,7 +190,7 @@ func TestAccKMSKeyPolicy_iamRole(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policyIAMRole(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 				),
 			},
 		},
@@ -206,13 +212,13 @@ func TestAccKMSKeyPolicy_iamRoleUpdate(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policy(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 				),
 			},
 			{
 				Config: testAccKeyPolicyConfig_policyIAMRole(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 				),
 			},
 		},
@@ -235,7 +241,7 @@ func TestAccKMSKeyPolicy_iamRoleOrder(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policyIAMMultiRole(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 				),
 			},
 		},
@@ -258,7 +264,7 @@ func TestAccKMSKeyPolicy_iamServiceLinkedRole(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policyIAMServiceLinkedRole(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 				),
 			},
 		},
@@ -280,13 +286,120 @@ func TestAccKMSKeyPolicy_booleanCondition(t *testing.T) {
 			{
 				Config: testAccKeyPolicyConfig_policyBooleanCondition(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
 				),
 			},
 		},
 	})
 }
 
+func TestAccKMSKeyPolicy_mergeStatements(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	keyResourceName := "aws_kms_key.test"
+	attachmentResourceName := "aws_kms_key_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyPolicyConfig_mergeStatementsNoSID(rName),
+				ExpectError: regexache.MustCompile(`merge_statements requires every policy statement to have a unique Sid`),
+			},
+			{
+				Config: testAccKeyPolicyConfig_mergeStatements(rName, "AllowDescribeKey", "kms:DescribeKey"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
+					resource.TestCheckResourceAttr(attachmentResourceName, "merge_statements", acctest.CtTrue),
+					testAccCheckKeyPolicyStatementSIDs(ctx, keyResourceName, "Enable IAM User Permissions", "AllowDescribeKey"),
+				),
+			},
+			{
+				Config: testAccKeyPolicyConfig_mergeStatements(rName, "AllowListGrants", "kms:ListGrants"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
+					testAccCheckKeyPolicyStatementSIDs(ctx, keyResourceName, "Enable IAM User Permissions", "AllowListGrants"),
+				),
+			},
+			{
+				Config: testAccKeyPolicyConfig_removedPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
+					testAccCheckKeyPolicyStatementSIDs(ctx, keyResourceName, "Enable IAM User Permissions"),
+				),
+			},
+		},
+	})
+}
+
+func TestMergeKeyPolicyStatements(t *testing.T) {
+	t.Parallel()
+
+	existing := `{"Version":"2012-10-17","Id":"key-default-1","Statement":[{"Sid":"Root","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*"},{"Sid":"Owned","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:Decrypt","Resource":"*"},{"Sid":"Removed","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:Encrypt","Resource":"*"}]}`
+	configured := `{"Version":"2012-10-17","Statement":[{"Sid":"Owned","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:DescribeKey","Resource":"*"}]}`
+
+	got, err := tfkms.MergeKeyPolicyStatements(existing, configured, []string{"Owned", "Removed"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"Version":"2012-10-17","Id":"key-default-1","Statement":[{"Sid":"Root","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*"},{"Sid":"Owned","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:DescribeKey","Resource":"*"}]}`; got != want {
+		t.Errorf("MergeKeyPolicyStatements = %s, want %s", got, want)
+	}
+
+	got, err = tfkms.OwnedKeyPolicy(existing, configured)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"Version":"2012-10-17","Statement":[{"Sid":"Owned","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:Decrypt","Resource":"*"}]}`; got != want {
+		t.Errorf("OwnedKeyPolicy = %s, want %s", got, want)
+	}
+}
+
+func testAccCheckKeyPolicyStatementSIDs(ctx context.Context, n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		output, err := tfkms.FindKeyPolicyByTwoPartKey(ctx, conn, rs.Primary.ID, tfkms.PolicyNameDefault)
+
+		if err != nil {
+			return err
+		}
+
+		var policy struct {
+			Statement []struct {
+				Sid string
+			}
+		}
+		if err := json.Unmarshal([]byte(aws.ToString(output)), &policy); err != nil {
+			return err
+		}
+
+		var got []string
+		for _, v := range policy.Statement {
+			got = append(got, v.Sid)
+		}
+
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("KMS Key (%s) policy statement IDs = %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccKeyPolicyConfig_policy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -699,3 +812,62 @@ resource "aws_kms_key_policy" "test" {
 }
 `, rName, isEnabled)
 }
+
+func testAccKeyPolicyConfig_mergeStatements(rName, sid, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_kms_key_policy" "test" {
+  key_id           = aws_kms_key.test.id
+  merge_statements = true
+
+  policy = jsonencode({
+    Statement = [{
+      Sid    = %[2]q
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = %[3]q
+      Resource = "*"
+    }]
+    Version = "2012-10-17"
+  })
+}
+`, rName, sid, action)
+}
+
+func testAccKeyPolicyConfig_mergeStatementsNoSID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_kms_key_policy" "test" {
+  key_id           = aws_kms_key.test.id
+  merge_statements = true
+
+  policy = jsonencode({
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "*"
+      }
+      Action   = "kms:DescribeKey"
+      Resource = "*"
+    }]
+    Version = "2012-10-17"
+  })
+}
+`, rName)
+}
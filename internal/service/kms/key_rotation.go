@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kms_key_rotation", name="Key Rotation")
+func resourceKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyRotationCreate,
+		ReadWithoutTimeout:   resourceKeyRotationRead,
+		UpdateWithoutTimeout: resourceKeyRotationUpdate,
+		DeleteWithoutTimeout: resourceKeyRotationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrKeyID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotate_on_change": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rotations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rotation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKeyRotationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	key, err := findKeyByID(ctx, conn, d.Get(names.AttrKeyID).(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", d.Get(names.AttrKeyID).(string), err)
+	}
+
+	keyID := aws.ToString(key.KeyId)
+	ctx = withKeyLogFields(ctx, keyID, aws.ToString(key.Arn))
+
+	if err := rotateKeyOnDemand(ctx, conn, keyID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(keyID)
+
+	return append(diags, resourceKeyRotationRead(ctx, d, meta)...)
+}
+
+func resourceKeyRotationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	if _, err := findKeyByID(ctx, conn, d.Id()); !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Key (%s) not found, removing Key Rotation from state", d.Id())
+		d.SetId("")
+		return diags
+	} else if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", d.Id(), err)
+	}
+
+	rotations, err := findKeyRotationsByKeyID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Key Rotation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key Rotation (%s): %s", d.Id(), err)
+	}
+
+	if _, ok := d.GetOk(names.AttrKeyID); !ok {
+		// Import.
+		d.Set(names.AttrKeyID, d.Id())
+	}
+	if err := d.Set("rotations", flattenKeyRotationsListEntries(rotations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rotations: %s", err)
+	}
+
+	return diags
+}
+
+func resourceKeyRotationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	if d.HasChange("rotate_on_change") {
+		if err := rotateKeyOnDemand(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceKeyRotationRead(ctx, d, meta)...)
+}
+
+func resourceKeyRotationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Key material rotations cannot be undone.
+	log.Printf("[WARN] KMS Key Rotation (%s) is only removed from Terraform state", d.Id())
+
+	return diags
+}
+
+// rotateKeyOnDemand starts an on-demand rotation of a key's material and waits for it to complete.
+func rotateKeyOnDemand(ctx context.Context, conn *kms.Client, keyID string, timeout time.Duration) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "rotateKeyOnDemand")
+
+	input := kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	// A ConflictException is returned if a rotation is already in progress.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, timeout, func() (any, error) {
+		return conn.RotateKeyOnDemand(ctx, &input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("rotating KMS Key (%s) on demand: %w", keyID, err)
+	}
+
+	if err := waitKeyOnDemandRotationCompleted(ctx, conn, keyID, timeout); err != nil {
+		return fmt.Errorf("waiting for KMS Key (%s) on-demand rotation: %w", keyID, err)
+	}
+
+	return nil
+}
+
+func findKeyRotationsByKeyID(ctx context.Context, conn *kms.Client, keyID string) ([]awstypes.RotationsListEntry, error) {
+	input := kms.ListKeyRotationsInput{
+		KeyId: aws.String(keyID),
+	}
+
+	return findKeyRotations(ctx, conn, &input)
+}
+
+func findKeyRotations(ctx context.Context, conn *kms.Client, input *kms.ListKeyRotationsInput) ([]awstypes.RotationsListEntry, error) {
+	var output []awstypes.RotationsListEntry
+
+	pages := kms.NewListKeyRotationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Rotations...)
+	}
+
+	return output, nil
+}
+
+func waitKeyOnDemandRotationCompleted(ctx context.Context, conn *kms.Client, keyID string, timeout time.Duration) error {
+	checkFunc := func(ctx context.Context) (bool, error) {
+		output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		// OnDemandRotationStartDate is only set while an on-demand rotation is in progress.
+		return output.OnDemandRotationStartDate == nil, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                10 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
+
+func flattenKeyRotationsListEntries(apiObjects []awstypes.RotationsListEntry) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"rotation_type": string(apiObject.RotationType),
+		}
+
+		if v := apiObject.RotationDate; v != nil {
+			tfMap["rotation_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSKeyRotation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	keyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_key_rotation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRotationConfig_basic(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, keyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKeyID, keyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttr(resourceName, "rotations.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotations.0.rotation_date"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.0.rotation_type", string(awstypes.RotationTypeOnDemand)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_on_change"},
+			},
+			{
+				Config: testAccKeyRotationConfig_basic(rName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rotations.1.rotation_type", string(awstypes.RotationTypeOnDemand)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSKeyRotation_keyDisappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRotationConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, t, keyResourceName, &key),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkms.ResourceKey(), keyResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccKeyRotationConfig_basic(rName, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_kms_key_rotation" "test" {
+  key_id = aws_kms_key.test.key_id
+
+  rotate_on_change = {
+    trigger = %[2]q
+  }
+}
+`, rName, trigger)
+}
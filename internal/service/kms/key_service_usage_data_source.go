@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_kms_key_service_usage", name="Key Service Usage")
+func dataSourceKeyServiceUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceKeyServiceUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrKeyID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"latest_grant_creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_statement_sids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"referenced_in_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeyServiceUsageRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	keyID := d.Get(names.AttrKeyID).(string)
+	key, err := findKeyByID(ctx, conn, keyID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", keyID, err)
+	}
+
+	keyARN := aws.ToString(key.Arn)
+	policy, err := findKeyPolicyByTwoPartKey(ctx, conn, keyARN, policyNameDefault)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) policy: %s", keyID, err)
+	}
+
+	input := kms.ListGrantsInput{
+		KeyId: aws.String(keyARN),
+	}
+	grants, err := findGrants(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.GrantListEntry]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) Grants: %s", keyID, err)
+	}
+
+	usages, err := keyServiceUsages(aws.ToString(policy), grants)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) policy: %s", keyID, err)
+	}
+
+	d.SetId(keyARN)
+	d.Set("key_arn", keyARN)
+	d.Set("service_names", tfslices.ApplyToAll(usages, func(v *keyServiceUsage) string {
+		return v.name
+	}))
+	if err := d.Set("services", flattenKeyServiceUsages(usages)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting services: %s", err)
+	}
+
+	return diags
+}
+
+// keyServiceUsage is an AWS service that a key's policy or grants refer to.
+type keyServiceUsage struct {
+	name                    string
+	grantIDs                []string
+	latestGrantCreationDate *time.Time
+	policyStatementSIDs     []string
+	referencedInPolicy      bool
+}
+
+// keyServiceUsages returns the AWS services that a key's policy or grants refer to, sorted by name.
+// Key policy statements refer to a service by a kms:ViaService condition or a Service principal.
+// Grants refer to a service by a service grantee or retiring principal.
+func keyServiceUsages(policy string, grants []awstypes.GrantListEntry) ([]*keyServiceUsage, error) {
+	usages := make(map[string]*keyServiceUsage)
+	usage := func(name string) *keyServiceUsage {
+		name = strings.ToLower(name)
+		if _, ok := usages[name]; !ok {
+			usages[name] = &keyServiceUsage{name: name}
+		}
+		return usages[name]
+	}
+
+	if policy != "" {
+		statements, err := parseKeyPolicyStatements(policy)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, statement := range statements {
+			var services []string
+
+			for _, condition := range statement.Conditions {
+				if strings.EqualFold(condition.Variable, "kms:ViaService") {
+					services = append(services, keyPolicyValues(condition.Values)...)
+				}
+			}
+
+			for _, principal := range statement.Principals {
+				if principal.Type == "Service" {
+					services = append(services, keyPolicyValues(principal.Identifiers)...)
+				}
+			}
+
+			for _, name := range services {
+				v := usage(name)
+				v.referencedInPolicy = true
+				if sid := statement.Sid; sid != "" && !slices.Contains(v.policyStatementSIDs, sid) {
+					v.policyStatementSIDs = append(v.policyStatementSIDs, sid)
+				}
+			}
+		}
+	}
+
+	for _, grant := range grants {
+		var services []string
+
+		for _, principal := range []string{aws.ToString(grant.GranteePrincipal), aws.ToString(grant.RetiringPrincipal)} {
+			if isServicePrincipal(principal) && !slices.Contains(services, principal) {
+				services = append(services, principal)
+			}
+		}
+
+		for _, name := range services {
+			v := usage(name)
+			v.grantIDs = append(v.grantIDs, aws.ToString(grant.GrantId))
+			if t := grant.CreationDate; t != nil && (v.latestGrantCreationDate == nil || t.After(aws.ToTime(v.latestGrantCreationDate))) {
+				v.latestGrantCreationDate = t
+			}
+		}
+	}
+
+	return slices.SortedFunc(maps.Values(usages), func(a, b *keyServiceUsage) int {
+		return strings.Compare(a.name, b.name)
+	}), nil
+}
+
+// isServicePrincipal returns whether a principal is an AWS service principal, e.g. "rds.us-west-2.amazonaws.com".
+func isServicePrincipal(principal string) bool {
+	return strings.HasSuffix(principal, ".amazonaws.com") || strings.HasSuffix(principal, ".amazonaws.com.cn")
+}
+
+func flattenKeyServiceUsages(apiObjects []*keyServiceUsage) []any {
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"grant_ids":             apiObject.grantIDs,
+			names.AttrName:          apiObject.name,
+			"policy_statement_sids": apiObject.policyStatementSIDs,
+			"referenced_in_policy":  apiObject.referencedInPolicy,
+		}
+
+		if v := apiObject.latestGrantCreationDate; v != nil {
+			tfMap["latest_grant_creation_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
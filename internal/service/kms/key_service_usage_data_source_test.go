@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestKeyServiceUsages(t *testing.T) {
+	t.Parallel()
+
+	t1 := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	testcases := map[string]struct {
+		policy  string
+		grants  []awstypes.GrantListEntry
+		want    []any
+		wantErr bool
+	}{
+		"no policy or grants": {},
+		"no services": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*"}]}`,
+			grants: []awstypes.GrantListEntry{
+				{GrantId: aws.String("g1"), GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/test")}, // lintignore:AWSAT005
+			},
+		},
+		"ViaService condition": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"ViaRDS","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:Decrypt","Resource":"*","Condition":{"StringEquals":{"kms:ViaService":["rds.us-west-2.amazonaws.com","s3.us-west-2.amazonaws.com"]}}}]}`,
+			want: []any{
+				map[string]any{
+					"grant_ids":             []string(nil),
+					names.AttrName:          "rds.us-west-2.amazonaws.com",
+					"policy_statement_sids": []string{"ViaRDS"},
+					"referenced_in_policy":  true,
+				},
+				map[string]any{
+					"grant_ids":             []string(nil),
+					names.AttrName:          "s3.us-west-2.amazonaws.com",
+					"policy_statement_sids": []string{"ViaRDS"},
+					"referenced_in_policy":  true,
+				},
+			},
+		},
+		"service principal": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"logs.us-west-2.amazonaws.com"},"Action":"kms:Encrypt*","Resource":"*"}]}`,
+			want: []any{
+				map[string]any{
+					"grant_ids":             []string(nil),
+					names.AttrName:          "logs.us-west-2.amazonaws.com",
+					"policy_statement_sids": []string(nil),
+					"referenced_in_policy":  true,
+				},
+			},
+		},
+		"grants": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"ViaRDS","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:CreateGrant","Resource":"*","Condition":{"StringEquals":{"kms:ViaService":"rds.us-west-2.amazonaws.com"}}}]}`,
+			grants: []awstypes.GrantListEntry{
+				{GrantId: aws.String("g1"), GranteePrincipal: aws.String("rds.us-west-2.amazonaws.com"), CreationDate: aws.Time(t1)},
+				{GrantId: aws.String("g2"), GranteePrincipal: aws.String("rds.us-west-2.amazonaws.com"), RetiringPrincipal: aws.String("rds.us-west-2.amazonaws.com"), CreationDate: aws.Time(t2)},
+				{GrantId: aws.String("g3"), GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/test")}, // lintignore:AWSAT005
+			},
+			want: []any{
+				map[string]any{
+					"grant_ids":                  []string{"g1", "g2"},
+					"latest_grant_creation_date": t2.Format(time.RFC3339),
+					names.AttrName:               "rds.us-west-2.amazonaws.com",
+					"policy_statement_sids":      []string{"ViaRDS"},
+					"referenced_in_policy":       true,
+				},
+			},
+		},
+		"invalid policy": {
+			policy:  `{"Statement":`,
+			wantErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			usages, err := tfkms.KeyServiceUsages(testcase.policy, testcase.grants)
+
+			if got, want := err != nil, testcase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tfkms.FlattenKeyServiceUsages(usages), testcase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccKMSKeyServiceUsageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kms_key_service_usage.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyServiceUsageDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_arn", keyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "service_names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.grant_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.policy_statement_sids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.policy_statement_sids.0", "ViaSecretsManager"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.referenced_in_policy", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyServiceUsageDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+
+  policy = jsonencode({
+    Id = %[1]q
+    Statement = [
+      {
+        Action = "kms:*"
+        Effect = "Allow"
+        Principal = {
+          AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+        }
+        Resource = "*"
+        Sid      = "Enable IAM User Permissions"
+      },
+      {
+        Action = ["kms:Decrypt", "kms:GenerateDataKey*"]
+        Effect = "Allow"
+        Principal = {
+          AWS = data.aws_caller_identity.current.arn
+        }
+        Resource = "*"
+        Sid      = "ViaSecretsManager"
+        Condition = {
+          StringEquals = {
+            "kms:ViaService" = "secretsmanager.${data.aws_region.current.region}.amazonaws.com"
+          }
+        }
+      },
+    ]
+    Version = "2012-10-17"
+  })
+}
+
+data "aws_kms_key_service_usage" "test" {
+  key_id = aws_kms_key.test.key_id
+}
+`, rName)
+}
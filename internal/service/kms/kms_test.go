@@ -17,6 +17,7 @@ func TestAccKMS_serial(t *testing.T) {
 			acctest.CtBasic:      testAccCustomKeyStore_basic,
 			"update":             testAccCustomKeyStore_update,
 			acctest.CtDisappears: testAccCustomKeyStore_disappears,
+			"writeOnly":          testAccCustomKeyStore_writeOnly,
 		},
 		"CustomKeyStoreDataSource": {
 			acctest.CtBasic: testAccCustomKeyStoreDataSource_basic,
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+const (
+	logKeyKeyARN    = "tf_aws.kms.key_arn"
+	logKeyOperation = "tf_aws.kms.operation"
+	logKeyRegion    = "tf_aws.region"
+)
+
+// withKeyLogFields returns a context whose log lines carry the KMS key's ID and, if known, ARN.
+func withKeyLogFields(ctx context.Context, keyID, keyARN string) context.Context {
+	ctx = tflog.SetField(ctx, logging.KeyResourceId, keyID)
+
+	if keyARN != "" {
+		ctx = tflog.SetField(ctx, logKeyKeyARN, keyARN)
+	}
+
+	return ctx
+}
+
+// withOperationLogFields returns a context whose log lines carry the KMS key's ID and ARN,
+// the name of the operation being performed on it and the Region the operation is performed in.
+// The ARN is that set by withKeyLogFields unless keyID is itself an ARN.
+func withOperationLogFields(ctx context.Context, conn *kms.Client, keyID, operation string) context.Context {
+	ctx = tflog.SetField(ctx, logging.KeyResourceId, keyID)
+	if arn.IsARN(keyID) {
+		ctx = tflog.SetField(ctx, logKeyKeyARN, keyID)
+	}
+	ctx = tflog.SetField(ctx, logKeyOperation, operation)
+	ctx = tflog.SetField(ctx, logKeyRegion, conn.Options().Region)
+
+	return ctx
+}
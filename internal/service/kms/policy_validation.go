@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// keyPolicyActions are the actions that can be specified in a KMS key policy.
+// See https://docs.aws.amazon.com/kms/latest/developerguide/kms-api-permissions-reference.html.
+var keyPolicyActions = []string{
+	"CancelKeyDeletion",
+	"ConnectCustomKeyStore",
+	"CreateAlias",
+	"CreateCustomKeyStore",
+	"CreateGrant",
+	"CreateKey",
+	"Decrypt",
+	"DeleteAlias",
+	"DeleteCustomKeyStore",
+	"DeleteImportedKeyMaterial",
+	"DeriveSharedSecret",
+	"DescribeCustomKeyStores",
+	"DescribeKey",
+	"DisableKey",
+	"DisableKeyRotation",
+	"DisconnectCustomKeyStore",
+	"EnableKey",
+	"EnableKeyRotation",
+	"Encrypt",
+	"GenerateDataKey",
+	"GenerateDataKeyPair",
+	"GenerateDataKeyPairWithoutPlaintext",
+	"GenerateDataKeyWithoutPlaintext",
+	"GenerateMac",
+	"GenerateRandom",
+	"GetKeyPolicy",
+	"GetKeyRotationStatus",
+	"GetParametersForImport",
+	"GetPublicKey",
+	"ImportKeyMaterial",
+	"ListAliases",
+	"ListGrants",
+	"ListKeyPolicies",
+	"ListKeyRotations",
+	"ListKeys",
+	"ListResourceTags",
+	"ListRetirableGrants",
+	"PutKeyPolicy",
+	"ReEncryptFrom",
+	"ReEncryptTo",
+	"ReplicateKey",
+	"RetireGrant",
+	"RevokeGrant",
+	"RotateKeyOnDemand",
+	"ScheduleKeyDeletion",
+	"Sign",
+	"SynchronizeMultiRegionKey",
+	"TagResource",
+	"UntagResource",
+	"UpdateAlias",
+	"UpdateCustomKeyStore",
+	"UpdateKeyDescription",
+	"UpdatePrimaryRegion",
+	"Verify",
+	"VerifyMac",
+}
+
+// keyPolicyConditionKeys are the KMS condition keys that can be specified in a KMS key policy.
+// See https://docs.aws.amazon.com/kms/latest/developerguide/policy-conditions.html.
+var keyPolicyConditionKeys = []string{
+	"kms:BypassPolicyLockoutSafetyCheck",
+	"kms:CallerAccount",
+	"kms:CustomerMasterKeySpec",
+	"kms:CustomerMasterKeyUsage",
+	"kms:DataKeyPairSpec",
+	"kms:EncryptionAlgorithm",
+	"kms:EncryptionContextKeys",
+	"kms:ExpirationModel",
+	"kms:GrantConstraintType",
+	"kms:GrantIsForAWSResource",
+	"kms:GrantOperations",
+	"kms:GranteePrincipal",
+	"kms:KeyAgreementAlgorithm",
+	"kms:KeyOrigin",
+	"kms:KeySpec",
+	"kms:KeyUsage",
+	"kms:MacAlgorithm",
+	"kms:MessageType",
+	"kms:MultiRegion",
+	"kms:MultiRegionKeyType",
+	"kms:PrimaryRegion",
+	"kms:ReEncryptOnSameKey",
+	"kms:RecipientAttestation:ImageSha384",
+	"kms:ReplicaRegion",
+	"kms:RequestAlias",
+	"kms:ResourceAliases",
+	"kms:RetiringPrincipal",
+	"kms:RotationPeriodInDays",
+	"kms:ScheduleKeyDeletionPendingWindowInDays",
+	"kms:SigningAlgorithm",
+	"kms:ValidTo",
+	"kms:ViaService",
+	"kms:WrappingAlgorithm",
+	"kms:WrappingKeySpec",
+}
+
+var (
+	keyPolicyConditionKeyPrefixRegex = regexache.MustCompile(`(?i)^kms:(EncryptionContext:.+|RecipientAttestation:PCR\d+)$`)
+)
+
+// validateKeyPolicy checks a key policy document against the KMS key policy grammar.
+// Invalid effects, actions and KMS condition keys are returned as an error.
+// The returned lockouts describe why applying the policy would prevent the account from managing the key's policy.
+func validateKeyPolicy(policy string) ([]string, error) {
+	statements, err := parseKeyPolicyStatements(policy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	var lockouts []string
+	var putKeyPolicyAllowed bool
+
+	for i, statement := range statements {
+		id := statement.Sid
+		if id == "" {
+			id = fmt.Sprintf("#%d", i)
+		}
+
+		if statement.Effect != "Allow" && statement.Effect != "Deny" {
+			errs = append(errs, fmt.Errorf("statement (%s): Effect must be Allow or Deny, got %q", id, statement.Effect))
+		}
+
+		actions, notActions := keyPolicyValues(statement.Actions), keyPolicyValues(statement.NotActions)
+
+		for _, action := range append(slices.Clone(actions), notActions...) {
+			if err := validKeyPolicyAction(action); err != nil {
+				errs = append(errs, fmt.Errorf("statement (%s): %w", id, err))
+			}
+		}
+
+		for _, condition := range statement.Conditions {
+			if err := validKeyPolicyConditionKey(condition.Variable); err != nil {
+				errs = append(errs, fmt.Errorf("statement (%s): %w", id, err))
+			}
+		}
+
+		appliesToPutKeyPolicy := keyPolicyActionsMatch(actions, "PutKeyPolicy") || (len(notActions) > 0 && !keyPolicyActionsMatch(notActions, "PutKeyPolicy"))
+
+		switch statement.Effect {
+		case "Allow":
+			if appliesToPutKeyPolicy {
+				putKeyPolicyAllowed = true
+			}
+		case "Deny":
+			if appliesToPutKeyPolicy && len(statement.Conditions) == 0 && keyPolicyPrincipalsIncludeEveryone(statement.Principals) {
+				lockouts = append(lockouts, fmt.Sprintf("statement (%s) denies kms:PutKeyPolicy to all principals", id))
+			}
+		}
+	}
+
+	if !putKeyPolicyAllowed {
+		lockouts = append(lockouts, "no statement allows kms:PutKeyPolicy")
+	}
+
+	return lockouts, errors.Join(errs...)
+}
+
+func parseKeyPolicyStatements(policy string) ([]*tfiam.IAMPolicyStatement, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	// Statement can be a single object or an array of objects.
+	if v := bytes.TrimSpace(doc.Statement); len(v) > 0 && v[0] == '{' {
+		var statement tfiam.IAMPolicyStatement
+		if err := json.Unmarshal(v, &statement); err != nil {
+			return nil, fmt.Errorf("parsing policy statement: %w", err)
+		}
+
+		return []*tfiam.IAMPolicyStatement{&statement}, nil
+	}
+
+	var statements []*tfiam.IAMPolicyStatement
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		return nil, fmt.Errorf("parsing policy statements: %w", err)
+	}
+
+	if len(statements) == 0 {
+		return nil, errors.New("policy contains no statements")
+	}
+
+	return statements, nil
+}
+
+func validKeyPolicyAction(action string) error {
+	if action == "*" {
+		return nil
+	}
+
+	name, ok := strings.CutPrefix(strings.ToLower(action), "kms:")
+	if !ok {
+		return fmt.Errorf("action %q is not a KMS action", action)
+	}
+
+	if !keyPolicyActionsMatch([]string{action}, keyPolicyActions...) {
+		if strings.Contains(name, "*") || strings.Contains(name, "?") {
+			return fmt.Errorf("action %q does not match any KMS action", action)
+		}
+
+		return fmt.Errorf("action %q is not a valid KMS action", action)
+	}
+
+	return nil
+}
+
+func validKeyPolicyConditionKey(key string) error {
+	if !strings.HasPrefix(strings.ToLower(key), "kms:") {
+		// Global and other services' condition keys are not validated.
+		return nil
+	}
+
+	if slices.ContainsFunc(keyPolicyConditionKeys, func(v string) bool {
+		return strings.EqualFold(v, key)
+	}) || keyPolicyConditionKeyPrefixRegex.MatchString(key) {
+		return nil
+	}
+
+	return fmt.Errorf("condition key %q is not a valid KMS condition key", key)
+}
+
+// keyPolicyActionsMatch returns whether any of the policy actions (which may contain wildcards) match any of the specified KMS action names.
+func keyPolicyActionsMatch(policyActions []string, actionNames ...string) bool {
+	for _, policyAction := range policyActions {
+		if policyAction == "*" {
+			return true
+		}
+
+		pattern, ok := strings.CutPrefix(strings.ToLower(policyAction), "kms:")
+		if !ok {
+			continue
+		}
+
+		re := regexache.MustCompile(`^` + strings.NewReplacer(`\*`, `.*`, `\?`, `.`).Replace(regexp.QuoteMeta(pattern)) + `$`)
+
+		if slices.ContainsFunc(actionNames, func(name string) bool {
+			return re.MatchString(strings.ToLower(name))
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func keyPolicyPrincipalsIncludeEveryone(principals tfiam.IAMPolicyStatementPrincipalSet) bool {
+	for _, principal := range principals {
+		if principal.Type != "*" && principal.Type != "AWS" {
+			continue
+		}
+
+		if slices.Contains(keyPolicyValues(principal.Identifiers), "*") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func keyPolicyValues(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+// validateKeyPolicyCustomizeDiff validates the planned key policy when validate_policy is true.
+// Policies that would lock out the account are rejected at plan time, as KMS rejects them at apply time,
+// unless bypass_policy_lockout_safety_check is true, in which case a warning is reported when applying.
+func validateKeyPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.Get("validate_policy").(bool) || !d.NewValueKnown(names.AttrPolicy) {
+		return nil
+	}
+
+	policy, err := effectiveKeyPolicy(ctx, d, meta)
+	if err != nil {
+		return fmt.Errorf("validating %s: %w", names.AttrPolicy, err)
+	}
+
+	if policy == "" {
+		return nil
+	}
+
+	lockouts, err := validateKeyPolicy(policy)
+
+	if err != nil {
+		return fmt.Errorf("validating %s: %w", names.AttrPolicy, err)
+	}
+
+	// Statements merged into the key policy from outside the configuration may allow the account to manage the key.
+	if v, ok := d.GetOk("merge_statements"); ok && v.(bool) {
+		lockouts = nil
+	}
+
+	if len(lockouts) > 0 && !d.Get("bypass_policy_lockout_safety_check").(bool) {
+		return fmt.Errorf("%s would lock out the account from managing the key (%s); set bypass_policy_lockout_safety_check to apply it anyway", names.AttrPolicy, strings.Join(lockouts, "; "))
+	}
+
+	return nil
+}
+
+// appendKeyPolicyLockoutWarnings warns about a validated key policy that locks out the account from managing the key.
+func appendKeyPolicyLockoutWarnings(ctx context.Context, diags diag.Diagnostics, d *schema.ResourceData, meta any, keyID string) diag.Diagnostics {
+	if !d.Get("validate_policy").(bool) || !d.Get("bypass_policy_lockout_safety_check").(bool) {
+		return diags
+	}
+
+	if v, ok := d.GetOk("merge_statements"); ok && v.(bool) {
+		return diags
+	}
+
+	policy, err := effectiveKeyPolicy(ctx, d, meta)
+	if err != nil {
+		return diags
+	}
+
+	if lockouts, err := validateKeyPolicy(policy); err == nil && len(lockouts) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "KMS Key (%s) policy may lock out the account from managing the key: %s", keyID, strings.Join(lockouts, "; "))
+	}
+
+	return diags
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"testing"
+
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+)
+
+func TestValidateKeyPolicy(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy       string
+		wantErr      bool
+		wantLockouts int
+	}{
+		"default": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"EnableRootAccess","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"kms:*","Resource":"*"}]}`, // lintignore:AWSAT005
+		},
+		"single statement object": {
+			policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":["kms:Put*","kms:Decrypt"],"Resource":"*"}}`,
+		},
+		"encryption context condition": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*"},{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:Decrypt","Resource":"*","Condition":{"StringEquals":{"kms:EncryptionContext:Department":"IT","aws:PrincipalOrgID":"o-123"},"ForAllValues:StringEquals":{"kms:EncryptionContextKeys":["Department"]}}}]}`,
+		},
+		"invalid JSON": {
+			policy:  `{"Statement":`,
+			wantErr: true,
+		},
+		"no statements": {
+			policy:  `{"Version":"2012-10-17","Statement":[]}`,
+			wantErr: true,
+		},
+		"invalid effect": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Permit","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"non-KMS action": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":["kms:*","s3:GetObject"],"Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"unknown KMS action": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":["kms:*","kms:Encrypted"],"Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"wildcard matching no KMS action": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":["kms:*","kms:Foo*"],"Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"unknown KMS condition key": {
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*","Condition":{"StringEquals":{"kms:EncryptionContextKey":"Department"}}}]}`,
+			wantErr: true,
+		},
+		"no PutKeyPolicy": {
+			policy:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":["kms:Encrypt","kms:Decrypt"],"Resource":"*"}]}`,
+			wantLockouts: 1,
+		},
+		"NotAction excluding PutKeyPolicy": {
+			policy:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"NotAction":"kms:PutKeyPolicy","Resource":"*"}]}`,
+			wantLockouts: 1,
+		},
+		"deny PutKeyPolicy to everyone": {
+			policy:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*"},{"Sid":"DenyPolicyChanges","Effect":"Deny","Principal":"*","Action":"kms:PutKeyPolicy","Resource":"*"}]}`,
+			wantLockouts: 1,
+		},
+		"conditional deny PutKeyPolicy": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"kms:*","Resource":"*"},{"Effect":"Deny","Principal":"*","Action":"kms:PutKeyPolicy","Resource":"*","Condition":{"StringNotEquals":{"kms:CallerAccount":"123456789012"}}}]}`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lockouts, err := tfkms.ValidateKeyPolicy(testcase.policy)
+
+			if got, want := err != nil, testcase.wantErr; got != want {
+				t.Fatalf("err = %v, wantErr = %t", err, want)
+			}
+
+			if got, want := len(lockouts), testcase.wantLockouts; !testcase.wantErr && got != want {
+				t.Errorf("lockouts = %v, want %d", lockouts, want)
+			}
+		})
+	}
+}
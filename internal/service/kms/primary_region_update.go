@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kms_primary_region_update", name="Primary Region Update")
+func resourcePrimaryRegionUpdate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePrimaryRegionUpdateCreate,
+		ReadWithoutTimeout:   resourcePrimaryRegionUpdateRead,
+		DeleteWithoutTimeout: resourcePrimaryRegionUpdateDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+			if from, to := d.Get("from_region").(string), d.Get("to_region").(string); from != "" && from == to {
+				return fmt.Errorf("from_region and to_region must be different, got %s", from)
+			}
+
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"from_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			names.AttrKeyID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"primary_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"to_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+		},
+	}
+}
+
+func resourcePrimaryRegionUpdateCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	keyID, fromRegion, toRegion := d.Get(names.AttrKeyID).(string), d.Get("from_region").(string), d.Get("to_region").(string)
+	key, err := findKeyByID(ctx, conn, keyID, withRegion(fromRegion))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) in %s: %s", keyID, fromRegion, err)
+	}
+
+	primaryRegion, err := multiRegionKeyPrimaryRegion(key)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "KMS Key (%s): %s", keyID, err)
+	}
+
+	keyID = aws.ToString(key.KeyId)
+	ctx = withKeyLogFields(ctx, keyID, aws.ToString(key.Arn))
+
+	switch primaryRegion {
+	case toRegion:
+		// Nothing to do, e.g. the resource is being recreated after a previous apply was interrupted.
+		log.Printf("[WARN] KMS multi-Region Key (%s) primary Region is already %s", keyID, toRegion)
+	case fromRegion:
+		if err := updatePrimaryRegion(ctx, conn, keyID, fromRegion, toRegion, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	default:
+		return sdkdiag.AppendErrorf(diags, "KMS multi-Region Key (%s) primary Region is %s, not from_region (%s)", keyID, primaryRegion, fromRegion)
+	}
+
+	d.SetId(keyID)
+
+	return append(diags, resourcePrimaryRegionUpdateRead(ctx, d, meta)...)
+}
+
+func resourcePrimaryRegionUpdateRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	key, err := findKeyByID(ctx, conn, d.Id(), withRegion(d.Get("to_region").(string)))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Key (%s) not found, removing Primary Region Update from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", d.Id(), err)
+	}
+
+	// The primary Region may since have been changed outside Terraform or by another resource.
+	// It is recorded but not reconciled, as the update is a one-time action.
+	if v := key.MultiRegionConfiguration; v != nil && v.PrimaryKey != nil {
+		d.Set("primary_key_arn", v.PrimaryKey.Arn)
+		d.Set("primary_region", v.PrimaryKey.Region)
+	} else {
+		d.Set("primary_key_arn", nil)
+		d.Set("primary_region", nil)
+	}
+
+	return diags
+}
+
+func resourcePrimaryRegionUpdateDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The primary Region is not changed back.
+	log.Printf("[WARN] KMS Primary Region Update (%s) is only removed from Terraform state", d.Id())
+
+	return diags
+}
+
+// updatePrimaryRegion changes the primary key of a multi-Region key to the replica in the specified Region
+// and waits for both the old and new primary keys to finish updating.
+func updatePrimaryRegion(ctx context.Context, conn *kms.Client, keyID, fromRegion, toRegion string, timeout time.Duration) error {
+	ctx = withOperationLogFields(ctx, conn, keyID, "updatePrimaryRegion")
+	ctx = tflog.SetField(ctx, logKeyRegion, fromRegion)
+
+	input := kms.UpdatePrimaryRegionInput{
+		KeyId:         aws.String(keyID),
+		PrimaryRegion: aws.String(toRegion),
+	}
+
+	tflog.Info(ctx, "Updating KMS multi-Region Key primary Region", map[string]any{
+		"to_region": toRegion,
+	})
+
+	// The operation must be performed in the Region of the current primary key.
+	if _, err := conn.UpdatePrimaryRegion(ctx, &input, withRegion(fromRegion)); err != nil {
+		return fmt.Errorf("updating KMS multi-Region Key (%s) primary Region from %s to %s: %w", keyID, fromRegion, toRegion, err)
+	}
+
+	if err := waitPrimaryRegionUpdated(ctx, conn, keyID, fromRegion, toRegion, timeout); err != nil {
+		return fmt.Errorf("waiting for KMS multi-Region Key (%s) primary Region update: %w", keyID, err)
+	}
+
+	return nil
+}
+
+func waitPrimaryRegionUpdated(ctx context.Context, conn *kms.Client, keyID, fromRegion, toRegion string, timeout time.Duration) error {
+	checkFunc := func(ctx context.Context) (bool, error) {
+		for _, region := range []string{fromRegion, toRegion} {
+			key, err := findKeyByID(ctx, conn, keyID, withRegion(region))
+
+			if tfresource.NotFound(err) {
+				return false, nil
+			}
+
+			if err != nil {
+				return false, err
+			}
+
+			// Both keys are in the Updating state until the update completes.
+			if key.KeyState == awstypes.KeyStateUpdating {
+				return false, nil
+			}
+
+			primaryRegion, err := multiRegionKeyPrimaryRegion(key)
+
+			if err != nil {
+				return false, err
+			}
+
+			if primaryRegion != toRegion {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                10 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
+
+// multiRegionKeyPrimaryRegion returns the Region of a multi-Region key's primary key.
+func multiRegionKeyPrimaryRegion(key *awstypes.KeyMetadata) (string, error) {
+	if !aws.ToBool(key.MultiRegion) || key.MultiRegionConfiguration == nil || key.MultiRegionConfiguration.PrimaryKey == nil {
+		return "", errors.New("not a multi-Region key")
+	}
+
+	return aws.ToString(key.MultiRegionConfiguration.PrimaryKey.Region), nil
+}
+
+func withRegion(region string) func(*kms.Options) {
+	return func(o *kms.Options) {
+		o.Region = region
+	}
+}
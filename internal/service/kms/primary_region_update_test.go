@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSPrimaryRegionUpdate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	replicaKeyResourceName := "aws_kms_replica_key.test"
+	resourceName := "aws_kms_primary_region_update.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrimaryRegionUpdateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, replicaKeyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttr(resourceName, "from_region", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", replicaKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "primary_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "to_region", acctest.AlternateRegion()),
+				),
+				// The original primary key is now a replica and the replica key is now the primary key.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKMSPrimaryRegionUpdate_sameRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPrimaryRegionUpdateConfig_sameRegion(),
+				ExpectError: regexache.MustCompile(`from_region and to_region must be different`),
+			},
+		},
+	})
+}
+
+func testAccPrimaryRegionUpdateConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  multi_region            = true
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_replica_key" "test" {
+  region = %[2]q
+
+  description             = %[1]q
+  primary_key_arn         = aws_kms_key.test.arn
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_primary_region_update" "test" {
+  key_id      = aws_kms_replica_key.test.key_id
+  from_region = aws_kms_key.test.region
+  to_region   = aws_kms_replica_key.test.region
+}
+`, rName, acctest.AlternateRegion())
+}
+
+func testAccPrimaryRegionUpdateConfig_sameRegion() string {
+	return fmt.Sprintf(`
+resource "aws_kms_primary_region_update" "test" {
+  key_id      = "mrk-00000000000000000000000000000000"
+  from_region = %[1]q
+  to_region   = %[1]q
+}
+`, acctest.Region())
+}
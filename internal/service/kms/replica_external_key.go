@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +21,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -35,6 +33,8 @@ import (
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kms/types;awstypes;awstypes.KeyMetadata")
 // @Testing(importIgnore="deletion_window_in_days;bypass_policy_lockout_safety_check;key_material_base64")
 // @Testing(altRegionProvider=true)
+// @Testing(existsTakesT=true)
+// @Testing(destroyTakesT=true)
 func resourceReplicaExternalKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReplicaExternalKeyCreate,
@@ -43,10 +43,15 @@ func resourceReplicaExternalKey() *schema.Resource {
 		DeleteWithoutTimeout: resourceReplicaExternalKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importKeyWithDefaults,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -101,6 +106,11 @@ func resourceReplicaExternalKey() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"skip_propagation_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"valid_to": {
@@ -153,7 +163,7 @@ func resourceReplicaExternalKeyCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(aws.ToString(output.ReplicaKeyMetadata.KeyId))
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), aws.ToString(output.ReplicaKeyMetadata.Arn))
 
 	if _, err := waitReplicaExternalKeyCreated(ctx, conn, d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica External Key (%s) create: %s", d.Id(), err)
@@ -162,7 +172,7 @@ func resourceReplicaExternalKeyCreate(ctx context.Context, d *schema.ResourceDat
 	if v, ok := d.GetOk("key_material_base64"); ok {
 		validTo := d.Get("valid_to").(string)
 
-		if err := importExternalKeyMaterial(ctx, conn, "KMS Replica External Key", d.Id(), v.(string), validTo); err != nil {
+		if _, err := importExternalKeyMaterial(ctx, conn, "KMS Replica External Key", d.Id(), v.(string), validTo); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -184,15 +194,9 @@ func resourceReplicaExternalKeyCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	// Wait for propagation since KMS is eventually consistent.
-	if v, ok := d.GetOk(names.AttrPolicy); ok {
-		if err := waitKeyPolicyPropagated(ctx, conn, d.Id(), v.(string)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica External Key (%s) policy update: %s", d.Id(), err)
-		}
-	}
-
-	if tags := keyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
-		if err := waitTagsPropagated(ctx, conn, d.Id(), tags); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica External Key (%s) tag update: %s", d.Id(), err)
+	if !d.Get("skip_propagation_wait").(bool) {
+		if err := waitKeyPolicyAndTagsPropagated(ctx, conn, d.Id(), d.Get(names.AttrPolicy).(string), keyValueTags(ctx, getTagsIn(ctx))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica External Key (%s) propagation: %s", d.Id(), err)
 		}
 	}
 
@@ -203,7 +207,7 @@ func resourceReplicaExternalKeyRead(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	key, err := findKeyInfo(ctx, conn, d.Id(), d.IsNewResource())
 
@@ -257,7 +261,7 @@ func resourceReplicaExternalKeyUpdate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	if hasChange, enabled, state := d.HasChange(names.AttrEnabled), d.Get(names.AttrEnabled).(bool), awstypes.KeyState(d.Get("key_state").(string)); hasChange && enabled && state != awstypes.KeyStatePendingImport {
 		// Enable before any attributes are modified.
@@ -273,7 +277,12 @@ func resourceReplicaExternalKeyUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if d.HasChange(names.AttrPolicy) {
-		if err := updateKeyPolicy(ctx, conn, "KMS Replica External Key", d.Id(), d.Get(names.AttrPolicy).(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+		update := updateKeyPolicy
+		if d.Get("skip_propagation_wait").(bool) {
+			update = putKeyPolicy
+		}
+
+		if err := update(ctx, conn, "KMS Replica External Key", d.Id(), d.Get(names.AttrPolicy).(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -281,7 +290,7 @@ func resourceReplicaExternalKeyUpdate(ctx context.Context, d *schema.ResourceDat
 	if d.HasChange("valid_to") {
 		validTo := d.Get("valid_to").(string)
 
-		if err := importExternalKeyMaterial(ctx, conn, "KMS Replica External Key", d.Id(), d.Get("key_material_base64").(string), validTo); err != nil {
+		if _, err := importExternalKeyMaterial(ctx, conn, "KMS Replica External Key", d.Id(), d.Get("key_material_base64").(string), validTo); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -301,6 +310,12 @@ func resourceReplicaExternalKeyUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.HasChange(names.AttrTagsAll) {
+		if err := waitKeyTagsUpdatePropagated(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica External Key (%s) tag update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceReplicaExternalKeyRead(ctx, d, meta)...)
 }
 
@@ -308,7 +323,11 @@ func resourceReplicaExternalKeyDelete(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	if err := checkKeyAllowDestroy(ctx, d, meta, "KMS Replica External Key"); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	input := kms.ScheduleKeyDeletionInput{
 		KeyId: aws.String(d.Id()),
@@ -340,6 +359,8 @@ func resourceReplicaExternalKeyDelete(ctx context.Context, d *schema.ResourceDat
 }
 
 func waitReplicaExternalKeyCreated(ctx context.Context, conn *kms.Client, id string) (*awstypes.KeyMetadata, error) {
+	ctx = withOperationLogFields(ctx, conn, id, "waitReplicaExternalKeyCreated")
+
 	const (
 		timeout = 2 * time.Minute
 	)
@@ -5,6 +5,7 @@ package kms
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -13,7 +14,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -32,9 +32,14 @@ import (
 
 // @SDKResource("aws_kms_replica_key", name="Replica Key")
 // @Tags(identifierAttribute="id")
+// @ArnIdentity
+// @V60SDKv2Fix
+// @WrappedImport(false)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kms/types;awstypes;awstypes.KeyMetadata")
-// @Testing(importIgnore="deletion_window_in_days;bypass_policy_lockout_safety_check")
+// @Testing(importIgnore="deletion_window_in_days;bypass_policy_lockout_safety_check;recover_pending_deletion")
 // @Testing(altRegionProvider=true)
+// @Testing(existsTakesT=true)
+// @Testing(destroyTakesT=true)
 func resourceReplicaKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReplicaKeyCreate,
@@ -43,10 +48,37 @@ func resourceReplicaKey() *schema.Resource {
 		DeleteWithoutTimeout: resourceReplicaKeyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				// Import by key ID, key ARN or alias.
+				if d.Id() != "" {
+					return importKeyWithDefaults(ctx, d, meta)
+				}
+
+				if err := importer.RegionalARN(ctx, d, names.AttrARN, nil); err != nil {
+					return nil, err
+				}
+
+				v := d.Get(names.AttrARN).(string)
+				keyID := keyIDFromARN(v)
+
+				if keyID == "" {
+					return nil, fmt.Errorf("unexpected format for KMS Replica Key ARN (%s)", v)
+				}
+
+				d.SetId(keyID)
+				d.Set("allow_destroy", false)
+				d.Set("skip_propagation_wait", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -72,6 +104,11 @@ func resourceReplicaKey() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"include_account_root_statement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrKeyID: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,6 +132,16 @@ func resourceReplicaKey() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"recover_pending_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"skip_propagation_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -111,6 +158,22 @@ func resourceReplicaKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "parsing primary key ARN: %s", err)
 	}
 
+	// A Region can contain only one replica of a multi-Region primary key, and it has the same key ID as the primary key.
+	if d.Get("recover_pending_deletion").(bool) {
+		key, err := findPendingDeletionKey(ctx, conn, strings.TrimPrefix(primaryKeyARN.Resource, "key/"), func(v *awstypes.KeyMetadata) bool {
+			return v.MultiRegionConfiguration != nil && v.MultiRegionConfiguration.MultiRegionKeyType == awstypes.MultiRegionKeyTypeReplica &&
+				v.MultiRegionConfiguration.PrimaryKey != nil && aws.ToString(v.MultiRegionConfiguration.PrimaryKey.Arn) == primaryKeyARN.String()
+		})
+
+		if err == nil {
+			return append(diags, resourceReplicaKeyRecover(ctx, d, meta, aws.ToString(key.KeyId))...)
+		}
+
+		if !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "finding KMS Replica Key pending deletion: %s", err)
+		}
+	}
+
 	input := kms.ReplicateKeyInput{
 		KeyId:         aws.String(strings.TrimPrefix(primaryKeyARN.Resource, "key/")),
 		ReplicaRegion: aws.String(meta.(*conns.AWSClient).Region(ctx)),
@@ -125,8 +188,13 @@ func resourceReplicaKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Description = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk(names.AttrPolicy); ok {
-		input.Policy = aws.String(v.(string))
+	policy, err := effectiveKeyPolicy(ctx, d, meta)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if policy != "" {
+		input.Policy = aws.String(policy)
 	}
 
 	output, err := waitIAMPropagation(ctx, iamPropagationTimeout, func() (*kms.ReplicateKeyOutput, error) {
@@ -142,7 +210,7 @@ func resourceReplicaKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(output.ReplicaKeyMetadata.KeyId))
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), aws.ToString(output.ReplicaKeyMetadata.Arn))
 
 	if _, err := waitReplicaKeyCreated(ctx, conn, d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica Key (%s) create: %s", d.Id(), err)
@@ -157,15 +225,47 @@ func resourceReplicaKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// Wait for propagation since KMS is eventually consistent.
-	if v, ok := d.GetOk(names.AttrPolicy); ok {
-		if err := waitKeyPolicyPropagated(ctx, conn, d.Id(), v.(string)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica Key (%s) policy update: %s", d.Id(), err)
+	if !d.Get("skip_propagation_wait").(bool) {
+		if err := waitKeyPolicyAndTagsPropagated(ctx, conn, d.Id(), policy, keyValueTags(ctx, getTagsIn(ctx))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica Key (%s) propagation: %s", d.Id(), err)
 		}
 	}
 
-	if tags := keyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
-		if err := waitTagsPropagated(ctx, conn, d.Id(), tags); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica Key (%s) tag update: %s", d.Id(), err)
+	return append(diags, resourceReplicaKeyRead(ctx, d, meta)...)
+}
+
+// resourceReplicaKeyRecover cancels the deletion of an existing replica key and brings it in line with the configuration.
+func resourceReplicaKeyRecover(ctx context.Context, d *schema.ResourceData, meta any, keyID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	if err := cancelKeyDeletion(ctx, conn, "KMS Replica Key", keyID); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(keyID)
+
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
+
+	if err := updateKeyDescription(ctx, conn, "KMS Replica Key", d.Id(), d.Get(names.AttrDescription).(string)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if policy, err := effectiveKeyPolicy(ctx, d, meta); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	} else if policy != "" {
+		if err := updateKeyPolicy(ctx, conn, "KMS Replica Key", d.Id(), policy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if err := updateRecoveredKeyTags(ctx, conn, d.Id(), keyValueTags(ctx, getTagsIn(ctx)), d.Get("skip_propagation_wait").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating KMS Replica Key (%s) tags: %s", d.Id(), err)
+	}
+
+	if enabled := d.Get(names.AttrEnabled).(bool); !enabled {
+		if err := updateKeyEnabled(ctx, conn, "KMS Replica Key", d.Id(), enabled); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 
@@ -176,7 +276,7 @@ func resourceReplicaKeyRead(ctx context.Context, d *schema.ResourceData, meta an
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	key, err := findKeyInfo(ctx, conn, d.Id(), d.IsNewResource())
 
@@ -209,7 +309,15 @@ func resourceReplicaKeyRead(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set("key_rotation_enabled", key.rotation)
 	d.Set("key_spec", key.metadata.KeySpec)
 	d.Set("key_usage", key.metadata.KeyUsage)
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get(names.AttrPolicy).(string), key.policy)
+	policy := key.policy
+	if d.Get("include_account_root_statement").(bool) {
+		// The added account root statement isn't compared with the configuration.
+		policy, err = keyPolicyWithoutAccountRootStatement(policy, d.Get(names.AttrPolicy).(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get(names.AttrPolicy).(string), policy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -218,6 +326,8 @@ func resourceReplicaKeyRead(ctx context.Context, d *schema.ResourceData, meta an
 
 	setTagsOut(ctx, key.tags)
 
+	diags = appendKeyPolicyAccountRootWarnings(ctx, diags, d, meta, d.Id())
+
 	return diags
 }
 
@@ -225,7 +335,7 @@ func resourceReplicaKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	if hasChange, enabled := d.HasChange(names.AttrEnabled), d.Get(names.AttrEnabled).(bool); hasChange && enabled {
 		// Enable before any attributes are modified.
@@ -240,8 +350,18 @@ func resourceReplicaKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChange(names.AttrPolicy) {
-		if err := updateKeyPolicy(ctx, conn, "KMS Replica Key", d.Id(), d.Get(names.AttrPolicy).(string), d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
+	if d.HasChanges(names.AttrPolicy, "include_account_root_statement") {
+		policy, err := effectiveKeyPolicy(ctx, d, meta)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		update := updateKeyPolicy
+		if d.Get("skip_propagation_wait").(bool) {
+			update = putKeyPolicy
+		}
+
+		if err := update(ctx, conn, "KMS Replica Key", d.Id(), policy, d.Get("bypass_policy_lockout_safety_check").(bool)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -253,6 +373,12 @@ func resourceReplicaKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange(names.AttrTagsAll) {
+		if err := waitKeyTagsUpdatePropagated(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica Key (%s) tag update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceReplicaKeyRead(ctx, d, meta)...)
 }
 
@@ -260,7 +386,11 @@ func resourceReplicaKeyDelete(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
-	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
+	if err := checkKeyAllowDestroy(ctx, d, meta, "KMS Replica Key"); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ctx = withKeyLogFields(ctx, d.Id(), d.Get(names.AttrARN).(string))
 
 	input := kms.ScheduleKeyDeletionInput{
 		KeyId: aws.String(d.Id()),
@@ -292,6 +422,8 @@ func resourceReplicaKeyDelete(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func waitReplicaKeyCreated(ctx context.Context, conn *kms.Client, id string) (*awstypes.KeyMetadata, error) {
+	ctx = withOperationLogFields(ctx, conn, id, "waitReplicaKeyCreated")
+
 	const (
 		timeout = 2 * time.Minute
 	)
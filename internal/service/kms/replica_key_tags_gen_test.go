@@ -26,7 +26,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -39,7 +39,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -75,7 +75,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -90,7 +90,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -131,7 +131,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -145,7 +145,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -181,7 +181,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -193,7 +193,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{})),
@@ -219,7 +219,7 @@ func TestAccKMSReplicaKey_tags(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -235,7 +235,7 @@ func TestAccKMSReplicaKey_tags_null(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -248,7 +248,7 @@ func TestAccKMSReplicaKey_tags_null(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -277,7 +277,7 @@ func TestAccKMSReplicaKey_tags_null(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -310,7 +310,7 @@ func TestAccKMSReplicaKey_tags_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -321,7 +321,7 @@ func TestAccKMSReplicaKey_tags_EmptyMap(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -348,7 +348,7 @@ func TestAccKMSReplicaKey_tags_EmptyMap(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -381,7 +381,7 @@ func TestAccKMSReplicaKey_tags_AddOnUpdate(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -392,7 +392,7 @@ func TestAccKMSReplicaKey_tags_AddOnUpdate(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -418,7 +418,7 @@ func TestAccKMSReplicaKey_tags_AddOnUpdate(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -454,7 +454,7 @@ func TestAccKMSReplicaKey_tags_AddOnUpdate(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -470,7 +470,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnCreate(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -483,7 +483,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnCreate(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -518,7 +518,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnCreate(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -530,7 +530,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnCreate(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{})),
@@ -556,7 +556,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnCreate(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -572,7 +572,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -585,7 +585,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -619,7 +619,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -658,7 +658,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -672,7 +672,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -708,7 +708,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -724,7 +724,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -737,7 +737,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -770,7 +770,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -805,7 +805,7 @@ func TestAccKMSReplicaKey_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -821,7 +821,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -835,7 +835,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -868,7 +868,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -884,7 +884,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{})),
@@ -920,7 +920,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -935,7 +935,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{})),
@@ -968,7 +968,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -980,7 +980,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{})),
@@ -1006,7 +1006,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_providerOnly(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1022,7 +1022,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nonOverlapping(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1038,7 +1038,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nonOverlapping(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1079,7 +1079,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nonOverlapping(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -1097,7 +1097,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nonOverlapping(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1143,7 +1143,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nonOverlapping(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -1155,7 +1155,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nonOverlapping(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{})),
@@ -1181,7 +1181,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nonOverlapping(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1197,7 +1197,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_overlapping(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1213,7 +1213,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_overlapping(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1252,7 +1252,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_overlapping(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -1271,7 +1271,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_overlapping(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1316,7 +1316,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_overlapping(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 			{
@@ -1333,7 +1333,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_overlapping(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1372,7 +1372,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_overlapping(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1388,7 +1388,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_updateToProviderOnly(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1401,7 +1401,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_updateToProviderOnly(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1435,7 +1435,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_updateToProviderOnly(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{})),
@@ -1468,7 +1468,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_updateToProviderOnly(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1484,7 +1484,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_updateToResourceOnly(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1498,7 +1498,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_updateToResourceOnly(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -1527,7 +1527,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_updateToResourceOnly(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1563,7 +1563,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_updateToResourceOnly(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1579,7 +1579,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1595,7 +1595,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1633,7 +1633,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_emptyResourceTag(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1649,7 +1649,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1663,7 +1663,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T) {
 					"alt_region":           config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -1695,7 +1695,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_emptyProviderOnlyTag(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1711,7 +1711,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nullOverlappingResourceTag(t *testing
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1727,7 +1727,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nullOverlappingResourceTag(t *testing
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -1762,7 +1762,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nullOverlappingResourceTag(t *testing
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1778,7 +1778,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nullNonOverlappingResourceTag(t *test
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1794,7 +1794,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nullNonOverlappingResourceTag(t *test
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
@@ -1829,7 +1829,7 @@ func TestAccKMSReplicaKey_tags_DefaultTags_nullNonOverlappingResourceTag(t *test
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1845,7 +1845,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnCreate(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1856,7 +1856,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnCreate(t *testing.T) {
 					"alt_region":    config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttrPair(resourceName, "tags.computedkey1", "null_resource.test", names.AttrID),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -1889,7 +1889,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnCreate(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -1905,7 +1905,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -1918,7 +1918,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -1951,7 +1951,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 					"alt_region":    config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttrPair(resourceName, "tags.computedkey1", "null_resource.test", names.AttrID),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -1992,7 +1992,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnUpdate_Add(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -2008,7 +2008,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
@@ -2021,7 +2021,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -2052,7 +2052,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 					"alt_region":    config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttrPair(resourceName, acctest.CtTagsKey1, "null_resource.test", names.AttrID),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -2085,7 +2085,7 @@ func TestAccKMSReplicaKey_tags_ComputedTag_OnUpdate_Replace(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"deletion_window_in_days", "bypass_policy_lockout_safety_check",
+					"deletion_window_in_days", "bypass_policy_lockout_safety_check", "recover_pending_deletion",
 				},
 			},
 		},
@@ -2101,7 +2101,7 @@ func TestAccKMSReplicaKey_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			// 1: Create
 			{
@@ -2121,7 +2121,7 @@ func TestAccKMSReplicaKey_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -2171,7 +2171,7 @@ func TestAccKMSReplicaKey_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -2221,7 +2221,7 @@ func TestAccKMSReplicaKey_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -2266,7 +2266,7 @@ func TestAccKMSReplicaKey_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, names.KMSServiceID),
-		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx),
+		CheckDestroy: testAccCheckReplicaKeyDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			// 1: Create
 			{
@@ -2284,7 +2284,7 @@ func TestAccKMSReplicaKey_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -2348,7 +2348,7 @@ func TestAccKMSReplicaKey_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
@@ -2412,7 +2412,7 @@ func TestAccKMSReplicaKey_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
 					"alt_region": config.StringVariable(acctest.AlternateRegion()),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckReplicaKeyExists(ctx, resourceName, &v),
+					testAccCheckReplicaKeyExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
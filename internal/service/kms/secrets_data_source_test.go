@@ -35,7 +35,7 @@ func TestAccKMSSecretsDataSource_basic(t *testing.T) {
 			{
 				Config: testAccSecretsDataSourceConfig_key,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, resourceName, &key),
+					testAccCheckKeyExists(ctx, t, resourceName, &key),
 					testAccSecretsDataSourceEncrypt(ctx, &key, plaintext, &encryptedPayload),
 					// We need to dereference the encryptedPayload in a test Terraform configuration
 					testAccSecretsDataSourceDecrypt(ctx, t, plaintext, &encryptedPayload),
@@ -62,7 +62,7 @@ func TestAccKMSSecretsDataSource_asymmetric(t *testing.T) {
 			{
 				Config: testAccSecretsDataSourceConfig_asymmetricKey,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckKeyExists(ctx, resourceName, &key),
+					testAccCheckKeyExists(ctx, t, resourceName, &key),
 					testAccSecretsDataSourceEncryptAsymmetric(ctx, &key, plaintext, &encryptedPayload),
 					// We need to dereference the encryptedPayload in a test Terraform configuration
 					testAccSecretsDataSourceDecryptAsymmetric(ctx, t, &key, plaintext, &encryptedPayload),
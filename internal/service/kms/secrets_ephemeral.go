@@ -33,6 +33,10 @@ type secretsEphemeralResource struct {
 func (e *secretsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"context_hash": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+			},
 			"plaintext": schema.MapAttribute{
 				CustomType: fwtypes.MapOfStringType,
 				Computed:   true,
@@ -90,7 +94,7 @@ func (e *secretsEphemeralResource) Open(ctx context.Context, request ephemeral.O
 		return
 	}
 
-	plaintext := make(map[string]attr.Value)
+	contextHash, plaintext := make(map[string]attr.Value), make(map[string]attr.Value)
 
 	for _, v := range secrets {
 		input := kms.DecryptInput{}
@@ -120,9 +124,21 @@ func (e *secretsEphemeralResource) Open(ctx context.Context, request ephemeral.O
 			return
 		}
 
-		plaintext[v.Name.ValueString()] = fwflex.StringValueToFramework(ctx, string(output.Plaintext))
+		hash, err := encryptionContextHash(input.EncryptionContext)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"failed to decrypt secret",
+				err.Error(),
+			)
+			return
+		}
+
+		name := v.Name.ValueString()
+		contextHash[name] = fwflex.StringValueToFramework(ctx, hash)
+		plaintext[name] = fwflex.StringValueToFramework(ctx, string(output.Plaintext))
 	}
 
+	data.ContextHash = fwtypes.NewMapValueOfMust[types.String](ctx, contextHash)
 	data.Plaintext = fwtypes.NewMapValueOfMust[types.String](ctx, plaintext)
 
 	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
@@ -130,8 +146,9 @@ func (e *secretsEphemeralResource) Open(ctx context.Context, request ephemeral.O
 
 type secretsEphemeralResourceModel struct {
 	framework.WithRegionModel
-	Plaintext fwtypes.MapOfString                       `tfsdk:"plaintext"`
-	Secrets   fwtypes.SetNestedObjectValueOf[epSecrets] `tfsdk:"secret"`
+	ContextHash fwtypes.MapOfString                       `tfsdk:"context_hash"`
+	Plaintext   fwtypes.MapOfString                       `tfsdk:"plaintext"`
+	Secrets     fwtypes.SetNestedObjectValueOf[epSecrets] `tfsdk:"secret"`
 }
 
 type epSecrets struct {
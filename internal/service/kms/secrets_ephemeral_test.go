@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -37,6 +38,7 @@ func TestAccKMSSecretsEphemeral_basic(t *testing.T) {
 			{
 				Config: testAccSecretsEphemeralResourceConfig_basic(rName, plaintext),
 				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("context_hash").AtMapKey(rName), knownvalue.StringRegexp(regexache.MustCompile(`^[0-9a-f]{64}$`))),
 					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("plaintext").AtMapKey(rName), knownvalue.StringExact(plaintext)),
 				},
 			},
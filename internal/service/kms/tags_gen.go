@@ -154,12 +154,6 @@ func updateTags(ctx context.Context, conn *kms.Client, identifier string, oldTag
 		}
 	}
 
-	if len(removedTags) > 0 || len(updatedTags) > 0 {
-		if err := waitTagsPropagated(ctx, conn, identifier, newTags, optFns...); err != nil {
-			return fmt.Errorf("waiting for resource (%s) tag propagation: %w", identifier, err)
-		}
-	}
-
 	return nil
 }
 
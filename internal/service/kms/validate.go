@@ -5,9 +5,11 @@ package kms
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -23,6 +25,44 @@ var (
 	keyIDResourceRegex = regexache.MustCompile(`^key/(` + verify.UUIDRegexPattern + `|` + multiRegionKeyIDPattern + `)$`)
 )
 
+// keySpecKeyUsages maps each key spec to the key usages that KMS accepts for it.
+// See https://docs.aws.amazon.com/kms/latest/developerguide/symm-asymm-choose-key-spec.html.
+var keySpecKeyUsages = map[awstypes.KeySpec][]awstypes.KeyUsageType{
+	awstypes.KeySpecSymmetricDefault: {awstypes.KeyUsageTypeEncryptDecrypt},
+	awstypes.KeySpecRsa2048:          {awstypes.KeyUsageTypeEncryptDecrypt, awstypes.KeyUsageTypeSignVerify},
+	awstypes.KeySpecRsa3072:          {awstypes.KeyUsageTypeEncryptDecrypt, awstypes.KeyUsageTypeSignVerify},
+	awstypes.KeySpecRsa4096:          {awstypes.KeyUsageTypeEncryptDecrypt, awstypes.KeyUsageTypeSignVerify},
+	awstypes.KeySpecEccNistP256:      {awstypes.KeyUsageTypeSignVerify, awstypes.KeyUsageTypeKeyAgreement},
+	awstypes.KeySpecEccNistP384:      {awstypes.KeyUsageTypeSignVerify, awstypes.KeyUsageTypeKeyAgreement},
+	awstypes.KeySpecEccNistP521:      {awstypes.KeyUsageTypeSignVerify, awstypes.KeyUsageTypeKeyAgreement},
+	awstypes.KeySpecEccSecgP256k1:    {awstypes.KeyUsageTypeSignVerify},
+	awstypes.KeySpecHmac224:          {awstypes.KeyUsageTypeGenerateVerifyMac},
+	awstypes.KeySpecHmac256:          {awstypes.KeyUsageTypeGenerateVerifyMac},
+	awstypes.KeySpecHmac384:          {awstypes.KeyUsageTypeGenerateVerifyMac},
+	awstypes.KeySpecHmac512:          {awstypes.KeyUsageTypeGenerateVerifyMac},
+	awstypes.KeySpecSm2:              {awstypes.KeyUsageTypeEncryptDecrypt, awstypes.KeyUsageTypeSignVerify, awstypes.KeyUsageTypeKeyAgreement},
+	awstypes.KeySpecMlDsa44:          {awstypes.KeyUsageTypeSignVerify},
+	awstypes.KeySpecMlDsa65:          {awstypes.KeyUsageTypeSignVerify},
+	awstypes.KeySpecMlDsa87:          {awstypes.KeyUsageTypeSignVerify},
+}
+
+// validateKeySpecKeyUsageOrigin returns an error if KMS rejects a key with the given combination of key spec, key usage and origin.
+// Key specs not known to the provider are not validated.
+func validateKeySpecKeyUsageOrigin(keySpec awstypes.KeySpec, keyUsage awstypes.KeyUsageType, origin awstypes.OriginType) error {
+	switch origin {
+	case awstypes.OriginTypeAwsCloudhsm, awstypes.OriginTypeExternalKeyStore:
+		if keySpec != awstypes.KeySpecSymmetricDefault {
+			return fmt.Errorf("key spec %s is not supported for keys with origin %s, only %s is supported", keySpec, origin, awstypes.KeySpecSymmetricDefault)
+		}
+	}
+
+	if keyUsages, ok := keySpecKeyUsages[keySpec]; ok && !slices.Contains(keyUsages, keyUsage) {
+		return fmt.Errorf("key usage %s is not supported for key spec %s, supported key usages are %v", keyUsage, keySpec, keyUsages)
+	}
+
+	return nil
+}
+
 func validGrantName(v any, k string) (ws []string, es []error) {
 	value := v.(string)
 
@@ -124,3 +164,35 @@ func validateKeyAliasARN(v any, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validKeyPolicyPrincipal validates that a key policy principal is an AWS account ID or the ARN of an IAM or STS principal.
+func validKeyPolicyPrincipal(v any, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if regexache.MustCompile(`^\d{12}$`).MatchString(value) {
+		return
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is neither an AWS account ID nor a valid ARN: %s", k, value, err))
+		return
+	}
+
+	if service := parsedARN.Service; service != "iam" && service != "sts" {
+		errors = append(errors, fmt.Errorf("%q (%s) is not an IAM or STS principal ARN", k, value))
+		return
+	}
+
+	if parsedARN.AccountID == "" || parsedARN.Resource == "" {
+		errors = append(errors, fmt.Errorf("%q (%s) is missing an account ID or resource", k, value))
+		return
+	}
+
+	return
+}
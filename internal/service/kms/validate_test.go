@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -250,3 +251,158 @@ func TestValidateKeyARN(t *testing.T) {
 		})
 	}
 }
+
+func TestValidKeyPolicyPrincipal(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		in    any
+		valid bool
+	}{
+		"account id": {
+			in:    "123456789012",
+			valid: true,
+		},
+		"iam root": {
+			in:    "arn:aws:iam::123456789012:root", // lintignore:AWSAT005
+			valid: true,
+		},
+		"iam role": {
+			in:    "arn:aws:iam::123456789012:role/Admin", // lintignore:AWSAT005
+			valid: true,
+		},
+		"sts assumed role": {
+			in:    "arn:aws:sts::123456789012:assumed-role/Admin/session", // lintignore:AWSAT005
+			valid: true,
+		},
+		"wildcard": {
+			in:    "*",
+			valid: false,
+		},
+		"non-iam arn": {
+			in:    "arn:aws:kms:us-west-2:123456789012:key/57ff7a43-341d-46b6-aee3-a450c9de6dc8", // lintignore:AWSAT003,AWSAT005
+			valid: false,
+		},
+		"short account id": {
+			in:    "12345678901",
+			valid: false,
+		},
+		"not a string": {
+			in:    123,
+			valid: false,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			aWs, aEs := tfkms.ValidKeyPolicyPrincipal(testcase.in, names.AttrField)
+			if len(aWs) != 0 {
+				t.Errorf("expected no warnings, got %v", aWs)
+			}
+			if testcase.valid {
+				if len(aEs) != 0 {
+					t.Errorf("expected no errors, got %v", aEs)
+				}
+			} else {
+				if len(aEs) == 0 {
+					t.Error("expected errors, got none")
+				}
+			}
+		})
+	}
+}
+
+func TestValidateKeySpecKeyUsageOrigin(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		keySpec  awstypes.KeySpec
+		keyUsage awstypes.KeyUsageType
+		origin   awstypes.OriginType
+		valid    bool
+	}{
+		"symmetric encrypt": {
+			keySpec:  awstypes.KeySpecSymmetricDefault,
+			keyUsage: awstypes.KeyUsageTypeEncryptDecrypt,
+			origin:   awstypes.OriginTypeAwsKms,
+			valid:    true,
+		},
+		"symmetric sign": {
+			keySpec:  awstypes.KeySpecSymmetricDefault,
+			keyUsage: awstypes.KeyUsageTypeSignVerify,
+			origin:   awstypes.OriginTypeAwsKms,
+		},
+		"RSA sign": {
+			keySpec:  awstypes.KeySpecRsa4096,
+			keyUsage: awstypes.KeyUsageTypeSignVerify,
+			origin:   awstypes.OriginTypeAwsKms,
+			valid:    true,
+		},
+		"ECC key agreement": {
+			keySpec:  awstypes.KeySpecEccNistP384,
+			keyUsage: awstypes.KeyUsageTypeKeyAgreement,
+			origin:   awstypes.OriginTypeAwsKms,
+			valid:    true,
+		},
+		"ECC encrypt": {
+			keySpec:  awstypes.KeySpecEccNistP256,
+			keyUsage: awstypes.KeyUsageTypeEncryptDecrypt,
+			origin:   awstypes.OriginTypeAwsKms,
+		},
+		"HMAC MAC": {
+			keySpec:  awstypes.KeySpecHmac256,
+			keyUsage: awstypes.KeyUsageTypeGenerateVerifyMac,
+			origin:   awstypes.OriginTypeAwsKms,
+			valid:    true,
+		},
+		"HMAC sign": {
+			keySpec:  awstypes.KeySpecHmac256,
+			keyUsage: awstypes.KeyUsageTypeSignVerify,
+			origin:   awstypes.OriginTypeAwsKms,
+		},
+		"ML-DSA sign": {
+			keySpec:  awstypes.KeySpecMlDsa65,
+			keyUsage: awstypes.KeyUsageTypeSignVerify,
+			origin:   awstypes.OriginTypeAwsKms,
+			valid:    true,
+		},
+		"CloudHSM symmetric": {
+			keySpec:  awstypes.KeySpecSymmetricDefault,
+			keyUsage: awstypes.KeyUsageTypeEncryptDecrypt,
+			origin:   awstypes.OriginTypeAwsCloudhsm,
+			valid:    true,
+		},
+		"CloudHSM HMAC": {
+			keySpec:  awstypes.KeySpecHmac256,
+			keyUsage: awstypes.KeyUsageTypeGenerateVerifyMac,
+			origin:   awstypes.OriginTypeAwsCloudhsm,
+		},
+		"external key store RSA": {
+			keySpec:  awstypes.KeySpecRsa2048,
+			keyUsage: awstypes.KeyUsageTypeEncryptDecrypt,
+			origin:   awstypes.OriginTypeExternalKeyStore,
+		},
+		"unknown key spec": {
+			keySpec:  awstypes.KeySpec("FUTURE_SPEC"),
+			keyUsage: awstypes.KeyUsageTypeSignVerify,
+			origin:   awstypes.OriginTypeAwsKms,
+			valid:    true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfkms.ValidateKeySpecKeyUsageOrigin(testcase.keySpec, testcase.keyUsage, testcase.origin)
+			if testcase.valid && err != nil {
+				t.Errorf("expected no error, got %s", err)
+			}
+			if !testcase.valid && err == nil {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
@@ -3,6 +3,12 @@
 
 package networkmonitor
 
+import (
+	"time"
+
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
 // Exports for use in tests only.
 var (
 	ResourceMonitor = newMonitorResource
@@ -11,3 +17,12 @@ var (
 	FindMonitorByName     = findMonitorByName
 	FindProbeByTwoPartKey = findProbeByTwoPartKey
 )
+
+func LatestProbeMetricStatistics(results []cwtypes.MetricDataResult, metricName string) (time.Time, map[string]float64, bool) {
+	v := latestProbeMetricStatistics(results, metricName)
+	if v == nil {
+		return time.Time{}, nil, false
+	}
+
+	return v.timestamp, v.values, true
+}
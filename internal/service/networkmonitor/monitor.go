@@ -6,14 +6,18 @@ package networkmonitor
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/networkmonitor"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmonitor/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -35,13 +39,22 @@ import (
 
 // @FrameworkResource("aws_networkmonitor_monitor", name="Monitor")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @Testing(useVCR=true)
 func newMonitorResource(context.Context) (resource.ResourceWithConfigure, error) {
-	return &monitorResource{}, nil
+	r := &monitorResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
 }
 
 type monitorResource struct {
 	framework.ResourceWithModel[monitorResourceModel]
-	framework.WithImportByID
+	framework.WithImportByIdentity
+	framework.WithTimeouts
 }
 
 func (r *monitorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -72,6 +85,13 @@ func (r *monitorResource) Schema(ctx context.Context, request resource.SchemaReq
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -102,7 +122,7 @@ func (r *monitorResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
-	output, err := waitMonitorReady(ctx, conn, data.MonitorName.ValueString())
+	output, err := waitMonitorReady(ctx, conn, data.MonitorName.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Network Monitor Monitor (%s) create", data.MonitorName.ValueString()), err.Error())
 
@@ -171,14 +191,27 @@ func (r *monitorResource) Update(ctx context.Context, request resource.UpdateReq
 
 	conn := r.Meta().NetworkMonitorClient(ctx)
 
+	// Tags-only changes are made by the transparent tagging interceptor; the monitor is neither updated nor waited on.
 	if !plan.AggregationPeriod.Equal(state.AggregationPeriod) {
+		name := plan.ID.ValueString()
+		timeout := r.UpdateTimeout(ctx, plan.Timeouts)
+
+		// Capture the monitor and probe states so that deactivation caused by the update can be reported.
+		before, err := findMonitorByName(ctx, conn, name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Network Monitor Monitor (%s)", name), err.Error())
+
+			return
+		}
+
 		input := &networkmonitor.UpdateMonitorInput{}
 		response.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
 		if response.Diagnostics.HasError() {
 			return
 		}
 
-		_, err := conn.UpdateMonitor(ctx, input)
+		_, err = conn.UpdateMonitor(ctx, input)
 
 		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Network Monitor Monitor (%s)", plan.ID.ValueString()), err.Error())
@@ -186,12 +219,35 @@ func (r *monitorResource) Update(ctx context.Context, request resource.UpdateReq
 			return
 		}
 
-		output, err := waitMonitorReady(ctx, conn, plan.ID.ValueString())
+		output, err := waitMonitorReady(ctx, conn, name, timeout)
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Network Monitor Monitor (%s) update", plan.ID.ValueString()), err.Error())
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Network Monitor Monitor (%s) update", name), err.Error())
 
 			return
 		}
+
+		if before.State == awstypes.MonitorStateActive && output.State != awstypes.MonitorStateActive {
+			response.Diagnostics.AddWarning(
+				fmt.Sprintf("CloudWatch Network Monitor Monitor (%s) not active after update", name),
+				fmt.Sprintf("The monitor was %s before aggregation_period was changed and is now %s. Probes may not be publishing metrics.", before.State, output.State),
+			)
+		}
+
+		deactivated, err := waitMonitorProbesReadyAfterUpdate(ctx, conn, name, before.Probes, timeout)
+
+		if err != nil {
+			response.Diagnostics.AddWarning(
+				fmt.Sprintf("verifying CloudWatch Network Monitor Monitor (%s) probes after update", name),
+				err.Error(),
+			)
+		}
+
+		if len(deactivated) > 0 {
+			response.Diagnostics.AddWarning(
+				fmt.Sprintf("CloudWatch Network Monitor Monitor (%s) probes deactivated after update", name),
+				monitorProbesDeactivatedDetail(deactivated),
+			)
+		}
 		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &plan)...)
 		if response.Diagnostics.HasError() {
 			return
@@ -224,13 +280,60 @@ func (r *monitorResource) Delete(ctx context.Context, request resource.DeleteReq
 		return
 	}
 
-	if _, err := waitMonitorDeleted(ctx, conn, data.MonitorName.ValueString()); err != nil {
+	if _, err := waitMonitorDeleted(ctx, conn, data.MonitorName.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Network Monitor Monitor (%s) delete", data.MonitorName.ValueString()), err.Error())
 
 		return
 	}
 }
 
+func (r *monitorResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// Import by monitor name.
+	if request.ID != "" && !arn.IsARN(request.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+
+		return
+	}
+
+	r.WithImportByIdentity.ImportState(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var monitorARN types.String
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(names.AttrARN), &monitorARN)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name, err := monitorNameFromARN(monitorARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing import ARN", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), name)...)
+}
+
+// monitorNameFromARN returns the monitor name from an ARN of the form arn:${Partition}:networkmonitor:${Region}:${Account}:monitor/${MonitorName}.
+func monitorNameFromARN(s string) (string, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	name, found := strings.CutPrefix(parsedARN.Resource, "monitor/")
+
+	if !found || name == "" {
+		return "", fmt.Errorf("unexpected format for ARN (%s), expected arn:${Partition}:networkmonitor:${Region}:${Account}:monitor/${MonitorName}", s)
+	}
+
+	return name, nil
+}
+
 func findMonitorByName(ctx context.Context, conn *networkmonitor.Client, name string) (*networkmonitor.GetMonitorOutput, error) {
 	input := &networkmonitor.GetMonitorInput{
 		MonitorName: aws.String(name),
@@ -272,10 +375,7 @@ func statusMonitor(ctx context.Context, conn *networkmonitor.Client, name string
 	}
 }
 
-func waitMonitorReady(ctx context.Context, conn *networkmonitor.Client, name string) (*networkmonitor.GetMonitorOutput, error) {
-	const (
-		timeout = time.Minute * 10
-	)
+func waitMonitorReady(ctx context.Context, conn *networkmonitor.Client, name string, timeout time.Duration) (*networkmonitor.GetMonitorOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.MonitorStatePending),
 		Target:     enum.Slice(awstypes.MonitorStateActive, awstypes.MonitorStateInactive),
@@ -293,10 +393,47 @@ func waitMonitorReady(ctx context.Context, conn *networkmonitor.Client, name str
 	return nil, err
 }
 
-func waitMonitorDeleted(ctx context.Context, conn *networkmonitor.Client, name string) (*networkmonitor.GetMonitorOutput, error) {
-	const (
-		timeout = time.Minute * 10
-	)
+// waitMonitorProbesReadyAfterUpdate waits for each of the monitor's probes that was ACTIVE before an update
+// to settle and returns those that are now INACTIVE.
+func waitMonitorProbesReadyAfterUpdate(ctx context.Context, conn *networkmonitor.Client, monitorName string, before []awstypes.Probe, timeout time.Duration) ([]awstypes.Probe, error) {
+	var deactivated []awstypes.Probe
+
+	for _, probe := range before {
+		if probe.State != awstypes.ProbeStateActive {
+			continue
+		}
+
+		probeID := aws.ToString(probe.ProbeId)
+		output, err := waitProbeReady(ctx, conn, monitorName, probeID, timeout)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return deactivated, fmt.Errorf("waiting for probe (%s): %w", probeID, err)
+		}
+
+		if output.State == awstypes.ProbeStateInactive {
+			deactivated = append(deactivated, probe)
+		}
+	}
+
+	return deactivated, nil
+}
+
+func monitorProbesDeactivatedDetail(probes []awstypes.Probe) string {
+	var sb strings.Builder
+
+	sb.WriteString("The following probes were ACTIVE before aggregation_period was changed and are now INACTIVE. They will not publish metrics until reactivated:\n")
+	for _, probe := range probes {
+		fmt.Fprintf(&sb, "\n  - %s (destination: %s, protocol: %s)", aws.ToString(probe.ProbeId), aws.ToString(probe.Destination), probe.Protocol)
+	}
+
+	return sb.String()
+}
+
+func waitMonitorDeleted(ctx context.Context, conn *networkmonitor.Client, name string, timeout time.Duration) (*networkmonitor.GetMonitorOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.MonitorStateDeleting, awstypes.MonitorStateActive, awstypes.MonitorStateInactive),
 		Target:     []string{},
@@ -316,12 +453,13 @@ func waitMonitorDeleted(ctx context.Context, conn *networkmonitor.Client, name s
 
 type monitorResourceModel struct {
 	framework.WithRegionModel
-	AggregationPeriod types.Int64  `tfsdk:"aggregation_period"`
-	ID                types.String `tfsdk:"id"`
-	MonitorARN        types.String `tfsdk:"arn"`
-	MonitorName       types.String `tfsdk:"monitor_name"`
-	Tags              tftags.Map   `tfsdk:"tags"`
-	TagsAll           tftags.Map   `tfsdk:"tags_all"`
+	AggregationPeriod types.Int64    `tfsdk:"aggregation_period"`
+	ID                types.String   `tfsdk:"id"`
+	MonitorARN        types.String   `tfsdk:"arn"`
+	MonitorName       types.String   `tfsdk:"monitor_name"`
+	Tags              tftags.Map     `tfsdk:"tags"`
+	TagsAll           tftags.Map     `tfsdk:"tags_all"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (model *monitorResourceModel) InitFromID() error {
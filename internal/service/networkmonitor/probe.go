@@ -12,14 +12,17 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkmonitor"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmonitor/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -32,18 +35,30 @@ import (
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_networkmonitor_probe", name="Probe")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("monitor_name")
+// @IdentityAttribute("probe_id")
+// @ImportIDHandler("probeImportID", setIDAttribute=true)
+// @Testing(useVCR=true)
 func newProbeResource(context.Context) (resource.ResourceWithConfigure, error) {
-	return &probeResource{}, nil
+	r := &probeResource{}
+
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultUpdateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
 }
 
 type probeResource struct {
 	framework.ResourceWithModel[probeResourceModel]
-	framework.WithImportByID
+	framework.WithImportByIdentity
+	framework.WithTimeouts
 }
 
 func (r *probeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -104,6 +119,17 @@ func (r *probeResource) Schema(ctx context.Context, request resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProbeState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive)...),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 			names.AttrVPCID: schema.StringAttribute{
@@ -113,6 +139,13 @@ func (r *probeResource) Schema(ctx context.Context, request resource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -156,7 +189,8 @@ func (r *probeResource) Create(ctx context.Context, request resource.CreateReque
 	}
 	data.ID = types.StringValue(id)
 
-	outputGP, err := waitProbeReady(ctx, conn, data.MonitorName.ValueString(), data.ProbeID.ValueString())
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+	outputGP, err := waitProbeReady(ctx, conn, data.MonitorName.ValueString(), data.ProbeID.ValueString(), createTimeout)
 
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Network Monitor Probe (%s) create", data.ID.ValueString()), err.Error())
@@ -164,11 +198,37 @@ func (r *probeResource) Create(ctx context.Context, request resource.CreateReque
 		return
 	}
 
+	// Probes can't be created in the INACTIVE state.
+	if state := data.State.ValueEnum(); state != "" && state != outputGP.State {
+		input := &networkmonitor.UpdateProbeInput{
+			MonitorName: fwflex.StringFromFramework(ctx, data.MonitorName),
+			ProbeId:     fwflex.StringFromFramework(ctx, data.ProbeID),
+			State:       state,
+		}
+
+		_, err := conn.UpdateProbe(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudWatch Network Monitor Probe (%s) state", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		outputGP, err = waitProbeStateUpdated(ctx, conn, data.MonitorName.ValueString(), data.ProbeID.ValueString(), state, createTimeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Network Monitor Probe (%s) state update", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
 	// Set values for unknowns.
 	data.AddressFamily = fwtypes.StringEnumValue(outputGP.AddressFamily)
 	if data.PacketSize.IsUnknown() {
 		data.PacketSize = fwflex.Int32ToFrameworkInt64(ctx, outputGP.PacketSize)
 	}
+	data.State = fwtypes.StringEnumValue(outputGP.State)
 	data.VpcID = fwflex.StringToFramework(ctx, outputGP.VpcId)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -228,10 +288,12 @@ func (r *probeResource) Update(ctx context.Context, request resource.UpdateReque
 
 	conn := r.Meta().NetworkMonitorClient(ctx)
 
+	// Tags-only changes are made by the transparent tagging interceptor; the probe is neither updated nor waited on.
 	if !new.Destination.Equal(old.Destination) ||
 		!new.DestinationPort.Equal(old.DestinationPort) ||
 		!new.PacketSize.Equal(old.PacketSize) ||
-		!new.Protocol.Equal(old.Protocol) {
+		!new.Protocol.Equal(old.Protocol) ||
+		!new.State.Equal(old.State) {
 		input := &networkmonitor.UpdateProbeInput{
 			MonitorName: fwflex.StringFromFramework(ctx, new.MonitorName),
 			ProbeId:     fwflex.StringFromFramework(ctx, new.ProbeID),
@@ -249,6 +311,9 @@ func (r *probeResource) Update(ctx context.Context, request resource.UpdateReque
 		if !new.Protocol.Equal(old.Protocol) {
 			input.Protocol = new.Protocol.ValueEnum()
 		}
+		if !new.State.Equal(old.State) {
+			input.State = new.State.ValueEnum()
+		}
 
 		_, err := conn.UpdateProbe(ctx, input)
 
@@ -258,7 +323,7 @@ func (r *probeResource) Update(ctx context.Context, request resource.UpdateReque
 			return
 		}
 
-		outputGP, err := waitProbeReady(ctx, conn, new.MonitorName.ValueString(), new.ProbeID.ValueString())
+		outputGP, err := waitProbeUpdated(ctx, conn, input, r.UpdateTimeout(ctx, new.Timeouts))
 
 		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Network Monitor Probe (%s) update", new.ID.ValueString()), err.Error())
@@ -268,6 +333,10 @@ func (r *probeResource) Update(ctx context.Context, request resource.UpdateReque
 
 		// Set values for unknowns.
 		new.AddressFamily = fwtypes.StringEnumValue(outputGP.AddressFamily)
+		if new.PacketSize.IsUnknown() {
+			new.PacketSize = fwflex.Int32ToFrameworkInt64(ctx, outputGP.PacketSize)
+		}
+		new.State = fwtypes.StringEnumValue(outputGP.State)
 	} else {
 		new.AddressFamily = old.AddressFamily
 	}
@@ -275,6 +344,28 @@ func (r *probeResource) Update(ctx context.Context, request resource.UpdateReque
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
+func (r *probeResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state probeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The address family is derived from the destination, so other changes (e.g. to tags) must not show it as unknown.
+	if plan.Destination.Equal(state.Destination) && plan.AddressFamily.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("address_family"), state.AddressFamily)...)
+	}
+}
+
 func (r *probeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data probeResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
@@ -299,7 +390,7 @@ func (r *probeResource) Delete(ctx context.Context, request resource.DeleteReque
 		return
 	}
 
-	if _, err := waitProbeDeleted(ctx, conn, data.MonitorName.ValueString(), data.ProbeID.ValueString()); err != nil {
+	if _, err := waitProbeDeleted(ctx, conn, data.MonitorName.ValueString(), data.ProbeID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudWatch Network Monitor Probe (%s) delete", data.ID.ValueString()), err.Error())
 
 		return
@@ -332,6 +423,18 @@ func findProbeByTwoPartKey(ctx context.Context, conn *networkmonitor.Client, mon
 	return output, nil
 }
 
+// findProbesByMonitorName returns all of a monitor's probes.
+// The API has no ListProbes operation; GetMonitor returns every probe in a single response.
+func findProbesByMonitorName(ctx context.Context, conn *networkmonitor.Client, monitorName string) ([]awstypes.Probe, error) {
+	output, err := findMonitorByName(ctx, conn, monitorName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Probes, nil
+}
+
 func statusProbe(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findProbeByTwoPartKey(ctx, conn, monitorName, probeID)
@@ -348,10 +451,31 @@ func statusProbe(ctx context.Context, conn *networkmonitor.Client, monitorName,
 	}
 }
 
-func waitProbeReady(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string) (*networkmonitor.GetProbeOutput, error) {
-	const (
-		timeout = time.Minute * 15
-	)
+// statusProbeUpdated reports a probe as pending until GetProbe reflects every value sent in the UpdateProbe request.
+func statusProbeUpdated(ctx context.Context, conn *networkmonitor.Client, input *networkmonitor.UpdateProbeInput) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findProbeByTwoPartKey(ctx, conn, aws.ToString(input.MonitorName), aws.ToString(input.ProbeId))
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if (input.Destination != nil && aws.ToString(output.Destination) != aws.ToString(input.Destination)) ||
+			(input.DestinationPort != nil && aws.ToInt32(output.DestinationPort) != aws.ToInt32(input.DestinationPort)) ||
+			(input.PacketSize != nil && aws.ToInt32(output.PacketSize) != aws.ToInt32(input.PacketSize)) ||
+			(input.Protocol != "" && output.Protocol != input.Protocol) {
+			return output, string(awstypes.ProbeStatePending), nil
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitProbeReady(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string, timeout time.Duration) (*networkmonitor.GetProbeOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ProbeStatePending),
 		Target:     enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive),
@@ -369,10 +493,57 @@ func waitProbeReady(ctx context.Context, conn *networkmonitor.Client, monitorNam
 	return nil, err
 }
 
-func waitProbeDeleted(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string) (*networkmonitor.GetProbeOutput, error) {
-	const (
-		timeout = time.Minute * 15
-	)
+func waitProbeStateUpdated(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string, state awstypes.ProbeState, timeout time.Duration) (*networkmonitor.GetProbeOutput, error) {
+	// The probe may briefly report its previous state after the update request.
+	pending := enum.Slice(awstypes.ProbeStatePending, awstypes.ProbeStateInactive)
+	if state == awstypes.ProbeStateInactive {
+		pending = enum.Slice(awstypes.ProbeStatePending, awstypes.ProbeStateActive)
+	}
+	stateConf := &retry.StateChangeConf{
+		Pending:    pending,
+		Target:     enum.Slice(state),
+		Refresh:    statusProbe(ctx, conn, monitorName, probeID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetProbeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProbeUpdated(ctx context.Context, conn *networkmonitor.Client, input *networkmonitor.UpdateProbeInput, timeout time.Duration) (*networkmonitor.GetProbeOutput, error) {
+	pending := enum.Slice(awstypes.ProbeStatePending)
+	target := enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive)
+	switch input.State {
+	case awstypes.ProbeStateActive:
+		pending, target = enum.Slice(awstypes.ProbeStatePending, awstypes.ProbeStateInactive), enum.Slice(awstypes.ProbeStateActive)
+	case awstypes.ProbeStateInactive:
+		pending, target = enum.Slice(awstypes.ProbeStatePending, awstypes.ProbeStateActive), enum.Slice(awstypes.ProbeStateInactive)
+	}
+	stateConf := &retry.StateChangeConf{
+		Pending:                   pending,
+		Target:                    target,
+		Refresh:                   statusProbeUpdated(ctx, conn, input),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetProbeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProbeDeleted(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string, timeout time.Duration) (*networkmonitor.GetProbeOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive, awstypes.ProbeStateDeleting),
 		Target:     []string{},
@@ -402,8 +573,10 @@ type probeResourceModel struct {
 	ProbeID         types.String                               `tfsdk:"probe_id"`
 	Protocol        fwtypes.StringEnum[awstypes.Protocol]      `tfsdk:"protocol"`
 	SourceARN       fwtypes.ARN                                `tfsdk:"source_arn"`
+	State           fwtypes.StringEnum[awstypes.ProbeState]    `tfsdk:"state"`
 	Tags            tftags.Map                                 `tfsdk:"tags"`
 	TagsAll         tftags.Map                                 `tfsdk:"tags_all"`
+	Timeouts        timeouts.Value                             `tfsdk:"timeouts"`
 	VpcID           types.String                               `tfsdk:"vpc_id"`
 }
 
@@ -433,3 +606,36 @@ func (m *probeResourceModel) setID() (string, error) {
 
 	return flex.FlattenResourceId(parts, probeResourceIDPartCount, false)
 }
+
+var (
+	_ inttypes.ImportIDParser           = probeImportID{}
+	_ inttypes.FrameworkImportIDCreator = probeImportID{}
+)
+
+type probeImportID struct{}
+
+func (probeImportID) Parse(id string) (string, map[string]string, error) {
+	parts, err := flex.ExpandResourceId(id, probeResourceIDPartCount, false)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]string{
+		"monitor_name": parts[0],
+		"probe_id":     parts[1],
+	}
+
+	return id, result, nil
+}
+
+func (probeImportID) Create(ctx context.Context, state tfsdk.State) string {
+	var monitorName, probeID types.String
+
+	state.GetAttribute(ctx, path.Root("monitor_name"), &monitorName)
+	state.GetAttribute(ctx, path.Root("probe_id"), &probeID)
+
+	id, _ := flex.FlattenResourceId([]string{monitorName.ValueString(), probeID.ValueString()}, probeResourceIDPartCount, false)
+
+	return id
+}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// @FrameworkDataSource("aws_networkmonitor_probe_metrics", name="Probe Metrics")
+func newProbeMetricsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &probeMetricsDataSource{}, nil
+}
+
+const (
+	// Default period and lookback period, in seconds, of the probe metric statistics.
+	probeMetricsDefaultPeriod         = 300
+	probeMetricsDefaultLookbackPeriod = 3600
+
+	probeMetricsNamespace = "AWS/NetworkMonitor"
+
+	probeMetricPacketLoss    = "PacketLoss"
+	probeMetricRoundTripTime = "RTT"
+)
+
+var (
+	// Statistics returned for each probe metric.
+	probeMetricStatistics = []string{"Average", "Maximum", "Minimum", "p99"}
+)
+
+type probeMetricsDataSource struct {
+	framework.DataSourceWithModel[probeMetricsDataSourceModel]
+}
+
+func (d *probeMetricsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"lookback_period": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(300, 14*24*60*60),
+				},
+			},
+			"monitor_name": schema.StringAttribute{
+				Required: true,
+			},
+			"packet_loss": framework.DataSourceComputedListOfObjectAttribute[probeMetricStatisticsModel](ctx),
+			"period": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 24*60*60),
+					fwvalidators.Int64MultipleOf(60),
+				},
+			},
+			"probe_id": schema.StringAttribute{
+				Required: true,
+			},
+			"round_trip_time": framework.DataSourceComputedListOfObjectAttribute[probeMetricStatisticsModel](ctx),
+		},
+	}
+}
+
+func (d *probeMetricsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data probeMetricsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	monitorName, probeID := data.MonitorName.ValueString(), data.ProbeID.ValueString()
+	if _, err := findProbeByTwoPartKey(ctx, d.Meta().NetworkMonitorClient(ctx), monitorName, probeID); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Network Monitor Probe (%s)", probeID), err.Error())
+
+		return
+	}
+
+	period, lookbackPeriod := int64(probeMetricsDefaultPeriod), int64(probeMetricsDefaultLookbackPeriod)
+	if !data.Period.IsNull() {
+		period = data.Period.ValueInt64()
+	}
+	if !data.LookbackPeriod.IsNull() {
+		lookbackPeriod = data.LookbackPeriod.ValueInt64()
+	}
+
+	now := time.Now()
+	input := cloudwatch.GetMetricDataInput{
+		EndTime:           aws.Time(now),
+		MetricDataQueries: probeMetricDataQueries(monitorName, probeID, int32(period)),
+		ScanBy:            cwtypes.ScanByTimestampDescending,
+		StartTime:         aws.Time(now.Add(-time.Duration(lookbackPeriod) * time.Second)),
+	}
+	results, err := findMetricDataResults(ctx, d.Meta().CloudWatchClient(ctx), &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Network Monitor Probe (%s) metrics", probeID), err.Error())
+
+		return
+	}
+
+	data.PacketLoss = flattenProbeMetricStatistics(ctx, latestProbeMetricStatistics(results, probeMetricPacketLoss))
+	data.RoundTripTime = flattenProbeMetricStatistics(ctx, latestProbeMetricStatistics(results, probeMetricRoundTripTime))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// probeMetricDataQueryID returns the ID of the query for the specified probe metric statistic, e.g. "rtt_p99".
+func probeMetricDataQueryID(metricName, statistic string) string {
+	return strings.ToLower(metricName + "_" + statistic)
+}
+
+// probeMetricDataQueries returns the queries for each statistic of each probe metric.
+// Network Monitor publishes probe metrics with the monitor name and probe ID as dimensions.
+func probeMetricDataQueries(monitorName, probeID string, period int32) []cwtypes.MetricDataQuery {
+	var queries []cwtypes.MetricDataQuery
+
+	for _, metricName := range []string{probeMetricPacketLoss, probeMetricRoundTripTime} {
+		for _, statistic := range probeMetricStatistics {
+			queries = append(queries, cwtypes.MetricDataQuery{
+				Id: aws.String(probeMetricDataQueryID(metricName, statistic)),
+				MetricStat: &cwtypes.MetricStat{
+					Metric: &cwtypes.Metric{
+						Dimensions: []cwtypes.Dimension{
+							{
+								Name:  aws.String("Monitor"),
+								Value: aws.String(monitorName),
+							},
+							{
+								Name:  aws.String("Probe"),
+								Value: aws.String(probeID),
+							},
+						},
+						MetricName: aws.String(metricName),
+						Namespace:  aws.String(probeMetricsNamespace),
+					},
+					Period: aws.Int32(period),
+					Stat:   aws.String(statistic),
+				},
+			})
+		}
+	}
+
+	return queries
+}
+
+// findMetricDataResults returns the results of the metric data queries, with each query's data points from all pages combined.
+func findMetricDataResults(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.GetMetricDataInput) ([]cwtypes.MetricDataResult, error) {
+	var output []cwtypes.MetricDataResult
+
+	pages := cloudwatch.NewGetMetricDataPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricDataResults {
+			i := slices.IndexFunc(output, func(e cwtypes.MetricDataResult) bool {
+				return aws.ToString(e.Id) == aws.ToString(v.Id)
+			})
+
+			if i == -1 {
+				output = append(output, v)
+				continue
+			}
+
+			output[i].Timestamps = append(output[i].Timestamps, v.Timestamps...)
+			output[i].Values = append(output[i].Values, v.Values...)
+		}
+	}
+
+	return output, nil
+}
+
+// probeMetricStatisticsValues are a probe metric's statistics for a single period.
+type probeMetricStatisticsValues struct {
+	timestamp time.Time
+	values    map[string]float64
+}
+
+// latestProbeMetricStatistics returns the specified probe metric's statistics for the most recent period with an average.
+// nil is returned if the metric has no data points.
+func latestProbeMetricStatistics(results []cwtypes.MetricDataResult, metricName string) *probeMetricStatisticsValues {
+	var output *probeMetricStatisticsValues
+
+	for _, statistic := range probeMetricStatistics {
+		id := probeMetricDataQueryID(metricName, statistic)
+		i := slices.IndexFunc(results, func(v cwtypes.MetricDataResult) bool {
+			return aws.ToString(v.Id) == id
+		})
+
+		if i == -1 {
+			continue
+		}
+
+		result := results[i]
+
+		if output == nil {
+			// The average determines the period; other statistics are matched to it.
+			if statistic != probeMetricStatistics[0] || len(result.Timestamps) == 0 {
+				return nil
+			}
+
+			latest := 0
+			for j, t := range result.Timestamps {
+				if t.After(result.Timestamps[latest]) {
+					latest = j
+				}
+			}
+
+			output = &probeMetricStatisticsValues{
+				timestamp: result.Timestamps[latest],
+				values:    map[string]float64{statistic: result.Values[latest]},
+			}
+
+			continue
+		}
+
+		if j := slices.IndexFunc(result.Timestamps, output.timestamp.Equal); j != -1 {
+			output.values[statistic] = result.Values[j]
+		}
+	}
+
+	return output
+}
+
+func flattenProbeMetricStatistics(ctx context.Context, apiObject *probeMetricStatisticsValues) fwtypes.ListNestedObjectValueOf[probeMetricStatisticsModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[probeMetricStatisticsModel](ctx)
+	}
+
+	value := func(statistic string) types.Float64 {
+		if v, ok := apiObject.values[statistic]; ok {
+			return types.Float64Value(v)
+		}
+
+		return types.Float64Null()
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &probeMetricStatisticsModel{
+		Average:   value("Average"),
+		Maximum:   value("Maximum"),
+		Minimum:   value("Minimum"),
+		P99:       value("p99"),
+		Timestamp: fwflex.TimeToFramework(ctx, &apiObject.timestamp),
+	})
+}
+
+type probeMetricsDataSourceModel struct {
+	framework.WithRegionModel
+	LookbackPeriod types.Int64                                                 `tfsdk:"lookback_period"`
+	MonitorName    types.String                                                `tfsdk:"monitor_name"`
+	PacketLoss     fwtypes.ListNestedObjectValueOf[probeMetricStatisticsModel] `tfsdk:"packet_loss"`
+	Period         types.Int64                                                 `tfsdk:"period"`
+	ProbeID        types.String                                                `tfsdk:"probe_id"`
+	RoundTripTime  fwtypes.ListNestedObjectValueOf[probeMetricStatisticsModel] `tfsdk:"round_trip_time"`
+}
+
+type probeMetricStatisticsModel struct {
+	Average   types.Float64     `tfsdk:"average"`
+	Maximum   types.Float64     `tfsdk:"maximum"`
+	Minimum   types.Float64     `tfsdk:"minimum"`
+	P99       types.Float64     `tfsdk:"p99"`
+	Timestamp timetypes.RFC3339 `tfsdk:"timestamp"`
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfnetworkmonitor "github.com/hashicorp/terraform-provider-aws/internal/service/networkmonitor"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestLatestProbeMetricStatistics(t *testing.T) {
+	t.Parallel()
+
+	t1 := time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)
+	t2 := t1.Add(5 * time.Minute)
+
+	testcases := map[string]struct {
+		results       []cwtypes.MetricDataResult
+		metricName    string
+		wantTimestamp time.Time
+		wantValues    map[string]float64
+		wantOK        bool
+	}{
+		"no results": {
+			metricName: "RTT",
+		},
+		"no data points": {
+			results: []cwtypes.MetricDataResult{
+				{Id: aws.String("rtt_average")},
+				{Id: aws.String("rtt_maximum")},
+			},
+			metricName: "RTT",
+		},
+		"latest period": {
+			results: []cwtypes.MetricDataResult{
+				{Id: aws.String("rtt_average"), Timestamps: []time.Time{t1, t2}, Values: []float64{100, 200}},
+				{Id: aws.String("rtt_maximum"), Timestamps: []time.Time{t2, t1}, Values: []float64{250, 150}},
+				{Id: aws.String("rtt_minimum"), Timestamps: []time.Time{t1}, Values: []float64{50}},
+				{Id: aws.String("rtt_p99"), Timestamps: []time.Time{t2}, Values: []float64{240}},
+				{Id: aws.String("packetloss_average"), Timestamps: []time.Time{t2}, Values: []float64{1}},
+			},
+			metricName:    "RTT",
+			wantTimestamp: t2,
+			wantValues: map[string]float64{
+				"Average": 200,
+				"Maximum": 250,
+				"p99":     240,
+			},
+			wantOK: true,
+		},
+		"other metric": {
+			results: []cwtypes.MetricDataResult{
+				{Id: aws.String("rtt_average"), Timestamps: []time.Time{t1}, Values: []float64{100}},
+			},
+			metricName: "PacketLoss",
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			timestamp, values, ok := tfnetworkmonitor.LatestProbeMetricStatistics(testcase.results, testcase.metricName)
+
+			if got, want := ok, testcase.wantOK; got != want {
+				t.Fatalf("ok = %t, want %t", got, want)
+			}
+
+			if got, want := timestamp, testcase.wantTimestamp; !got.Equal(want) {
+				t.Errorf("timestamp = %s, want %s", got, want)
+			}
+
+			if diff := cmp.Diff(values, testcase.wantValues); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccNetworkMonitorProbeMetricsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpc awstypes.Vpc
+	dataSourceName := "data.aws_networkmonitor_probe_metrics.test"
+	probeResourceName := "aws_networkmonitor_probe.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProbeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProbeMetricsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "monitor_name", probeResourceName, "monitor_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "probe_id", probeResourceName, "probe_id"),
+					// A new probe has no metrics yet.
+					resource.TestCheckResourceAttr(dataSourceName, "packet_loss.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "round_trip_time.#", "0"),
+				),
+			},
+			{ // nosemgrep:ci.test-config-funcs-correct-form
+				Config: acctest.ConfigVPCWithSubnets(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(ctx, vpcResourceName, &vpc),
+					testAccCheckProbeDeleteSecurityGroup(ctx, rName, &vpc),
+				),
+			},
+		},
+	})
+}
+
+func testAccProbeMetricsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProbeConfig_basic(rName, "10.0.0.1"), `
+data "aws_networkmonitor_probe_metrics" "test" {
+  monitor_name    = aws_networkmonitor_probe.test.monitor_name
+  probe_id        = aws_networkmonitor_probe.test.probe_id
+  period          = 60
+  lookback_period = 600
+}
+`)
+}
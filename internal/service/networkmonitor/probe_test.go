@@ -10,9 +10,9 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -29,18 +29,18 @@ func TestAccNetworkMonitorProbe_basic(t *testing.T) {
 	var vpc awstypes.Vpc
 	resourceName := "aws_networkmonitor_probe.test"
 	vpcResourceName := "aws_vpc.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckProbeDestroy(ctx),
+		CheckDestroy:             testAccCheckProbeDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProbeConfig_basic(rName, "10.0.0.1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckProbeExists(ctx, resourceName),
+					testAccCheckProbeExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "address_family"),
 					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "networkmonitor", "probe/{probe_id}"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "10.0.0.1"),
@@ -48,6 +48,7 @@ func TestAccNetworkMonitorProbe_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "packet_size"),
 					resource.TestCheckResourceAttrSet(resourceName, "probe_id"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrProtocol, "ICMP"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ACTIVE"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrVPCID),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -76,18 +77,18 @@ func TestAccNetworkMonitorProbe_disappears(t *testing.T) {
 	var vpc awstypes.Vpc
 	resourceName := "aws_networkmonitor_probe.test"
 	vpcResourceName := "aws_vpc.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckProbeDestroy(ctx),
+		CheckDestroy:             testAccCheckProbeDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProbeConfig_basic(rName, "10.0.0.1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckProbeExists(ctx, resourceName),
+					testAccCheckProbeExists(ctx, t, resourceName),
 					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfnetworkmonitor.ResourceProbe, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -108,18 +109,18 @@ func TestAccNetworkMonitorProbe_update(t *testing.T) {
 	var vpc awstypes.Vpc
 	resourceName := "aws_networkmonitor_probe.test"
 	vpcResourceName := "aws_vpc.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(ctx, t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckProbeDestroy(ctx),
+		CheckDestroy:             testAccCheckProbeDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProbeConfig_full(rName, "10.0.0.1", 8080, 256),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckProbeExists(ctx, resourceName),
+					testAccCheckProbeExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "address_family"),
 					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "networkmonitor", "probe/{probe_id}"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "10.0.0.1"),
@@ -137,8 +138,13 @@ func TestAccNetworkMonitorProbe_update(t *testing.T) {
 			},
 			{
 				Config: testAccProbeConfig_full(rName, "10.0.0.2", 8443, 512),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckProbeExists(ctx, resourceName),
+					testAccCheckProbeExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "address_family"),
 					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "networkmonitor", "probe/{probe_id}"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "10.0.0.2"),
@@ -149,6 +155,20 @@ func TestAccNetworkMonitorProbe_update(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrVPCID),
 				),
 			},
+			{
+				Config: testAccProbeConfig_basic(rName, "10.0.0.2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProbeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDestination, "10.0.0.2"),
+					resource.TestCheckNoResourceAttr(resourceName, "destination_port"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrProtocol, "ICMP"),
+				),
+			},
 			{ // nosemgrep:ci.test-config-funcs-correct-form
 				Config: acctest.ConfigVPCWithSubnets(rName, 1),
 				Check: resource.ComposeTestCheckFunc(
@@ -160,9 +180,111 @@ func TestAccNetworkMonitorProbe_update(t *testing.T) {
 	})
 }
 
-func testAccCheckProbeDestroy(ctx context.Context) resource.TestCheckFunc {
+func TestAccNetworkMonitorProbe_state(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpc awstypes.Vpc
+	resourceName := "aws_networkmonitor_probe.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProbeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProbeConfig_state(rName, "INACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProbeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "INACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProbeConfig_state(rName, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProbeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccProbeConfig_basic(rName, "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProbeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccProbeConfig_state(rName, "INACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProbeExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "INACTIVE"),
+				),
+			},
+			{ // nosemgrep:ci.test-config-funcs-correct-form
+				Config: acctest.ConfigVPCWithSubnets(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(ctx, vpcResourceName, &vpc),
+					testAccCheckProbeDeleteSecurityGroup(ctx, rName, &vpc),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkMonitorProbe_tagsOnlyUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpc awstypes.Vpc
+	resourceName := "aws_networkmonitor_probe.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProbeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProbeConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProbeExists(ctx, t, resourceName),
+				),
+			},
+			{
+				Config: testAccProbeConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1Updated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("address_family"), knownvalue.StringExact("IPV4")),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrState), knownvalue.StringExact("ACTIVE")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1Updated),
+					})),
+				},
+			},
+			{ // nosemgrep:ci.test-config-funcs-correct-form
+				Config: acctest.ConfigVPCWithSubnets(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(ctx, vpcResourceName, &vpc),
+					testAccCheckProbeDeleteSecurityGroup(ctx, rName, &vpc),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProbeDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkMonitorClient(ctx)
+		conn := acctest.ProviderMeta(ctx, t).NetworkMonitorClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_networkmonitor_probe" {
@@ -186,14 +308,14 @@ func testAccCheckProbeDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
-func testAccCheckProbeExists(ctx context.Context, n string) resource.TestCheckFunc {
+func testAccCheckProbeExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkMonitorClient(ctx)
+		conn := acctest.ProviderMeta(ctx, t).NetworkMonitorClient(ctx)
 
 		_, err := tfnetworkmonitor.FindProbeByTwoPartKey(ctx, conn, rs.Primary.Attributes["monitor_name"], rs.Primary.Attributes["probe_id"])
 
@@ -264,3 +386,30 @@ resource "aws_networkmonitor_probe" "test" {
 }
 `, rName, destination, port, packetSize))
 }
+
+func testAccProbeConfig_state(rName, state string) string {
+	return acctest.ConfigCompose(testAccProbeConfig_base(rName), fmt.Sprintf(`
+resource "aws_networkmonitor_probe" "test" {
+  monitor_name = aws_networkmonitor_monitor.test.monitor_name
+  destination  = "10.0.0.1"
+  protocol     = "ICMP"
+  source_arn   = aws_subnet.test[0].arn
+  state        = %[2]q
+}
+`, rName, state))
+}
+
+func testAccProbeConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccProbeConfig_base(rName), fmt.Sprintf(`
+resource "aws_networkmonitor_probe" "test" {
+  monitor_name = aws_networkmonitor_monitor.test.monitor_name
+  destination  = "10.0.0.1"
+  protocol     = "ICMP"
+  source_arn   = aws_subnet.test[0].arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
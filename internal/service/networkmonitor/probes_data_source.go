@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmonitor/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_networkmonitor_probes", name="Probes")
+func newProbesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &probesDataSource{}, nil
+}
+
+type probesDataSource struct {
+	framework.DataSourceWithModel[probesDataSourceModel]
+}
+
+func (d *probesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"monitor_name": schema.StringAttribute{
+				Required: true,
+			},
+			"probes": framework.DataSourceComputedListOfObjectAttribute[probeSummaryModel](ctx),
+		},
+	}
+}
+
+func (d *probesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data probesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().NetworkMonitorClient(ctx)
+
+	monitorName := data.MonitorName.ValueString()
+	output, err := findProbesByMonitorName(ctx, conn, monitorName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Network Monitor Monitor (%s) probes", monitorName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Probes)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type probesDataSourceModel struct {
+	framework.WithRegionModel
+	MonitorName types.String                                       `tfsdk:"monitor_name"`
+	Probes      fwtypes.ListNestedObjectValueOf[probeSummaryModel] `tfsdk:"probes"`
+}
+
+type probeSummaryModel struct {
+	AddressFamily   fwtypes.StringEnum[awstypes.AddressFamily] `tfsdk:"address_family"`
+	Destination     types.String                               `tfsdk:"destination"`
+	DestinationPort types.Int64                                `tfsdk:"destination_port"`
+	PacketSize      types.Int64                                `tfsdk:"packet_size"`
+	ProbeARN        types.String                               `tfsdk:"arn"`
+	ProbeID         types.String                               `tfsdk:"probe_id"`
+	Protocol        fwtypes.StringEnum[awstypes.Protocol]      `tfsdk:"protocol"`
+	SourceARN       types.String                               `tfsdk:"source_arn"`
+	State           fwtypes.StringEnum[awstypes.ProbeState]    `tfsdk:"state"`
+	VpcID           types.String                               `tfsdk:"vpc_id"`
+}
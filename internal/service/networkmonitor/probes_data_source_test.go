@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkMonitorProbesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpc awstypes.Vpc
+	dataSourceName := "data.aws_networkmonitor_probes.test"
+	probeResourceName := "aws_networkmonitor_probe.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProbeDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProbesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "probes.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "probes.0.arn", probeResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "probes.0.destination", probeResourceName, names.AttrDestination),
+					resource.TestCheckResourceAttrPair(dataSourceName, "probes.0.probe_id", probeResourceName, "probe_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "probes.0.protocol", probeResourceName, names.AttrProtocol),
+					resource.TestCheckResourceAttrPair(dataSourceName, "probes.0.source_arn", probeResourceName, "source_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "probes.0.state"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "probes.0.vpc_id", probeResourceName, names.AttrVPCID),
+				),
+			},
+			{ // nosemgrep:ci.test-config-funcs-correct-form
+				Config: acctest.ConfigVPCWithSubnets(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckVPCExists(ctx, vpcResourceName, &vpc),
+					testAccCheckProbeDeleteSecurityGroup(ctx, rName, &vpc),
+				),
+			},
+		},
+	})
+}
+
+func testAccProbesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProbeConfig_basic(rName, "10.0.0.1"), `
+data "aws_networkmonitor_probes" "test" {
+  monitor_name = aws_networkmonitor_monitor.test.monitor_name
+
+  depends_on = [aws_networkmonitor_probe.test]
+}
+`)
+}
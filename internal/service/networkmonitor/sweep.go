@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmonitor"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_networkmonitor_monitor", sweepMonitors, "aws_networkmonitor_probe")
+	awsv2.Register("aws_networkmonitor_probe", sweepProbes)
+}
+
+func sweepMonitors(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NetworkMonitorClient(ctx)
+	input := &networkmonitor.ListMonitorsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := networkmonitor.NewListMonitorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Monitors {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newMonitorResource, client,
+				framework.NewAttribute("monitor_name", aws.ToString(v.MonitorName))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepProbes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NetworkMonitorClient(ctx)
+	input := &networkmonitor.ListMonitorsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := networkmonitor.NewListMonitorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Monitors {
+			monitorName := aws.ToString(v.MonitorName)
+			probes, err := findProbesByMonitorName(ctx, conn, monitorName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range probes {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newProbeResource, client,
+					framework.NewAttribute("monitor_name", monitorName),
+					framework.NewAttribute("probe_id", aws.ToString(v.ProbeId))))
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
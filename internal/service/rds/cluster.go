@@ -292,6 +292,15 @@ func resourceCluster() *schema.Resource {
 					return
 				},
 			},
+			"final_snapshot_identifier_actual": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"final_snapshot_identifier_suffix_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(finalSnapshotIdentifierSuffixStrategy_Values(), false),
+			},
 			"global_cluster_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -690,6 +699,7 @@ func resourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			finalSnapshotIdentifierActualCustomizeDiff,
 		),
 	}
 }
@@ -698,6 +708,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
+	if err := setFinalSnapshotIdentifierActual(d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating RDS Cluster final snapshot identifier: %s", err)
+	}
+
 	identifier := create.NewNameGenerator(
 		create.WithConfiguredName(d.Get(names.AttrClusterIdentifier).(string)),
 		create.WithConfiguredPrefix(d.Get("cluster_identifier_prefix").(string)),
@@ -1397,6 +1411,28 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any
 		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), true, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
 		}
+
+		if v := modifyDbClusterInput.ServerlessV2ScalingConfiguration; v != nil {
+			if err := waitDBClusterServerlessV2ScalingConfigurationUpdated(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) Serverless v2 scaling configuration update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	// Provisioned (including Serverless v2) clusters that are restored, or whose engine ignores EnableHttpEndpoint on creation,
+	// have the HTTP endpoint enabled separately.
+	if d.Get("enable_http_endpoint").(bool) && d.Get("engine_mode").(string) == engineModeProvisioned {
+		output, err := findDBClusterByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		if !aws.ToBool(output.HttpEndpointEnabled) {
+			if err := enableHTTPEndpointProvisioned(ctx, conn, d.Id(), aws.ToString(output.DBClusterArn), false, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "enabling HTTP endpoint for RDS Cluster (%s): %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
@@ -1544,13 +1580,19 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
+	if d.HasChanges(names.AttrFinalSnapshotIdentifier, "final_snapshot_identifier_suffix_strategy") {
+		if err := setFinalSnapshotIdentifierActual(d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "generating RDS Cluster (%s) final snapshot identifier: %s", d.Id(), err)
+		}
+	}
+
 	// There are two ways to enable the HTTP endpoint: new way and old way.
 	// This is the new way for provisioned engine mode (covers provisioned and serverlessv2).
 	// The old way is modifying the DB cluster and setting the EnableHttpEndpoint field (below).
 	// Both need a wait for update so when it's provisioned it will do old (not necessary but does the wait) & new ways, otherwise just old way.
 	if d.HasChange("enable_http_endpoint") && d.Get("engine_mode").(string) == engineModeProvisioned {
 		o, n := d.GetChange("enable_http_endpoint")
-		if err := enableHTTPEndpointProvisioned(ctx, conn, d.Get(names.AttrARN).(string), o, n); err != nil {
+		if err := enableHTTPEndpointProvisioned(ctx, conn, d.Id(), d.Get(names.AttrARN).(string), o, n, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "enabling HTTP endpoint for RDS Cluster (%s): %s", d.Id(), err)
 		}
 	}
@@ -1577,6 +1619,8 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		names.AttrAllowMajorVersionUpgrade,
 		"delete_automated_backups",
 		names.AttrFinalSnapshotIdentifier,
+		"final_snapshot_identifier_actual",
+		"final_snapshot_identifier_suffix_strategy",
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
@@ -1805,6 +1849,13 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), applyImmediately, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
 		}
+
+		// Serverless v2 scaling configuration changes are applied immediately, but are only reported by the API once they have taken effect.
+		if v := input.ServerlessV2ScalingConfiguration; v != nil {
+			if err := waitDBClusterServerlessV2ScalingConfigurationUpdated(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) Serverless v2 scaling configuration update: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("global_cluster_identifier") {
@@ -1889,8 +1940,8 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	if !skipFinalSnapshot {
-		if v, ok := d.GetOk(names.AttrFinalSnapshotIdentifier); ok {
-			input.FinalDBSnapshotIdentifier = aws.String(v.(string))
+		if v := finalSnapshotIdentifierActual(d); v != "" {
+			input.FinalDBSnapshotIdentifier = aws.String(v)
 		} else {
 			return sdkdiag.AppendErrorf(diags, "RDS Cluster final_snapshot_identifier is required when skip_final_snapshot is false")
 		}
@@ -1976,7 +2027,9 @@ func resourceClusterImport(_ context.Context, d *schema.ResourceData, meta any)
 	return []*schema.ResourceData{d}, nil
 }
 
-func enableHTTPEndpointProvisioned(ctx context.Context, conn *rds.Client, arn string, o, n any) error {
+// enableHTTPEndpointProvisioned enables or disables the HTTP endpoint (RDS Data API) of a provisioned cluster
+// and waits for the cluster to report the new status.
+func enableHTTPEndpointProvisioned(ctx context.Context, conn *rds.Client, id, arn string, o, n any, timeout time.Duration) error {
 	if o == nil {
 		return nil
 	}
@@ -2003,6 +2056,12 @@ func enableHTTPEndpointProvisioned(ctx context.Context, conn *rds.Client, arn st
 		}
 	}
 
+	if o.(bool) != n.(bool) {
+		if err := waitDBClusterHTTPEndpointUpdated(ctx, conn, id, n.(bool), timeout); err != nil {
+			return fmt.Errorf("waiting for update: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -2228,6 +2287,69 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.Client, id string, wait
 	return nil, err
 }
 
+// waitDBClusterServerlessV2ScalingConfigurationUpdated waits until the cluster reports the specified Serverless v2 scaling configuration.
+func waitDBClusterServerlessV2ScalingConfigurationUpdated(ctx context.Context, conn *rds.Client, id string, want *types.ServerlessV2ScalingConfiguration, timeout time.Duration) error {
+	checkFunc := func(ctx context.Context) (bool, error) {
+		output, err := findDBClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		got := output.ServerlessV2ScalingConfiguration
+		if got == nil {
+			return false, nil
+		}
+
+		if want.MaxCapacity != nil && aws.ToFloat64(got.MaxCapacity) != aws.ToFloat64(want.MaxCapacity) {
+			return false, nil
+		}
+
+		if want.MinCapacity != nil && aws.ToFloat64(got.MinCapacity) != aws.ToFloat64(want.MinCapacity) {
+			return false, nil
+		}
+
+		if want.SecondsUntilAutoPause != nil && aws.ToInt32(got.SecondsUntilAutoPause) != aws.ToInt32(want.SecondsUntilAutoPause) {
+			return false, nil
+		}
+
+		return true, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                10 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
+
+// waitDBClusterHTTPEndpointUpdated waits until the cluster reports the specified HTTP endpoint (RDS Data API) status.
+func waitDBClusterHTTPEndpointUpdated(ctx context.Context, conn *rds.Client, id string, enabled bool, timeout time.Duration) error {
+	checkFunc := func(ctx context.Context) (bool, error) {
+		output, err := findDBClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return aws.ToBool(output.HttpEndpointEnabled) == enabled && aws.ToString(output.Status) == clusterStatusAvailable, nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                10 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
+
 func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -28,18 +28,28 @@ func resourceClusterActivityStream() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterActivityStreamCreate,
 		ReadWithoutTimeout:   resourceClusterActivityStreamRead,
+		UpdateWithoutTimeout: resourceClusterActivityStreamUpdate,
 		DeleteWithoutTimeout: resourceClusterActivityStreamDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"engine_native_audit_fields_included": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
+			},
+			"kinesis_stream_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"kinesis_stream_name": {
 				Type:     schema.TypeString,
@@ -53,7 +63,6 @@ func resourceClusterActivityStream() *schema.Resource {
 			names.AttrMode: {
 				Type:             schema.TypeString,
 				Required:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[types.ActivityStreamMode](),
 			},
 			names.AttrResourceARN: {
@@ -71,23 +80,14 @@ func resourceClusterActivityStreamCreate(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
 	arn := d.Get(names.AttrResourceARN).(string)
-	input := &rds.StartActivityStreamInput{
-		ApplyImmediately:                aws.Bool(true),
-		EngineNativeAuditFieldsIncluded: aws.Bool(d.Get("engine_native_audit_fields_included").(bool)),
-		KmsKeyId:                        aws.String(d.Get(names.AttrKMSKeyID).(string)),
-		Mode:                            types.ActivityStreamMode(d.Get(names.AttrMode).(string)),
-		ResourceArn:                     aws.String(arn),
-	}
-
-	_, err := conn.StartActivityStream(ctx, input)
 
-	if err != nil {
+	if err := startActivityStream(ctx, conn, d); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating RDS Cluster Activity Stream (%s): %s", arn, err)
 	}
 
 	d.SetId(arn)
 
-	if _, err := waitActivityStreamStarted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitActivityStreamStarted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster Activity Stream (%s) start: %s", d.Id(), err)
 	}
 
@@ -110,6 +110,11 @@ func resourceClusterActivityStreamRead(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Activity Stream (%s): %s", d.Id(), err)
 	}
 
+	if v := aws.ToString(output.ActivityStreamKinesisStreamName); v != "" {
+		d.Set("kinesis_stream_arn", meta.(*conns.AWSClient).RegionalARN(ctx, "kinesis", "stream/"+v))
+	} else {
+		d.Set("kinesis_stream_arn", nil)
+	}
 	d.Set("kinesis_stream_name", output.ActivityStreamKinesisStreamName)
 	d.Set(names.AttrKMSKeyID, output.ActivityStreamKmsKeyId)
 	d.Set(names.AttrMode, output.ActivityStreamMode)
@@ -118,15 +123,40 @@ func resourceClusterActivityStreamRead(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+func resourceClusterActivityStreamUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	// An activity stream's mode and engine-native audit fields can't be modified; the activity stream is stopped and restarted.
+	if d.HasChanges("engine_native_audit_fields_included", names.AttrMode) {
+		deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+
+		if err := stopActivityStream(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Activity Stream (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitActivityStreamStopped(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster Activity Stream (%s) stop: %s", d.Id(), err)
+		}
+
+		if err := startActivityStream(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Activity Stream (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitActivityStreamStarted(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster Activity Stream (%s) start: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceClusterActivityStreamRead(ctx, d, meta)...)
+}
+
 func resourceClusterActivityStreamDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
 	log.Printf("[DEBUG] Deleting RDS Cluster Activity Stream: %s", d.Id())
-	_, err := conn.StopActivityStream(ctx, &rds.StopActivityStreamInput{
-		ApplyImmediately: aws.Bool(true),
-		ResourceArn:      aws.String(d.Id()),
-	})
+	err := stopActivityStream(ctx, conn, d.Id())
 
 	if tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "Activity Streams feature expected to be started, but is stopped") {
 		return diags
@@ -136,13 +166,38 @@ func resourceClusterActivityStreamDelete(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "stopping RDS Cluster Activity Stream (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitActivityStreamStopped(ctx, conn, d.Id()); err != nil {
+	if _, err := waitActivityStreamStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster Activity Stream (%s) stop: %s", d.Id(), err)
 	}
 
 	return diags
 }
 
+func startActivityStream(ctx context.Context, conn *rds.Client, d *schema.ResourceData) error {
+	input := rds.StartActivityStreamInput{
+		ApplyImmediately:                aws.Bool(true),
+		EngineNativeAuditFieldsIncluded: aws.Bool(d.Get("engine_native_audit_fields_included").(bool)),
+		KmsKeyId:                        aws.String(d.Get(names.AttrKMSKeyID).(string)),
+		Mode:                            types.ActivityStreamMode(d.Get(names.AttrMode).(string)),
+		ResourceArn:                     aws.String(d.Get(names.AttrResourceARN).(string)),
+	}
+
+	_, err := conn.StartActivityStream(ctx, &input)
+
+	return err
+}
+
+func stopActivityStream(ctx context.Context, conn *rds.Client, arn string) error {
+	input := rds.StopActivityStreamInput{
+		ApplyImmediately: aws.Bool(true),
+		ResourceArn:      aws.String(arn),
+	}
+
+	_, err := conn.StopActivityStream(ctx, &input)
+
+	return err
+}
+
 func findDBClusterWithActivityStream(ctx context.Context, conn *rds.Client, arn string) (*types.DBCluster, error) {
 	output, err := findDBClusterByID(ctx, conn, arn)
 
@@ -175,10 +230,7 @@ func statusDBClusterActivityStream(ctx context.Context, conn *rds.Client, arn st
 	}
 }
 
-func waitActivityStreamStarted(ctx context.Context, conn *rds.Client, arn string) (*types.DBCluster, error) {
-	const (
-		timeout = 30 * time.Minute
-	)
+func waitActivityStreamStarted(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*types.DBCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(types.ActivityStreamStatusStarting),
 		Target:     enum.Slice(types.ActivityStreamStatusStarted),
@@ -197,10 +249,7 @@ func waitActivityStreamStarted(ctx context.Context, conn *rds.Client, arn string
 	return nil, err
 }
 
-func waitActivityStreamStopped(ctx context.Context, conn *rds.Client, arn string) (*types.DBCluster, error) {
-	const (
-		timeout = 30 * time.Minute
-	)
+func waitActivityStreamStopped(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*types.DBCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(types.ActivityStreamStatusStopping),
 		Target:     []string{},
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -40,7 +41,9 @@ func TestAccRDSClusterActivityStream_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterActivityStreamExists(ctx, resourceName, &dbCluster),
 					resource.TestCheckResourceAttr(resourceName, "engine_native_audit_fields_included", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "kinesis_stream_arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "kinesis_stream_name"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMode, "async"),
 				),
 			},
 			{
@@ -53,6 +56,50 @@ func TestAccRDSClusterActivityStream_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterActivityStream_mode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster types.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster_activity_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartition(t, endpoints.AwsPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterActivityStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterActivityStreamConfig_mode(rName, "async"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterActivityStreamExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMode, "async"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				Config: testAccClusterActivityStreamConfig_mode(rName, "sync"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterActivityStreamExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMode, "sync"),
+					resource.TestCheckResourceAttrSet(resourceName, "kinesis_stream_arn"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccRDSClusterActivityStream_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster types.DBCluster
@@ -166,3 +213,15 @@ resource "aws_rds_cluster_activity_stream" "test" {
 }
 `)
 }
+
+func testAccClusterActivityStreamConfig_mode(rName, mode string) string {
+	return acctest.ConfigCompose(testAccClusterActivityStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_activity_stream" "test" {
+  resource_arn = aws_rds_cluster.test.arn
+  kms_key_id   = aws_kms_key.test.key_id
+  mode         = %[1]q
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, mode))
+}
@@ -220,9 +220,10 @@ func resourceClusterInstance() *schema.Resource {
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
 			"promotion_tier": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  0,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 15),
 			},
 			names.AttrPubliclyAccessible: {
 				Type:     schema.TypeBool,
@@ -445,7 +446,8 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	// Promotion tier changes are applied separately, in tier order across the cluster's instances.
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "promotion_tier") {
 		input := &rds.ModifyDBInstanceInput{
 			ApplyImmediately:     aws.Bool(d.Get(names.AttrApplyImmediately).(bool)),
 			DBInstanceIdentifier: aws.String(d.Id()),
@@ -499,10 +501,6 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 			input.PreferredMaintenanceWindow = aws.String(d.Get(names.AttrPreferredMaintenanceWindow).(string))
 		}
 
-		if d.HasChange("promotion_tier") {
-			input.PromotionTier = aws.Int32(int32(d.Get("promotion_tier").(int)))
-		}
-
 		if d.HasChange(names.AttrPubliclyAccessible) {
 			input.PubliclyAccessible = aws.Bool(d.Get(names.AttrPubliclyAccessible).(bool))
 		}
@@ -522,6 +520,20 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("promotion_tier") {
+		key := clusterInstancePromotionTierKey(conn, d.Get(names.AttrClusterIdentifier).(string))
+		change := &promotionTierChange{
+			applyImmediately: d.Get(names.AttrApplyImmediately).(bool),
+			instanceID:       d.Id(),
+			tier:             int32(d.Get("promotion_tier").(int)),
+			timeout:          d.Timeout(schema.TimeoutUpdate),
+		}
+
+		if err := clusterInstancePromotionTierUpdater.update(ctx, key, change, updateClusterInstancePromotionTier(conn)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceClusterInstanceRead(ctx, d, meta)...)
 }
 
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// promotionTierUpdateWindow is how long a promotion tier change waits for changes to
+	// other instances in the same DB cluster before they are applied together.
+	promotionTierUpdateWindow = 5 * time.Second
+)
+
+// clusterInstancePromotionTierUpdater coordinates promotion_tier changes across aws_rds_cluster_instance resources.
+var clusterInstancePromotionTierUpdater = newPromotionTierUpdater(promotionTierUpdateWindow)
+
+type promotionTierChange struct {
+	applyImmediately bool
+	done             chan error
+	instanceID       string
+	tier             int32
+	timeout          time.Duration
+}
+
+type promotionTierApplyFunc func(context.Context, *promotionTierChange) error
+
+// promotionTierUpdater batches promotion tier (failover priority) changes for the instances in a DB cluster.
+// Changes submitted within the batching window are applied one instance at a time in tier order,
+// waiting for each instance to become available before modifying the next, so that reordering
+// failover priorities across many instances never has several modifications in flight at once.
+type promotionTierUpdater struct {
+	mu      sync.Mutex
+	pending map[string][]*promotionTierChange
+	window  time.Duration
+}
+
+func newPromotionTierUpdater(window time.Duration) *promotionTierUpdater {
+	return &promotionTierUpdater{
+		pending: make(map[string][]*promotionTierChange),
+		window:  window,
+	}
+}
+
+// update submits a promotion tier change for the instance and waits for it to be applied.
+// key identifies the DB cluster and must distinguish clusters in different Regions and accounts.
+func (u *promotionTierUpdater) update(ctx context.Context, key string, change *promotionTierChange, f promotionTierApplyFunc) error {
+	change.done = make(chan error, 1)
+
+	u.mu.Lock()
+	_, ok := u.pending[key]
+	u.pending[key] = append(u.pending[key], change)
+	if !ok {
+		// The batch outlives the resource update that started it.
+		ctx := context.WithoutCancel(ctx)
+		time.AfterFunc(u.window, func() { u.run(ctx, key, f) })
+	}
+	u.mu.Unlock()
+
+	select {
+	case err := <-change.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (u *promotionTierUpdater) run(ctx context.Context, key string, f promotionTierApplyFunc) {
+	// Only one batch of changes for a DB cluster is applied at a time.
+	mutexKey := "rds-cluster-promotion-tier-" + key
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	u.mu.Lock()
+	changes := u.pending[key]
+	delete(u.pending, key)
+	u.mu.Unlock()
+
+	slices.SortStableFunc(changes, func(a, b *promotionTierChange) int {
+		return cmp.Or(cmp.Compare(a.tier, b.tier), strings.Compare(a.instanceID, b.instanceID))
+	})
+
+	for _, change := range changes {
+		tflog.Info(ctx, "Updating RDS Cluster Instance promotion tier", map[string]any{
+			"db_instance_identifier": change.instanceID,
+			"promotion_tier":         change.tier,
+		})
+
+		change.done <- f(ctx, change)
+	}
+}
+
+func clusterInstancePromotionTierKey(conn *rds.Client, clusterID string) string {
+	return fmt.Sprintf("%p/%s", conn, clusterID)
+}
+
+func updateClusterInstancePromotionTier(conn *rds.Client) promotionTierApplyFunc {
+	return func(ctx context.Context, change *promotionTierChange) error {
+		input := rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(change.applyImmediately),
+			DBInstanceIdentifier: aws.String(change.instanceID),
+			PromotionTier:        aws.Int32(change.tier),
+		}
+
+		if _, err := conn.ModifyDBInstance(ctx, &input); err != nil {
+			return fmt.Errorf("updating RDS Cluster Instance (%s) promotion tier: %w", change.instanceID, err)
+		}
+
+		if _, err := waitDBClusterInstanceAvailable(ctx, conn, change.instanceID, change.timeout); err != nil {
+			return fmt.Errorf("waiting for RDS Cluster Instance (%s) promotion tier update: %w", change.instanceID, err)
+		}
+
+		return nil
+	}
+}
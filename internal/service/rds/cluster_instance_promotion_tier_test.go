@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPromotionTierUpdater(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	u := newPromotionTierUpdater(500 * time.Millisecond)
+
+	var (
+		mu      sync.Mutex
+		applied []string
+	)
+	f := func(_ context.Context, change *promotionTierChange) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		applied = append(applied, change.instanceID)
+
+		if change.instanceID == "db3" {
+			return errors.New("failed")
+		}
+
+		return nil
+	}
+
+	changes := []*promotionTierChange{
+		{instanceID: "db1", tier: 2},
+		{instanceID: "db2", tier: 0},
+		{instanceID: "db3", tier: 1},
+		{instanceID: "db4", tier: 0},
+	}
+	errs := make([]error, len(changes))
+
+	var wg sync.WaitGroup
+	for i, change := range changes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			errs[i] = u.update(ctx, "cluster", change, f)
+		}()
+	}
+	wg.Wait()
+
+	if want := []string{"db2", "db4", "db3", "db1"}; !slices.Equal(applied, want) {
+		t.Errorf("applied = %v, want %v", applied, want)
+	}
+
+	for i, err := range errs {
+		if got, want := err != nil, changes[i].instanceID == "db3"; got != want {
+			t.Errorf("%s: err = %v", changes[i].instanceID, err)
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -315,6 +316,55 @@ func TestAccRDSClusterInstance_az(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterInstance_promotionTier(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 types.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_rds_cluster_instance.test.0"
+	resourceName2 := "aws_rds_cluster_instance.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterInstanceConfig_promotionTier(rName, 16, 0),
+				ExpectError: regexache.MustCompile(`expected promotion_tier to be in the range \(0 - 15\)`),
+			},
+			{
+				Config: testAccClusterInstanceConfig_promotionTier(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName1, &v1),
+					testAccCheckClusterInstanceExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttr(resourceName1, "promotion_tier", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "promotion_tier", "2"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_promotionTier(rName, 2, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName1, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(resourceName2, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName1, &v1),
+					testAccCheckClusterInstanceExists(ctx, resourceName2, &v2),
+					resource.TestCheckResourceAttr(resourceName1, "promotion_tier", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "promotion_tier", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSClusterInstance_kmsKey(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1390,6 +1440,25 @@ resource "aws_db_parameter_group" "test" {
 `, rName))
 }
 
+func testAccClusterInstanceConfig_promotionTier(rName string, tier1, tier2 int) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, "aurora-mysql"), fmt.Sprintf(`
+locals {
+  promotion_tiers = [%[2]d, %[3]d]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+  promotion_tier     = local.promotion_tiers[count.index]
+  apply_immediately  = true
+}
+`, rName, tier1, tier2))
+}
+
 func testAccClusterInstanceConfig_kmsKey(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_rds_cluster_parameter_group_parameters", name="Cluster Parameter Group Parameters")
+func newClusterParameterGroupParametersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterParameterGroupParametersDataSource{}, nil
+}
+
+const (
+	DSNameClusterParameterGroupParameters = "Cluster Parameter Group Parameters Data Source"
+)
+
+type clusterParameterGroupParametersDataSource struct {
+	framework.DataSourceWithModel[clusterParameterGroupParametersDataSourceModel]
+}
+
+func (d *clusterParameterGroupParametersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrParameters: framework.DataSourceComputedListOfObjectAttribute[clusterParameterGroupParameterModel](ctx),
+			names.AttrSource: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("engine-default", "system", "user"),
+				},
+			},
+		},
+	}
+}
+
+func (d *clusterParameterGroupParametersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterParameterGroupParametersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().RDSClient(ctx)
+
+	name := data.Name.ValueString()
+	input := rds.DescribeDBClusterParametersInput{
+		DBClusterParameterGroupName: aws.String(name),
+		Source:                      fwflex.StringFromFramework(ctx, data.Source),
+	}
+	parameters, err := findDBClusterParameters(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.Parameter]())
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.RDS, create.ErrActionReading, DSNameClusterParameterGroupParameters, name, err), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, parameters, &data.Parameters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterParameterGroupParametersDataSourceModel struct {
+	framework.WithRegionModel
+	Name       types.String                                                         `tfsdk:"name"`
+	Parameters fwtypes.ListNestedObjectValueOf[clusterParameterGroupParameterModel] `tfsdk:"parameters"`
+	Source     types.String                                                         `tfsdk:"source"`
+}
+
+type clusterParameterGroupParameterModel struct {
+	AllowedValues  types.String                             `tfsdk:"allowed_values"`
+	ApplyMethod    fwtypes.StringEnum[awstypes.ApplyMethod] `tfsdk:"apply_method"`
+	ApplyType      types.String                             `tfsdk:"apply_type"`
+	DataType       types.String                             `tfsdk:"data_type"`
+	Description    types.String                             `tfsdk:"description"`
+	IsModifiable   types.Bool                               `tfsdk:"is_modifiable"`
+	ParameterName  types.String                             `tfsdk:"name"`
+	ParameterValue types.String                             `tfsdk:"value"`
+	Source         types.String                             `tfsdk:"source"`
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSClusterParameterGroupParametersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_cluster_parameter_group_parameters.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterParameterGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterParameterGroupParametersDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "parameters.#", 1),
+				),
+			},
+			{
+				Config: testAccClusterParameterGroupParametersDataSourceConfig_source(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.apply_method", "pending-reboot"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.name", "client_encoding"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.source", "user"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.value", "UTF8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterParameterGroupParametersDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = "aurora-postgresql15"
+
+  parameter {
+    name         = "client_encoding"
+    value        = "UTF8"
+    apply_method = "pending-reboot"
+  }
+}
+`, rName)
+}
+
+func testAccClusterParameterGroupParametersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterParameterGroupParametersDataSourceConfig_base(rName), `
+data "aws_rds_cluster_parameter_group_parameters" "test" {
+  name = aws_rds_cluster_parameter_group.test.name
+}
+`)
+}
+
+func testAccClusterParameterGroupParametersDataSourceConfig_source(rName string) string {
+	return acctest.ConfigCompose(testAccClusterParameterGroupParametersDataSourceConfig_base(rName), `
+data "aws_rds_cluster_parameter_group_parameters" "test" {
+  name   = aws_rds_cluster_parameter_group.test.name
+  source = "user"
+}
+`)
+}
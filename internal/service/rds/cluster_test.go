@@ -1067,6 +1067,30 @@ func TestAccRDSCluster_takeFinalSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_takeFinalSnapshotSuffixStrategy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroyWithFinalSnapshot(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_finalSnapshotSuffixStrategy(rName, "timestamp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_identifier_suffix_strategy", "timestamp"),
+					resource.TestMatchResourceAttr(resourceName, "final_snapshot_identifier_actual", regexache.MustCompile(`^`+rName+`-\d{14}$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_GlobalClusterIdentifier_takeFinalSnapshot(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.DBCluster
@@ -2228,6 +2252,16 @@ func TestAccRDSCluster_serverlessV2ScalingConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.seconds_until_auto_pause", "21600"),
 				),
 			},
+			{
+				Config: testAccClusterConfig_serverlessV2ScalingConfigurationWithSecondsUntilAutoPause(rName, 256.0, 0, "600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.max_capacity", "256"),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "serverlessv2_scaling_configuration.0.seconds_until_auto_pause", "600"),
+				),
+			},
 			// https://github.com/hashicorp/terraform-provider-aws/issues/40637.
 			{
 				Config: testAccClusterConfig_serverlessV2ScalingConfiguration(rName, 64.0, 2.5),
@@ -2945,6 +2979,34 @@ func TestAccRDSCluster_enableHTTPEndpointProvisioned(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_SnapshotIdentifier_enableHTTPEndpoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbCluster types.DBCluster
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_SnapshotID_enableHTTPEndpoint(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "enable_http_endpoint", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_password(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster types.DBCluster
@@ -3573,6 +3635,9 @@ func testAccCheckClusterDestroyWithFinalSnapshot(ctx context.Context) resource.T
 			}
 
 			finalSnapshotID := rs.Primary.Attributes[names.AttrFinalSnapshotIdentifier]
+			if v := rs.Primary.Attributes["final_snapshot_identifier_actual"]; v != "" {
+				finalSnapshotID = v
+			}
 			_, err := tfrds.FindDBClusterSnapshotByID(ctx, conn, finalSnapshotID)
 			if err != nil {
 				return err
@@ -4257,6 +4322,20 @@ resource "aws_rds_cluster" "test" {
 `, rName, tfrds.ClusterEngineAuroraMySQL)
 }
 
+func testAccClusterConfig_finalSnapshotSuffixStrategy(rName, strategy string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier                        = %[1]q
+  database_name                             = "test"
+  engine                                    = %[2]q
+  master_username                           = "tfacctest"
+  master_password                           = "avoid-plaintext-passwords"
+  final_snapshot_identifier                 = %[1]q
+  final_snapshot_identifier_suffix_strategy = %[3]q
+}
+`, rName, tfrds.ClusterEngineAuroraMySQL, strategy)
+}
+
 func testAccClusterConfig_GlobalClusterID_finalSnapshot(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_global_cluster" "test" {
@@ -6355,6 +6434,42 @@ resource "aws_rds_cluster" "test" {
 `, rName, tfrds.ClusterEngineAuroraPostgreSQL, enableHttpEndpoint)
 }
 
+func testAccClusterConfig_SnapshotID_enableHTTPEndpoint(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "source" {
+  cluster_identifier  = "%[1]s-source"
+  engine              = %[2]q
+  master_password     = "barbarbarbar"
+  master_username     = "foo"
+  skip_final_snapshot = true
+
+  serverlessv2_scaling_configuration {
+    max_capacity = 1.0
+    min_capacity = 0.5
+  }
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = aws_rds_cluster.source.id
+  db_cluster_snapshot_identifier = %[1]q
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier   = %[1]q
+  engine               = %[2]q
+  engine_mode          = "provisioned"
+  skip_final_snapshot  = true
+  snapshot_identifier  = aws_db_cluster_snapshot.test.id
+  enable_http_endpoint = true
+
+  serverlessv2_scaling_configuration {
+    max_capacity = 1.0
+    min_capacity = 0.5
+  }
+}
+`, rName, tfrds.ClusterEngineAuroraPostgreSQL)
+}
+
 func testAccClusterConfig_password(rName, password string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -134,6 +134,7 @@ const (
 const (
 	dbSnapshotAvailable = "available"
 	dbSnapshotCreating  = "creating"
+	dbSnapshotDeleting  = "deleting"
 )
 
 const (
@@ -323,3 +324,21 @@ const (
 	parameterSourceSystem        = "system"
 	parameterSourceUser          = "user"
 )
+
+const (
+	parameterApplyStatusApplying      = "applying"
+	parameterApplyStatusInSync        = "in-sync"
+	parameterApplyStatusPendingReboot = "pending-reboot"
+)
+
+const (
+	staticParameterApplyStrategyBlueGreen = "blue-green"
+	staticParameterApplyStrategyReboot    = "reboot"
+)
+
+func staticParameterApplyStrategy_Values() []string {
+	return []string{
+		staticParameterApplyStrategyBlueGreen,
+		staticParameterApplyStrategyReboot,
+	}
+}
@@ -5,6 +5,7 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -96,6 +97,10 @@ func resourceCustomDBEngineVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 60),
 			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -148,16 +153,33 @@ func resourceCustomDBEngineVersion() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.CustomEngineVersionStatus](),
 			},
+			// Allow CEV creation from an existing CEV.
+			// implicit state passthrough, virtual attribute
+			"source_custom_db_engine_version_identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_image_id"},
+			},
 			// Allow CEV creation from a source AMI ID.
 			// implicit state passthrough, virtual attribute
 			"source_image_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 255),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 255),
+				ConflictsWith: []string{"source_custom_db_engine_version_identifier", "use_aws_provided_latest_image"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			// Allow CEV creation from the latest AMI provided by AWS.
+			// implicit state passthrough, virtual attribute
+			"use_aws_provided_latest_image": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_image_id"},
+			},
 		},
 	}
 }
@@ -187,31 +209,22 @@ func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.Resource
 		input.Description = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("filename"); ok {
-		// Grab an exclusive lock so that we're only reading one contact flow into
-		// memory at a time.
-		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(mutexKey)
-		defer conns.GlobalMutexKV.Unlock(mutexKey)
-
-		file, err := tfio.ReadFileContents(v.(string))
-		if err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
-
-		input.Manifest = aws.String(string(file))
-	} else if v, ok := d.GetOk("manifest"); ok {
-		input.Manifest = aws.String(v.(string))
-	}
-
 	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
 		input.KMSKeyId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("source_custom_db_engine_version_identifier"); ok {
+		input.SourceCustomDbEngineVersionIdentifier = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("source_image_id"); ok {
 		input.ImageId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("use_aws_provided_latest_image"); ok {
+		input.UseAwsProvidedLatestImage = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("filename"); ok {
 		filename := v.(string)
 		// Grab an exclusive lock so that we're only reading one contact flow into
@@ -277,7 +290,12 @@ func resourceCustomDBEngineVersionRead(ctx context.Context, d *schema.ResourceDa
 	d.Set(names.AttrDescription, out.DBEngineVersionDescription)
 	d.Set(names.AttrEngine, out.Engine)
 	d.Set(names.AttrEngineVersion, out.EngineVersion)
-	d.Set("image_id", out.Image.ImageId)
+	d.Set("failure_reason", out.FailureReason)
+	if out.Image != nil {
+		d.Set("image_id", out.Image.ImageId)
+	} else {
+		d.Set("image_id", nil)
+	}
 	d.Set(names.AttrKMSKeyID, out.KMSKeyId)
 	d.Set("major_engine_version", out.MajorEngineVersion)
 	d.Set("manifest_computed", out.CustomDBEngineVersionManifest)
@@ -423,12 +441,15 @@ func findDBEngineVersions(ctx context.Context, conn *rds.Client, input *rds.Desc
 }
 
 const (
-	statusAvailable         = "available"
-	statusCreating          = "creating"
-	statusDeleting          = "deleting"
-	statusDeprecated        = "deprecated"
-	statusFailed            = "failed"
-	statusPendingValidation = "pending-validation" // Custom for SQL Server, ready for validation by an instance
+	statusAvailable                      = "available"
+	statusCreating                       = "creating"
+	statusDeleting                       = "deleting"
+	statusDeprecated                     = "deprecated"
+	statusFailed                         = "failed"
+	statusInactive                       = "inactive"
+	statusInactiveExceptRestore          = "inactive-except-restore"
+	statusIncompatibleImageConfiguration = "incompatible-image-configuration"
+	statusPendingValidation              = "pending-validation" // Custom for SQL Server, ready for validation by an instance
 )
 
 func statusDBEngineVersion(ctx context.Context, conn *rds.Client, engine, engineVersion string) retry.StateRefreshFunc {
@@ -460,6 +481,10 @@ func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.Client, eng
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*types.DBEngineVersion); ok {
+		if status := aws.ToString(output.Status); status == statusFailed || status == statusIncompatibleImageConfiguration {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+		}
+
 		return output, err
 	}
 
@@ -469,7 +494,7 @@ func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.Client, eng
 func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.Client, engine, engineVersion string, timeout time.Duration) (*types.DBEngineVersion, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{statusAvailable},
-		Target:  []string{statusAvailable, statusPendingValidation},
+		Target:  []string{statusAvailable, statusInactive, statusInactiveExceptRestore, statusPendingValidation},
 		Refresh: statusDBEngineVersion(ctx, conn, engine, engineVersion),
 		Timeout: timeout,
 	}
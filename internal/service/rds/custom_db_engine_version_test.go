@@ -167,6 +167,56 @@ func TestAccRDSCustomDBEngineVersion_oracle(t *testing.T) {
 	})
 }
 
+func TestAccRDSCustomDBEngineVersion_oracleStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	// Requires an existing Oracle installation media in S3 bucket owned (bucket must be in operating region) by operating account set as environmental variable
+	key := "RDS_CUSTOM_ORACLE_S3_BUCKET"
+	bucket := os.Getenv(key)
+	if bucket == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	var customdbengineversion types.DBEngineVersion
+	rName := fmt.Sprintf("%s%s%d", "19.19.ee.", acctest.ResourcePrefix, sdkacctest.RandIntRange(100, 999))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_oracleStatus(rName, bucket, "available"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &customdbengineversion),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "available"),
+				),
+			},
+			{
+				Config: testAccCustomDBEngineVersionConfig_oracleStatus(rName, bucket, "inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &customdbengineversion),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "inactive"),
+				),
+			},
+			{
+				Config: testAccCustomDBEngineVersionConfig_oracleStatus(rName, bucket, "available"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &customdbengineversion),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCustomDBEngineVersion_manifestFile(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -409,6 +459,29 @@ resource "aws_rds_custom_db_engine_version" "test" {
 `, rName, bucket)
 }
 
+func testAccCustomDBEngineVersionConfig_oracleStatus(rName, bucket, status string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "rdscfo_kms_key" {
+  description             = "KMS symmetric key for RDS Custom for Oracle"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_rds_custom_db_engine_version" "test" {
+  database_installation_files_s3_bucket_name = %[2]q
+  engine                                     = "custom-oracle-ee-cdb"
+  engine_version                             = %[1]q
+  kms_key_id                                 = aws_kms_key.rdscfo_kms_key.arn
+  status                                     = %[3]q
+  manifest                                   = <<JSON
+  {
+	"databaseInstallationFileNames":["V982063-01.zip"]
+  }
+  JSON
+}
+`, rName, bucket, status)
+}
+
 func testAccCustomDBEngineVersionConfig_manifestFile(rName, bucket, filename string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "rdscfo_kms_key" {
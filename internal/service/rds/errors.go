@@ -4,9 +4,11 @@
 package rds
 
 const (
+	errCodeAccessDenied                = "AccessDenied"
 	errCodeInvalidAction               = "InvalidAction"
 	errCodeInvalidParameterCombination = "InvalidParameterCombination"
 	errCodeInvalidParameterValue       = "InvalidParameterValue"
+	errCodeUnauthorizedOperation       = "UnauthorizedOperation"
 	errCodeValidationError             = "ValidationError"
 )
 
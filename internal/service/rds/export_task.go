@@ -9,17 +9,22 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
@@ -51,6 +56,10 @@ const (
 	exportTaskStatusStarting   = "STARTING"
 )
 
+// exportOnlyRegexp matches an export_only element: a database, optionally followed by a schema and a table
+// (database.table for MySQL and MariaDB), each separated by a period.
+var exportOnlyRegexp = regexache.MustCompile(`^[^.]+(\.[^.]+){0,2}$`)
+
 type exportTaskResource struct {
 	framework.ResourceWithModel[exportTaskResourceModel]
 	framework.WithNoUpdate
@@ -68,6 +77,12 @@ func (r *exportTaskResource) Schema(ctx context.Context, request resource.Schema
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(exportOnlyRegexp, "must be in the form database[.schema][.table]"),
+					),
+				},
 			},
 			"export_task_identifier": schema.StringAttribute{
 				Required: true,
@@ -298,6 +313,9 @@ func findExportTasks(ctx context.Context, conn *rds.Client, input *rds.DescribeE
 }
 
 func statusExportTask(ctx context.Context, conn *rds.Client, id string) retry.StateRefreshFunc {
+	var lastStatus string
+	lastPercentProgress := int32(-1)
+
 	return func() (any, string, error) {
 		out, err := findExportTaskByID(ctx, conn, id)
 
@@ -309,7 +327,20 @@ func statusExportTask(ctx context.Context, conn *rds.Client, id string) retry.St
 			return nil, "", err
 		}
 
-		return out, aws.ToString(out.Status), nil
+		// Large exports can run for hours, so log progress each time it changes.
+		status, percentProgress := aws.ToString(out.Status), aws.ToInt32(out.PercentProgress)
+		if status != lastStatus || percentProgress != lastPercentProgress {
+			tflog.Info(ctx, "RDS Export Task progress", map[string]any{
+				"export_task_identifier":     id,
+				"percent_progress":           percentProgress,
+				names.AttrStatus:             status,
+				"total_extracted_data_in_gb": aws.ToInt32(out.TotalExtractedDataInGB),
+			})
+
+			lastStatus, lastPercentProgress = status, percentProgress
+		}
+
+		return out, status, nil
 	}
 }
 
@@ -337,9 +368,11 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 func waitExportTaskDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.ExportTask, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{exportTaskStatusStarting, exportTaskStatusInProgress, exportTaskStatusCanceling},
-		Target:  []string{},
-		Refresh: statusExportTask(ctx, conn, id),
-		Timeout: timeout,
+		// Canceled tasks are still returned by the Describe API. The task may also finish before the cancellation takes effect.
+		Target:     []string{exportTaskStatusCanceled, exportTaskStatusComplete, exportTaskStatusFailed},
+		Refresh:    statusExportTask(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -9,6 +9,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -98,6 +99,24 @@ func TestAccRDSExportTask_optional(t *testing.T) {
 	})
 }
 
+func TestAccRDSExportTask_exportOnlyInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExportTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExportTaskConfig_exportOnly(rName, "database.schema.table.column"),
+				ExpectError: regexache.MustCompile(`must be in the form database\[.schema\]\[.table\]`),
+			},
+		},
+	})
+}
+
 func testAccCheckExportTaskDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
@@ -272,3 +291,21 @@ resource "aws_rds_export_task" "test" {
 }
 `, rName, s3Prefix))
 }
+
+func testAccExportTaskConfig_exportOnly(rName, exportOnly string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:snapshot:%[1]s"
+  s3_bucket_name         = %[1]q
+  iam_role_arn           = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+  kms_key_id             = "alias/%[1]s"
+
+  export_only = [%[2]q]
+}
+
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+`, rName, exportOnly)
+}
@@ -19,12 +19,14 @@ var (
 	ResourceExportTask                          = newExportTaskResource
 	ResourceGlobalCluster                       = resourceGlobalCluster
 	ResourceInstance                            = resourceInstance
+	ResourceInstanceReplicaFleet                = newInstanceReplicaFleetResource
 	ResourceInstanceState                       = newInstanceStateResource
 	ResourceInstanceAutomatedBackupsReplication = resourceInstanceAutomatedBackupsReplication
 	ResourceInstanceRoleAssociation             = resourceInstanceRoleAssociation
 	ResourceIntegration                         = newIntegrationResource
 	ResourceOptionGroup                         = resourceOptionGroup
 	ResourceParameterGroup                      = resourceParameterGroup
+	ResourcePendingMaintenanceAction            = newPendingMaintenanceActionResource
 	ResourceProxy                               = resourceProxy
 	ResourceProxyDefaultTargetGroup             = resourceProxyDefaultTargetGroup
 	ResourceProxyEndpoint                       = resourceProxyEndpoint
@@ -34,6 +36,7 @@ var (
 	ResourceSnapshot                            = resourceSnapshot
 	ResourceSnapshotCopy                        = resourceSnapshotCopy
 	ResourceSubnetGroup                         = resourceSubnetGroup
+	ResourceTenantDatabase                      = resourceTenantDatabase
 
 	ClusterIDAndRegionFromARN                  = clusterIDAndRegionFromARN
 	FindCustomDBEngineVersionByTwoPartKey      = findCustomDBEngineVersionByTwoPartKey
@@ -46,6 +49,7 @@ var (
 	FindDBInstanceAutomatedBackupByARN         = findDBInstanceAutomatedBackupByARN
 	FindDBInstanceByID                         = findDBInstanceByID
 	FindDBInstanceRoleByTwoPartKey             = findDBInstanceRoleByTwoPartKey
+	FindInstanceReplicaFleetReplicas           = findInstanceReplicaFleetReplicas
 	FindDBParameterGroupByName                 = findDBParameterGroupByName
 	FindDBProxyByName                          = findDBProxyByName
 	FindDBProxyEndpointByTwoPartKey            = findDBProxyEndpointByTwoPartKey
@@ -54,12 +58,14 @@ var (
 	FindDBSnapshotByID                         = findDBSnapshotByID
 	FindDBSubnetGroupByName                    = findDBSubnetGroupByName
 	FindDefaultCertificate                     = findDefaultCertificate
+	FindTenantDatabaseByTwoPartKey             = findTenantDatabaseByTwoPartKey
 	FindDefaultDBProxyTargetGroupByDBProxyName = findDefaultDBProxyTargetGroupByDBProxyName
 	FindEventSubscriptionByID                  = findEventSubscriptionByID
 	FindExportTaskByID                         = findExportTaskByID
 	FindGlobalClusterByID                      = findGlobalClusterByID
 	FindIntegrationByARN                       = findIntegrationByARN
 	FindOptionGroupByName                      = findOptionGroupByName
+	FindPendingMaintenanceActionByTwoPartKey   = findPendingMaintenanceActionByTwoPartKey
 	FindReservedDBInstanceByID                 = findReservedDBInstanceByID
 	ListTags                                   = listTags
 	NewBlueGreenOrchestrator                   = newBlueGreenOrchestrator
@@ -75,3 +81,7 @@ var (
 	ErrCodeInvalidParameterCombination = errCodeInvalidParameterCombination
 	ErrCodeInvalidParameterValue       = errCodeInvalidParameterValue
 )
+
+const (
+	InstanceReplicaFleetMaxReplicas = instanceReplicaFleetMaxReplicas
+)
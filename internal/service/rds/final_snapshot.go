@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	finalSnapshotIdentifierSuffixStrategyTimestamp = "timestamp"
+	finalSnapshotIdentifierSuffixStrategyUUID      = "uuid"
+)
+
+func finalSnapshotIdentifierSuffixStrategy_Values() []string {
+	return []string{
+		finalSnapshotIdentifierSuffixStrategyTimestamp,
+		finalSnapshotIdentifierSuffixStrategyUUID,
+	}
+}
+
+const (
+	finalSnapshotIdentifierSuffixTimestampLayout = "20060102150405"
+)
+
+// finalSnapshotIdentifierWithSuffix returns the final snapshot identifier with a suffix generated using the specified strategy.
+func finalSnapshotIdentifierWithSuffix(identifier, strategy string, now time.Time) (string, error) {
+	if identifier == "" {
+		return "", nil
+	}
+
+	switch strategy {
+	case finalSnapshotIdentifierSuffixStrategyTimestamp:
+		return identifier + "-" + now.UTC().Format(finalSnapshotIdentifierSuffixTimestampLayout), nil
+	case finalSnapshotIdentifierSuffixStrategyUUID:
+		v, err := uuid.GenerateUUID()
+		if err != nil {
+			return "", err
+		}
+
+		return identifier + "-" + v, nil
+	default:
+		return identifier, nil
+	}
+}
+
+// setFinalSnapshotIdentifierActual records the identifier of the final snapshot that will be taken when the resource is deleted.
+// The suffix is generated once, when the resource is created or the final snapshot settings change,
+// so that each instance of the resource has its own final snapshot identifier.
+func setFinalSnapshotIdentifierActual(d *schema.ResourceData) error {
+	strategy := d.Get("final_snapshot_identifier_suffix_strategy").(string)
+	if strategy == "" {
+		d.Set("final_snapshot_identifier_actual", nil)
+
+		return nil
+	}
+
+	v, err := finalSnapshotIdentifierWithSuffix(d.Get(names.AttrFinalSnapshotIdentifier).(string), strategy, time.Now())
+	if err != nil {
+		return err
+	}
+
+	d.Set("final_snapshot_identifier_actual", v)
+
+	return nil
+}
+
+// finalSnapshotIdentifierActual returns the identifier of the final snapshot to take when the resource is deleted.
+// Without a suffix strategy, this is the configured final snapshot identifier.
+func finalSnapshotIdentifierActual(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("final_snapshot_identifier_actual"); ok {
+		return v.(string)
+	}
+
+	return d.Get(names.AttrFinalSnapshotIdentifier).(string)
+}
+
+func finalSnapshotIdentifierActualCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || !d.HasChanges(names.AttrFinalSnapshotIdentifier, "final_snapshot_identifier_suffix_strategy") {
+		return nil
+	}
+
+	if o, n := d.GetChange("final_snapshot_identifier_suffix_strategy"); o.(string) != "" || n.(string) != "" {
+		return d.SetNewComputed("final_snapshot_identifier_actual")
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestFinalSnapshotIdentifierWithSuffix(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.March, 4, 5, 6, 7, 0, time.UTC)
+
+	testCases := map[string]struct {
+		identifier string
+		strategy   string
+		expected   *regexp.Regexp
+	}{
+		"no identifier": {
+			identifier: "",
+			strategy:   finalSnapshotIdentifierSuffixStrategyTimestamp,
+			expected:   regexache.MustCompile(`^$`),
+		},
+		"no strategy": {
+			identifier: "final",
+			strategy:   "",
+			expected:   regexache.MustCompile(`^final$`),
+		},
+		"timestamp": {
+			identifier: "final",
+			strategy:   finalSnapshotIdentifierSuffixStrategyTimestamp,
+			expected:   regexache.MustCompile(`^final-20250304050607$`),
+		},
+		"uuid": {
+			identifier: "final",
+			strategy:   finalSnapshotIdentifierSuffixStrategyUUID,
+			expected:   regexache.MustCompile(`^final-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := finalSnapshotIdentifierWithSuffix(testCase.identifier, testCase.strategy, now)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.expected.MatchString(got) {
+				t.Errorf("finalSnapshotIdentifierWithSuffix(%q, %q) = %q, want match for %s", testCase.identifier, testCase.strategy, got, testCase.expected)
+			}
+		})
+	}
+}
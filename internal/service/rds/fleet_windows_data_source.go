@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_rds_fleet_windows", name="Fleet Windows")
+func newFleetWindowsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &fleetWindowsDataSource{}, nil
+}
+
+const (
+	// Minimum duration, in minutes, of both the preferred backup and maintenance windows.
+	fleetWindowMinimumDuration = 30
+
+	minutesPerDay = 24 * 60
+)
+
+var (
+	// Days of the week in maintenance window ("ddd") format.
+	fleetWindowDays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+)
+
+type fleetWindowsDataSource struct {
+	framework.DataSourceWithModel[fleetWindowsDataSourceModel]
+}
+
+func (d *fleetWindowsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"backup_window_duration": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(fleetWindowMinimumDuration, minutesPerDay/2),
+				},
+			},
+			"business_hours": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-1][0-9]|2[0-3]):[0-5][0-9]-([0-1][0-9]|2[0-3]):[0-5][0-9]$`), `must satisfy the format of "hh24:mi-hh24:mi"`),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_identifiers": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"maintenance_days": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(fleetWindowDays...)),
+				},
+			},
+			"maintenance_window_duration": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(fleetWindowMinimumDuration, minutesPerDay/2),
+				},
+			},
+			"windows": framework.DataSourceComputedListOfObjectAttribute[fleetWindowModel](ctx),
+		},
+	}
+}
+
+func (d *fleetWindowsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data fleetWindowsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := fleetWindowsInput{
+		backupDuration:      fleetWindowMinimumDuration,
+		maintenanceDuration: fleetWindowMinimumDuration,
+		maintenanceDays:     fleetWindowDays,
+		instanceIdentifiers: fwflex.ExpandFrameworkStringValueList(ctx, data.InstanceIdentifiers),
+	}
+	if !data.BackupWindowDuration.IsNull() {
+		input.backupDuration = int(data.BackupWindowDuration.ValueInt64())
+	}
+	if !data.MaintenanceWindowDuration.IsNull() {
+		input.maintenanceDuration = int(data.MaintenanceWindowDuration.ValueInt64())
+	}
+	if !data.MaintenanceDays.IsNull() {
+		days := fwflex.ExpandFrameworkStringValueSet(ctx, data.MaintenanceDays)
+		// Keep the days in week order so that results are stable.
+		input.maintenanceDays = nil
+		for _, day := range fleetWindowDays {
+			if slices.Contains(days, day) {
+				input.maintenanceDays = append(input.maintenanceDays, day)
+			}
+		}
+	}
+	if v := data.BusinessHours.ValueString(); v != "" {
+		start, end, err := parseOnceADayWindow(v)
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("business_hours"),
+				"Invalid Attribute Value",
+				err.Error(),
+			)
+
+			return
+		}
+
+		input.businessHours = &minuteRange{start: start, end: end}
+	}
+
+	windows, err := computeFleetWindows(input)
+
+	if err != nil {
+		response.Diagnostics.AddError("computing RDS fleet windows", err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region(ctx))
+	data.Windows = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, windows)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type fleetWindowsDataSourceModel struct {
+	framework.WithRegionModel
+	BackupWindowDuration      types.Int64                                       `tfsdk:"backup_window_duration"`
+	BusinessHours             types.String                                      `tfsdk:"business_hours"`
+	ID                        types.String                                      `tfsdk:"id"`
+	InstanceIdentifiers       fwtypes.ListOfString                              `tfsdk:"instance_identifiers"`
+	MaintenanceDays           fwtypes.SetOfString                               `tfsdk:"maintenance_days"`
+	MaintenanceWindowDuration types.Int64                                       `tfsdk:"maintenance_window_duration"`
+	Windows                   fwtypes.ListNestedObjectValueOf[fleetWindowModel] `tfsdk:"windows"`
+}
+
+type fleetWindowModel struct {
+	BackupWindow       types.String `tfsdk:"backup_window"`
+	InstanceIdentifier types.String `tfsdk:"instance_identifier"`
+	MaintenanceWindow  types.String `tfsdk:"maintenance_window"`
+}
+
+type fleetWindowsInput struct {
+	backupDuration      int
+	businessHours       *minuteRange
+	instanceIdentifiers []string
+	maintenanceDays     []string
+	maintenanceDuration int
+}
+
+// minuteRange is a half-open range of minutes, [start, end).
+// For daily ranges end may be less than start, indicating that the range wraps midnight.
+type minuteRange struct {
+	start, end int
+}
+
+func (r minuteRange) contains(minute int) bool {
+	if r.start <= r.end {
+		return minute >= r.start && minute < r.end
+	}
+
+	return minute >= r.start || minute < r.end
+}
+
+func (r minuteRange) overlaps(o minuteRange) bool {
+	return r.start < o.end && o.start < r.end
+}
+
+// computeFleetWindows returns a backup and maintenance window for each instance.
+// Windows never overlap business hours or span midnight and an instance's
+// maintenance window never overlaps its own backup window.
+// Backup windows are staggered across the free time of day and maintenance windows are
+// staggered first across the allowed days and then across the free time of day.
+// When there are more instances than free slots, slots are reused round-robin.
+func computeFleetWindows(input fleetWindowsInput) ([]fleetWindowModel, error) {
+	backupSlots := dailySlots(input.businessHours, input.backupDuration)
+
+	if len(backupSlots) == 0 {
+		return nil, fmt.Errorf("no %d minute backup window fits outside of business hours", input.backupDuration)
+	}
+
+	maintenanceDailySlots := dailySlots(input.businessHours, input.maintenanceDuration)
+
+	if len(maintenanceDailySlots) == 0 || len(input.maintenanceDays) == 0 {
+		return nil, fmt.Errorf("no %d minute maintenance window fits outside of business hours", input.maintenanceDuration)
+	}
+
+	type weeklySlot struct {
+		day int
+		minuteRange
+	}
+	var maintenanceSlots []weeklySlot
+	for _, slot := range maintenanceDailySlots {
+		for day := range input.maintenanceDays {
+			maintenanceSlots = append(maintenanceSlots, weeklySlot{day: day, minuteRange: slot})
+		}
+	}
+
+	windows := make([]fleetWindowModel, 0, len(input.instanceIdentifiers))
+	for i, id := range input.instanceIdentifiers {
+		backup := backupSlots[i%len(backupSlots)]
+
+		var maintenance *weeklySlot
+		for j := range maintenanceSlots {
+			if slot := maintenanceSlots[(i+j)%len(maintenanceSlots)]; !slot.overlaps(backup) {
+				maintenance = &slot
+				break
+			}
+		}
+
+		if maintenance == nil {
+			return nil, fmt.Errorf("no maintenance window that does not overlap backup window (%s) for %s", formatOnceADayWindow(backup), id)
+		}
+
+		day := input.maintenanceDays[maintenance.day]
+		windows = append(windows, fleetWindowModel{
+			BackupWindow:       types.StringValue(formatOnceADayWindow(backup)),
+			InstanceIdentifier: types.StringValue(id),
+			MaintenanceWindow:  types.StringValue(fmt.Sprintf("%s:%s-%s:%s", day, formatMinuteOfDay(maintenance.start), day, formatMinuteOfDay(maintenance.end))),
+		})
+	}
+
+	return windows, nil
+}
+
+// dailySlots returns consecutive non-overlapping slots of the specified duration that
+// end before midnight and lie outside of the excluded range.
+// Slots are aligned to the end of the excluded range so that the first slot starts as soon as it closes.
+func dailySlots(excluded *minuteRange, duration int) []minuteRange {
+	origin := 0
+	if excluded != nil {
+		origin = excluded.end
+	}
+
+	var slots []minuteRange
+	for offset := 0; offset+duration <= minutesPerDay; {
+		start := (origin + offset) % minutesPerDay
+		end := start + duration
+
+		if end >= minutesPerDay {
+			// Skip to midnight.
+			offset += minutesPerDay - start
+			continue
+		}
+
+		if excluded != nil && (excluded.contains(start) || excluded.contains(end-1) || (start < excluded.start && excluded.start < end)) {
+			offset++
+			continue
+		}
+
+		slots = append(slots, minuteRange{start: start, end: end})
+		offset += duration
+	}
+
+	return slots
+}
+
+func parseOnceADayWindow(v string) (int, int, error) {
+	from, to, ok := strings.Cut(v, "-")
+
+	if !ok {
+		return 0, 0, fmt.Errorf("(%s) must satisfy the format of \"hh24:mi-hh24:mi\"", v)
+	}
+
+	start, err := parseMinuteOfDay(from)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end, err := parseMinuteOfDay(to)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if start == end {
+		return 0, 0, fmt.Errorf("(%s) must not be empty", v)
+	}
+
+	return start, end, nil
+}
+
+func parseMinuteOfDay(v string) (int, error) {
+	h, m, ok := strings.Cut(v, ":")
+
+	if !ok {
+		return 0, fmt.Errorf("(%s) must satisfy the format of \"hh24:mi\"", v)
+	}
+
+	hours, err := strconv.Atoi(h)
+
+	if err != nil {
+		return 0, err
+	}
+
+	minutes, err := strconv.Atoi(m)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return hours*60 + minutes, nil
+}
+
+func formatMinuteOfDay(v int) string {
+	v %= minutesPerDay
+
+	return fmt.Sprintf("%02d:%02d", v/60, v%60)
+}
+
+func formatOnceADayWindow(v minuteRange) string {
+	return formatMinuteOfDay(v.start) + "-" + formatMinuteOfDay(v.end)
+}
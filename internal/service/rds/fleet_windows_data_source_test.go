@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSFleetWindowsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_fleet_windows.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetWindowsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "windows.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.0.instance_identifier", "db1"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.0.backup_window", "18:00-19:00"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.0.maintenance_window", "sat:19:00-sat:19:30"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.1.instance_identifier", "db2"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.1.backup_window", "19:00-20:00"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.1.maintenance_window", "sun:18:00-sun:18:30"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.2.instance_identifier", "db3"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.2.backup_window", "20:00-21:00"),
+					resource.TestCheckResourceAttr(dataSourceName, "windows.2.maintenance_window", "sat:18:30-sat:19:00"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFleetWindowsDataSourceConfig_basic = `
+data "aws_rds_fleet_windows" "test" {
+  instance_identifiers   = ["db1", "db2", "db3"]
+  business_hours         = "08:00-18:00"
+  backup_window_duration = 60
+  maintenance_days       = ["sat", "sun"]
+}
+`
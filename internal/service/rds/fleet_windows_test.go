@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"testing"
+)
+
+func TestComputeFleetWindows(t *testing.T) {
+	t.Parallel()
+
+	type window struct {
+		backup, maintenance string
+	}
+	type testCase struct {
+		input         fleetWindowsInput
+		expected      []window
+		expectedError bool
+	}
+	tests := map[string]testCase{
+		"defaults": {
+			input: fleetWindowsInput{
+				backupDuration:      30,
+				instanceIdentifiers: []string{"db1", "db2", "db3"},
+				maintenanceDays:     fleetWindowDays,
+				maintenanceDuration: 30,
+			},
+			expected: []window{
+				{"00:00-00:30", "mon:00:30-mon:01:00"},
+				{"00:30-01:00", "tue:00:00-tue:00:30"},
+				{"01:00-01:30", "wed:00:00-wed:00:30"},
+			},
+		},
+		"business hours": {
+			input: fleetWindowsInput{
+				backupDuration:      60,
+				businessHours:       &minuteRange{start: 6 * 60, end: 23 * 60},
+				instanceIdentifiers: []string{"db1", "db2", "db3", "db4", "db5", "db6", "db7", "db8"},
+				maintenanceDays:     []string{"sat", "sun"},
+				maintenanceDuration: 30,
+			},
+			expected: []window{
+				{"00:00-01:00", "sat:01:00-sat:01:30"},
+				{"01:00-02:00", "sun:00:00-sun:00:30"},
+				{"02:00-03:00", "sat:00:30-sat:01:00"},
+				{"03:00-04:00", "sun:00:30-sun:01:00"},
+				{"04:00-05:00", "sat:01:00-sat:01:30"},
+				{"05:00-06:00", "sun:01:00-sun:01:30"},
+				{"00:00-01:00", "sat:01:30-sat:02:00"},
+				{"01:00-02:00", "sun:02:00-sun:02:30"},
+			},
+		},
+		"business hours wrap midnight": {
+			input: fleetWindowsInput{
+				backupDuration:      30,
+				businessHours:       &minuteRange{start: 22 * 60, end: 60},
+				instanceIdentifiers: []string{"db1", "db2"},
+				maintenanceDays:     []string{"wed"},
+				maintenanceDuration: 60,
+			},
+			expected: []window{
+				{"01:00-01:30", "wed:02:00-wed:03:00"},
+				{"01:30-02:00", "wed:02:00-wed:03:00"},
+			},
+		},
+		"no free time": {
+			input: fleetWindowsInput{
+				backupDuration:      60,
+				businessHours:       &minuteRange{start: 60, end: 30},
+				instanceIdentifiers: []string{"db1"},
+				maintenanceDays:     fleetWindowDays,
+				maintenanceDuration: 30,
+			},
+			expectedError: true,
+		},
+		"no maintenance window outside backup window": {
+			input: fleetWindowsInput{
+				backupDuration:      60,
+				businessHours:       &minuteRange{start: 60, end: 0},
+				instanceIdentifiers: []string{"db1"},
+				maintenanceDays:     fleetWindowDays,
+				maintenanceDuration: 60,
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := computeFleetWindows(test.input)
+
+			if got, want := err != nil, test.expectedError; got != want {
+				t.Fatalf("err = %v, expectedError = %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := len(got), len(test.expected); got != want {
+				t.Fatalf("len(windows) = %d, want %d", got, want)
+			}
+
+			for i, v := range got {
+				if got, want := v.InstanceIdentifier.ValueString(), test.input.instanceIdentifiers[i]; got != want {
+					t.Errorf("windows[%d].instance_identifier = %q, want %q", i, got, want)
+				}
+				if got, want := v.BackupWindow.ValueString(), test.expected[i].backup; got != want {
+					t.Errorf("windows[%d].backup_window = %q, want %q", i, got, want)
+				}
+				if got, want := v.MaintenanceWindow.ValueString(), test.expected[i].maintenance; got != want {
+					t.Errorf("windows[%d].maintenance_window = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
@@ -23,10 +23,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -123,6 +125,15 @@ func resourceGlobalCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"secondary_db_cluster_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
 			"source_db_cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -140,6 +151,8 @@ func resourceGlobalCluster() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: resourceGlobalClusterCustomizeDiff,
 	}
 }
 
@@ -200,6 +213,12 @@ func resourceGlobalClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS Global Cluster (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("secondary_db_cluster_arns"); ok && v.(*schema.Set).Len() > 0 {
+		if err := globalClusterUpdateSecondaryMembers(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set)), nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceGlobalClusterRead(ctx, d, meta)...)
 }
 
@@ -230,6 +249,11 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting global_cluster_members: %s", err)
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("secondary_db_cluster_arns", tfslices.ApplyToAll(tfslices.Filter(globalCluster.GlobalClusterMembers, func(v types.GlobalClusterMember) bool {
+		return !aws.ToBool(v.IsWriter)
+	}), func(v types.GlobalClusterMember) string {
+		return aws.ToString(v.DBClusterArn)
+	}))
 	d.Set(names.AttrStorageEncrypted, globalCluster.StorageEncrypted)
 
 	oldEngineVersion, newEngineVersion := d.Get(names.AttrEngineVersion).(string), aws.ToString(globalCluster.EngineVersion)
@@ -262,7 +286,17 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange("secondary_db_cluster_arns") {
+		o, n := d.GetChange("secondary_db_cluster_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if err := globalClusterUpdateSecondaryMembers(ctx, conn, d.Id(), add, del, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "secondary_db_cluster_arns") {
 		input := &rds.ModifyGlobalClusterInput{
 			DeletionProtection:      aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
 			GlobalClusterIdentifier: aws.String(d.Id()),
@@ -286,6 +320,22 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 	return append(diags, resourceGlobalClusterRead(ctx, d, meta)...)
 }
 
+func resourceGlobalClusterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	// secondary_db_cluster_arns is Optional+Computed, so an explicitly empty set in configuration must be planned
+	// as the removal of all secondary clusters rather than as "not configured".
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if v := diff.GetRawConfig().GetAttr("secondary_db_cluster_arns"); v.IsKnown() && !v.IsNull() && v.LengthInt() == 0 {
+		if diff.Get("secondary_db_cluster_arns").(*schema.Set).Len() > 0 {
+			return diff.SetNew("secondary_db_cluster_arns", []any{})
+		}
+	}
+
+	return nil
+}
+
 func resourceGlobalClusterDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
@@ -384,6 +434,62 @@ func resourceGlobalClusterDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+// globalClusterUpdateSecondaryMembers adds and removes secondary DB clusters.
+// The RDS API only allows a DB cluster to join a global cluster when the DB cluster is created (with GlobalClusterIdentifier),
+// so additions wait for the DB cluster to become a secondary member. Removals detach the DB cluster, which becomes a standalone cluster.
+func globalClusterUpdateSecondaryMembers(ctx context.Context, conn *rds.Client, globalClusterID string, add, del []string, timeout time.Duration) error {
+	deadline := inttypes.NewDeadline(timeout)
+
+	for _, dbClusterARN := range del {
+		input := &rds.RemoveFromGlobalClusterInput{
+			DbClusterIdentifier:     aws.String(dbClusterARN),
+			GlobalClusterIdentifier: aws.String(globalClusterID),
+		}
+
+		_, err := conn.RemoveFromGlobalCluster(ctx, input)
+
+		if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "is not found in global cluster") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("removing RDS DB Cluster (%s) from RDS Global Cluster (%s): %w", dbClusterARN, globalClusterID, err)
+		}
+
+		if _, err := waitGlobalClusterMemberRemoved(ctx, conn, dbClusterARN, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS DB Cluster (%s) removal from RDS Global Cluster (%s): %w", dbClusterARN, globalClusterID, err)
+		}
+	}
+
+	// There is no API to add an existing DB Cluster to a Global Cluster.
+	// Added ARNs must belong to DB Clusters that have already joined the Global Cluster via their global_cluster_identifier.
+	for _, dbClusterARN := range add {
+		_, err := findGlobalClusterSecondaryMemberByTwoPartKey(ctx, conn, globalClusterID, dbClusterARN)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("RDS DB Cluster (%s) is not a secondary cluster of RDS Global Cluster (%s): existing RDS DB Clusters cannot be added, attach the DB Cluster by setting its global_cluster_identifier instead", dbClusterARN, globalClusterID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading RDS Global Cluster (%s) secondary cluster (%s): %w", globalClusterID, dbClusterARN, err)
+		}
+	}
+
+	return nil
+}
+
+func findGlobalClusterSecondaryMemberByTwoPartKey(ctx context.Context, conn *rds.Client, globalClusterID, dbClusterARN string) (*types.GlobalClusterMember, error) {
+	globalCluster, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(globalCluster.GlobalClusterMembers, func(v types.GlobalClusterMember) bool {
+		return aws.ToString(v.DBClusterArn) == dbClusterARN && !aws.ToBool(v.IsWriter)
+	}))
+}
+
 func findGlobalClusterByDBClusterARN(ctx context.Context, conn *rds.Client, dbClusterARN string) (*types.GlobalCluster, error) {
 	input := &rds.DescribeGlobalClustersInput{
 		Filters: []types.Filter{
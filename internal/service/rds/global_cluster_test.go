@@ -592,6 +592,49 @@ func TestAccRDSGlobalCluster_SourceDBClusterIdentifier_databaseName(t *testing.T
 	})
 }
 
+func TestAccRDSGlobalCluster_secondaryDBClusterARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalCluster1, globalCluster2 types.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePrimary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameSecondary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckGlobalCluster(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_secondaryDBClusterARNs(rNameGlobal, rNamePrimary, rNameSecondary, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster1),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_secondaryDBClusterARNs(rNameGlobal, rNamePrimary, rNameSecondary, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secondary_db_cluster_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "secondary_db_cluster_arns.*", "aws_rds_cluster.secondary", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_secondaryDBClusterARNs(rNameGlobal, rNamePrimary, rNameSecondary, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster2),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster2),
+					resource.TestCheckResourceAttr(resourceName, "secondary_db_cluster_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSGlobalCluster_storageEncrypted(t *testing.T) {
 	ctx := acctest.Context(t)
 	var globalCluster1, globalCluster2 types.GlobalCluster
@@ -1280,6 +1323,114 @@ resource "aws_rds_global_cluster" "test" {
 `, rName)
 }
 
+func testAccGlobalClusterConfig_secondaryDBClusterARNs(rNameGlobal, rNamePrimary, rNameSecondary string, removeSecondary bool) string {
+	secondaryDBClusterARNs := ""
+	if removeSecondary {
+		secondaryDBClusterARNs = "secondary_db_cluster_arns = []"
+	}
+
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+  state    = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = "aurora-postgresql"
+  preferred_instance_classes = [%[1]s]
+  supports_clusters          = true
+  supports_global_databases  = true
+}
+
+resource "aws_rds_global_cluster" "test" {
+  global_cluster_identifier = %[2]q
+  engine                    = data.aws_rds_orderable_db_instance.test.engine
+  engine_version            = data.aws_rds_orderable_db_instance.test.engine_version
+
+  %[5]s
+}
+
+resource "aws_rds_cluster" "primary" {
+  cluster_identifier        = %[3]q
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  master_password           = "avoid-plaintext-passwords"
+  master_username           = "tfacctest"
+  skip_final_snapshot       = true
+}
+
+resource "aws_rds_cluster_instance" "primary" {
+  cluster_identifier = aws_rds_cluster.primary.id
+  engine             = aws_rds_cluster.primary.engine
+  engine_version     = aws_rds_cluster.primary.engine_version
+  identifier         = %[3]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+resource "aws_vpc" "alternate" {
+  provider   = "awsalternate"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[4]q
+  }
+}
+
+resource "aws_subnet" "alternate" {
+  provider          = "awsalternate"
+  count             = 3
+  vpc_id            = aws_vpc.alternate.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[4]q
+  }
+}
+
+resource "aws_db_subnet_group" "alternate" {
+  provider   = "awsalternate"
+  name       = %[4]q
+  subnet_ids = aws_subnet.alternate[*].id
+}
+
+resource "aws_rds_cluster" "secondary" {
+  provider                  = "awsalternate"
+  cluster_identifier        = %[4]q
+  db_subnet_group_name      = aws_db_subnet_group.alternate.name
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  skip_final_snapshot       = true
+
+  lifecycle {
+    # Removal from the global cluster is managed by aws_rds_global_cluster.secondary_db_cluster_arns.
+    ignore_changes = [
+      global_cluster_identifier,
+      replication_source_identifier,
+    ]
+  }
+
+  depends_on = [aws_rds_cluster_instance.primary]
+}
+
+resource "aws_rds_cluster_instance" "secondary" {
+  provider           = "awsalternate"
+  cluster_identifier = aws_rds_cluster.secondary.id
+  engine             = aws_rds_cluster.secondary.engine
+  engine_version     = aws_rds_cluster.secondary.engine_version
+  identifier         = %[4]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, mainInstanceClasses, rNameGlobal, rNamePrimary, rNameSecondary, secondaryDBClusterARNs))
+}
+
 func testAccGlobalClusterConfig_storageEncrypted(rName string, storageEncrypted bool) string {
 	return fmt.Sprintf(`
 resource "aws_rds_global_cluster" "test" {
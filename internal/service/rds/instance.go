@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"slices"
 	"strconv"
 	"strings"
@@ -16,13 +17,17 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,6 +37,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -320,6 +326,11 @@ func resourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"fail_on_pending_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrFinalSnapshotIdentifier: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -330,6 +341,15 @@ func resourceInstance() *schema.Resource {
 					validation.StringDoesNotMatch(regexache.MustCompile(`-$`), "cannot end in a hyphen"),
 				),
 			},
+			"final_snapshot_identifier_actual": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"final_snapshot_identifier_suffix_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(finalSnapshotIdentifierSuffixStrategy_Values(), false),
+			},
 			names.AttrHostedZoneID: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -441,6 +461,38 @@ func resourceInstance() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidKMSKeyID,
 			},
+			"master_user_secret_rotate_immediately": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"master_user_secret_rotation_rules"},
+			},
+			"master_user_secret_rotation_rules": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"manage_master_user_password"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatically_after_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ExactlyOneOf: []string{"master_user_secret_rotation_rules.0.automatically_after_days", "master_user_secret_rotation_rules.0.schedule_expression"},
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						names.AttrDuration: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9h]+`), ""),
+						},
+						names.AttrScheduleExpression: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"master_user_secret_rotation_rules.0.automatically_after_days", "master_user_secret_rotation_rules.0.schedule_expression"},
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z\(\)#\?\*\-\/, ]+`), ""),
+						},
+					},
+				},
+			},
 			"max_allocated_storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -451,6 +503,10 @@ func resourceInstance() *schema.Resource {
 					return false
 				},
 			},
+			"max_allocated_storage_utilization": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 			"monitoring_interval": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -468,6 +524,11 @@ func resourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"multi_tenant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"nchar_character_set_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -509,6 +570,34 @@ func resourceInstance() *schema.Resource {
 				Optional:     true,
 				RequiredWith: []string{"password_wo"},
 			},
+			"pending_minor_version_upgrade": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_applied_after_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_apply_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEngineVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"forced_apply_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -550,6 +639,12 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"replica_lag_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				RequiredWith: []string{"replicate_source_db"},
+			},
 			"replicate_source_db": {
 				Type:                  schema.TypeString,
 				Optional:              true,
@@ -641,6 +736,14 @@ func resourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"snapshot_engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"snapshot_identifier": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -733,6 +836,14 @@ func resourceInstance() *schema.Resource {
 				}
 				return nil
 			},
+			// A multi-tenant DB instance can't be converted back to the single-tenant configuration.
+			customdiff.ForceNewIfChange("multi_tenant", func(_ context.Context, old, new, meta any) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			resourceInstanceSnapshotRestoreCustomizeDiff,
+			resourceInstanceNetworkTypeCustomizeDiff,
+			resourceInstancePendingMinorVersionUpgradeCustomizeDiff,
+			finalSnapshotIdentifierActualCustomizeDiff,
 		),
 	}
 }
@@ -741,6 +852,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta an
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
+	if err := setFinalSnapshotIdentifierActual(d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating RDS DB Instance final snapshot identifier: %s", err)
+	}
+
 	// Some API calls (e.g. CreateDBInstanceReadReplica, RestoreDBInstanceFromDBSnapshot
 	// RestoreDBInstanceToPointInTime do not support all parameters to
 	// correctly apply all settings in one pass. For missing parameters or
@@ -1793,6 +1908,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta an
 			input.MultiAZ = aws.Bool(v.(bool))
 		}
 
+		if v, ok := d.GetOk("multi_tenant"); ok {
+			input.MultiTenant = aws.Bool(v.(bool))
+		}
+
 		if v, ok := d.GetOk("nchar_character_set_name"); ok {
 			input.NcharCharacterSetName = aws.String(v.(string))
 		}
@@ -1923,9 +2042,179 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if _, ok := d.GetOk("master_user_secret_rotation_rules"); ok && d.Get("manage_master_user_password").(bool) {
+		if err := updateInstanceMasterUserSecretRotation(ctx, conn, meta.(*conns.AWSClient).SecretsManagerClient(ctx), d); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	// Use the raw configuration so that an explicit threshold of 0 is honored.
+	if v, ok := knownConfigInt(d.GetRawConfig().GetAttr("replica_lag_threshold")); ok && d.Get("replicate_source_db").(string) != "" {
+		if err := waitDBInstanceReplicaLagBelowThreshold(ctx, meta.(*conns.AWSClient).CloudWatchClient(ctx), identifier, v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) replica lag: %s", identifier, err)
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
+// resourceInstanceSnapshotRestoreCustomizeDiff surfaces the engine and engine version of the DB snapshot
+// that a new DB instance is restored from and validates that any configured parameter and option groups
+// are compatible with the engine version that the DB instance will run.
+func resourceInstanceSnapshotRestoreCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	snapshotID, ok := knownConfigString(rawConfig.GetAttr("snapshot_identifier"))
+	if !ok {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	}
+	snapshot, err := findDBSnapshot(ctx, conn, &input, tfslices.PredicateTrue[*types.DBSnapshot]())
+
+	// The snapshot may be created in the same apply.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Snapshot (%s): %w", snapshotID, err)
+	}
+
+	engine, engineVersion := aws.ToString(snapshot.Engine), aws.ToString(snapshot.EngineVersion)
+
+	if err := d.SetNew("snapshot_engine", engine); err != nil {
+		return err
+	}
+	if err := d.SetNew("snapshot_engine_version", engineVersion); err != nil {
+		return err
+	}
+
+	parameterGroupName, hasParameterGroup := knownConfigString(rawConfig.GetAttr(names.AttrParameterGroupName))
+	optionGroupName, hasOptionGroup := knownConfigString(rawConfig.GetAttr("option_group_name"))
+	if !hasParameterGroup && !hasOptionGroup {
+		return nil
+	}
+
+	if v, ok := knownConfigString(rawConfig.GetAttr(names.AttrEngine)); ok {
+		engine = v
+	}
+	if v, ok := knownConfigString(rawConfig.GetAttr(names.AttrEngineVersion)); ok {
+		engineVersion = v
+	}
+
+	versionInput := rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+	}
+	versions, err := findDBEngineVersions(ctx, conn, &versionInput, tfslices.PredicateTrue[*types.DBEngineVersion]())
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Engine Version (%s/%s): %w", engine, engineVersion, err)
+	}
+
+	// An unknown engine version is reported by the restore.
+	if len(versions) == 0 {
+		return nil
+	}
+
+	// All matching versions (e.g. for a major engine version) share a family and major engine version.
+	family, majorEngineVersion := aws.ToString(versions[0].DBParameterGroupFamily), aws.ToString(versions[0].MajorEngineVersion)
+
+	if hasParameterGroup {
+		parameterGroup, err := findDBParameterGroupByName(ctx, conn, parameterGroupName)
+
+		// The parameter group may be created in the same apply.
+		if err != nil && !tfresource.NotFound(err) {
+			return fmt.Errorf("reading RDS DB Parameter Group (%s): %w", parameterGroupName, err)
+		}
+
+		if parameterGroup != nil && aws.ToString(parameterGroup.DBParameterGroupFamily) != family {
+			return fmt.Errorf(`"parameter_group_name" (%s) has family %s, but the DB instance restored from snapshot (%s) runs %s %s (family %s)`,
+				parameterGroupName, aws.ToString(parameterGroup.DBParameterGroupFamily), snapshotID, engine, engineVersion, family)
+		}
+	}
+
+	if hasOptionGroup {
+		optionGroup, err := findOptionGroupByName(ctx, conn, optionGroupName)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return fmt.Errorf("reading RDS Option Group (%s): %w", optionGroupName, err)
+		}
+
+		if optionGroup != nil && (aws.ToString(optionGroup.EngineName) != engine || aws.ToString(optionGroup.MajorEngineVersion) != majorEngineVersion) {
+			return fmt.Errorf(`"option_group_name" (%s) is for %s %s, but the DB instance restored from snapshot (%s) runs %s %s (major version %s)`,
+				optionGroupName, aws.ToString(optionGroup.EngineName), aws.ToString(optionGroup.MajorEngineVersion), snapshotID, engine, engineVersion, majorEngineVersion)
+		}
+	}
+
+	return nil
+}
+
+// resourceInstanceNetworkTypeCustomizeDiff validates that the DB subnet group used by the DB instance
+// supports the configured network type, e.g. that all its subnets have IPv6 CIDR blocks for DUAL.
+// The check is skipped if the caller isn't allowed to describe DB subnet groups.
+func resourceInstanceNetworkTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() != "" && !d.HasChanges("db_subnet_group_name", "network_type") {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	networkType, ok := knownConfigString(rawConfig.GetAttr("network_type"))
+	if !ok {
+		return nil
+	}
+	subnetGroupName, ok := knownConfigString(rawConfig.GetAttr("db_subnet_group_name"))
+	if !ok {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	subnetGroup, err := findDBSubnetGroupByName(ctx, conn, subnetGroupName)
+
+	// The subnet group may be created in the same apply.
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Subnet Group (%s): %w", subnetGroupName, err)
+	}
+
+	if supportedNetworkTypes := subnetGroup.SupportedNetworkTypes; len(supportedNetworkTypes) > 0 && !slices.Contains(supportedNetworkTypes, networkType) {
+		return fmt.Errorf(`"network_type" (%s) is not supported by DB subnet group (%s), which supports %s`, networkType, subnetGroupName, strings.Join(supportedNetworkTypes, ", "))
+	}
+
+	return nil
+}
+
+// knownConfigString returns the value of a known, non-null and non-empty string configuration value.
+func knownConfigString(v cty.Value) (string, bool) {
+	if !v.IsKnown() || v.IsNull() || v.AsString() == "" {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+func knownConfigInt(v cty.Value) (int, bool) {
+	if !v.IsKnown() || v.IsNull() {
+		return 0, false
+	}
+
+	i, _ := v.AsBigFloat().Int64()
+
+	return int(i), true
+}
+
 func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
@@ -2025,10 +2314,28 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		d.Set("master_user_secret", nil)
 	}
 
+	// Only track the rotation schedule of the RDS-managed secret if it's being managed by this resource.
+	if _, ok := d.GetOk("master_user_secret_rotation_rules"); ok && v.MasterUserSecret != nil {
+		secret, err := tfsecretsmanager.FindSecretByID(ctx, meta.(*conns.AWSClient).SecretsManagerClient(ctx), aws.ToString(v.MasterUserSecret.SecretArn))
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("master_user_secret_rotation_rules", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading RDS DB Instance (%s) master user secret: %s", d.Get(names.AttrIdentifier).(string), err)
+		default:
+			if err := d.Set("master_user_secret_rotation_rules", tfsecretsmanager.FlattenRotationRules(secret.RotationRules)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting master_user_secret_rotation_rules: %s", err)
+			}
+		}
+	}
+
 	d.Set("max_allocated_storage", v.MaxAllocatedStorage)
+	d.Set("max_allocated_storage_utilization", maxAllocatedStorageUtilization(v))
 	d.Set("monitoring_interval", v.MonitoringInterval)
 	d.Set("monitoring_role_arn", v.MonitoringRoleArn)
 	d.Set("multi_az", v.MultiAZ)
+	d.Set("multi_tenant", v.MultiTenant)
 	d.Set("nchar_character_set_name", v.NcharCharacterSetName)
 	d.Set("network_type", v.NetworkType)
 	if len(v.OptionGroupMemberships) > 0 {
@@ -2093,6 +2400,20 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	dbSetResourceDataEngineVersionFromInstance(d, v)
 
+	// The pending upgrade is only looked up when it's checked, so the additional permissions aren't otherwise required.
+	var upgrade *pendingMinorVersionUpgrade
+	if d.Get("fail_on_pending_minor_version_upgrade").(bool) {
+		upgrade, err = findPendingMinorVersionUpgrade(ctx, conn, v)
+
+		if err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "reading RDS DB Instance (%s) pending minor version upgrade: %s", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("pending_minor_version_upgrade", flattenPendingMinorVersionUpgrade(upgrade)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pending_minor_version_upgrade: %s", err)
+	}
+
 	setTagsOut(ctx, v.TagList)
 
 	return diags
@@ -2103,6 +2424,12 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 	deadline := inttypes.NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
+	if d.HasChanges(names.AttrFinalSnapshotIdentifier, "final_snapshot_identifier_suffix_strategy") {
+		if err := setFinalSnapshotIdentifierActual(d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "generating RDS DB Instance (%s) final snapshot identifier: %s", d.Get(names.AttrIdentifier).(string), err)
+		}
+	}
+
 	// Separate request to promote a database.
 	if d.HasChange("replicate_source_db") {
 		if d.Get("replicate_source_db").(string) == "" {
@@ -2135,7 +2462,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		names.AttrAllowMajorVersionUpgrade,
 		"blue_green_update",
 		"delete_automated_backups",
+		"fail_on_pending_minor_version_upgrade",
 		names.AttrFinalSnapshotIdentifier,
+		"final_snapshot_identifier_actual",
+		"final_snapshot_identifier_suffix_strategy",
+		"master_user_secret_rotate_immediately",
+		"master_user_secret_rotation_rules",
+		"replica_lag_threshold",
 		"replicate_source_db",
 		"skip_final_snapshot",
 		names.AttrTags, names.AttrTagsAll,
@@ -2144,7 +2477,11 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			names.AttrAllowMajorVersionUpgrade,
 			"blue_green_update",
 			"delete_automated_backups",
+			"fail_on_pending_minor_version_upgrade",
 			names.AttrFinalSnapshotIdentifier,
+			"master_user_secret_rotate_immediately",
+			"master_user_secret_rotation_rules",
+			"replica_lag_threshold",
 			"replicate_source_db",
 			"skip_final_snapshot",
 			names.AttrTags, names.AttrTagsAll,
@@ -2340,6 +2677,21 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if d.HasChanges("manage_master_user_password", "master_user_secret_rotation_rules") {
+		if _, ok := d.GetOk("master_user_secret_rotation_rules"); ok && d.Get("manage_master_user_password").(bool) {
+			if err := updateInstanceMasterUserSecretRotation(ctx, conn, meta.(*conns.AWSClient).SecretsManagerClient(ctx), d); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	if v, ok := knownConfigInt(d.GetRawConfig().GetAttr("replica_lag_threshold")); ok && d.Get("replicate_source_db").(string) != "" {
+		id := d.Get(names.AttrIdentifier).(string)
+		if err := waitDBInstanceReplicaLagBelowThreshold(ctx, meta.(*conns.AWSClient).CloudWatchClient(ctx), id, v, deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) replica lag: %s", id, err)
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
@@ -2357,8 +2709,8 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta an
 	} else {
 		input.SkipFinalSnapshot = aws.Bool(false)
 
-		if v, ok := d.GetOk(names.AttrFinalSnapshotIdentifier); ok {
-			input.FinalDBSnapshotIdentifier = aws.String(v.(string))
+		if v := finalSnapshotIdentifierActual(d); v != "" {
+			input.FinalDBSnapshotIdentifier = aws.String(v)
 		} else {
 			return sdkdiag.AppendErrorf(diags, "final_snapshot_identifier is required when skip_final_snapshot is false")
 		}
@@ -2425,6 +2777,7 @@ func resourceInstanceImport(_ context.Context, d *schema.ResourceData, meta any)
 	// that final_snapshot_identifier is not required.
 	d.Set("skip_final_snapshot", true)
 	d.Set("delete_automated_backups", true)
+	d.Set("fail_on_pending_minor_version_upgrade", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -2606,6 +2959,11 @@ func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.Resour
 		input.MultiAZ = aws.Bool(d.Get("multi_az").(bool))
 	}
 
+	if d.HasChange("multi_tenant") {
+		needsModify = true
+		input.MultiTenant = aws.Bool(d.Get("multi_tenant").(bool))
+	}
+
 	if d.HasChange("network_type") {
 		needsModify = true
 		input.NetworkType = aws.String(d.Get("network_type").(string))
@@ -2868,6 +3226,18 @@ func findDBInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeD
 	return output, nil
 }
 
+// maxAllocatedStorageUtilization returns the DB instance's allocated storage as a percentage of its maximum allocated storage,
+// or 0 if storage autoscaling is not enabled.
+func maxAllocatedStorageUtilization(v *types.DBInstance) float64 {
+	allocatedStorage, maxAllocatedStorage := aws.ToInt32(v.AllocatedStorage), aws.ToInt32(v.MaxAllocatedStorage)
+
+	if maxAllocatedStorage == 0 {
+		return 0
+	}
+
+	return math.Round(float64(allocatedStorage)/float64(maxAllocatedStorage)*10000) / 100
+}
+
 func statusDBInstance(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findDBInstanceByID(ctx, conn, id, optFns...)
@@ -3005,6 +3375,65 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.Client, id string, tim
 	return nil, err
 }
 
+// findDBInstanceReplicaLag returns the most recent value, in seconds, of the CloudWatch ReplicaLag metric for the specified read replica.
+func findDBInstanceReplicaLag(ctx context.Context, conn *cloudwatch.Client, id string) (float64, error) {
+	now := time.Now()
+	input := cloudwatch.GetMetricStatisticsInput{
+		Dimensions: []cwtypes.Dimension{
+			{
+				Name:  aws.String("DBInstanceIdentifier"),
+				Value: aws.String(id),
+			},
+		},
+		EndTime:    aws.Time(now),
+		MetricName: aws.String("ReplicaLag"),
+		Namespace:  aws.String("AWS/RDS"),
+		Period:     aws.Int32(60),
+		StartTime:  aws.Time(now.Add(-5 * time.Minute)),
+		Statistics: []cwtypes.Statistic{cwtypes.StatisticMaximum},
+	}
+
+	output, err := conn.GetMetricStatistics(ctx, &input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil || len(output.Datapoints) == 0 {
+		return 0, tfresource.NewEmptyResultError(input)
+	}
+
+	latest := slices.MaxFunc(output.Datapoints, func(a, b cwtypes.Datapoint) int {
+		return aws.ToTime(a.Timestamp).Compare(aws.ToTime(b.Timestamp))
+	})
+
+	return aws.ToFloat64(latest.Maximum), nil
+}
+
+// waitDBInstanceReplicaLagBelowThreshold waits for the specified read replica's lag to be at or below the threshold (in seconds).
+// ReplicaLag is reported as -1 while replication isn't running, which is treated as not caught up.
+func waitDBInstanceReplicaLagBelowThreshold(ctx context.Context, conn *cloudwatch.Client, id string, threshold int, timeout time.Duration) error {
+	checkFunc := func(ctx context.Context) (bool, error) {
+		lag, err := findDBInstanceReplicaLag(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return lag >= 0 && lag <= float64(threshold), nil
+	}
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                30 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
+
 func findBlueGreenDeploymentByID(ctx context.Context, conn *rds.Client, id string) (*types.BlueGreenDeployment, error) {
 	input := &rds.DescribeBlueGreenDeploymentsInput{
 		BlueGreenDeploymentIdentifier: aws.String(id),
@@ -3191,6 +3620,40 @@ func flattenEndpoint(apiObject *types.Endpoint) map[string]any {
 	return tfMap
 }
 
+// updateInstanceMasterUserSecretRotation applies the configured rotation schedule to the
+// Secrets Manager secret that RDS manages for the DB instance's master user password.
+func updateInstanceMasterUserSecretRotation(ctx context.Context, conn *rds.Client, secretsManagerConn *secretsmanager.Client, d *schema.ResourceData) error {
+	id := d.Get(names.AttrIdentifier).(string)
+	instance, err := findDBInstanceByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Instance (%s): %w", id, err)
+	}
+
+	if instance.MasterUserSecret == nil {
+		return fmt.Errorf("RDS DB Instance (%s) has no master user secret", id)
+	}
+
+	secretARN := aws.ToString(instance.MasterUserSecret.SecretArn)
+	input := secretsmanager.RotateSecretInput{
+		ClientRequestToken: aws.String(sdkid.UniqueId()), // Needed because we're handling our own retries
+		RotateImmediately:  aws.Bool(d.Get("master_user_secret_rotate_immediately").(bool)),
+		RotationRules:      tfsecretsmanager.ExpandRotationRules(d.Get("master_user_secret_rotation_rules").([]any)),
+		SecretId:           aws.String(secretARN),
+	}
+
+	// InvalidRequestException: A previous rotation isn't complete. That rotation will be reattempted.
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (any, error) {
+		return secretsManagerConn.RotateSecret(ctx, &input)
+	}, "InvalidRequestException")
+
+	if err != nil {
+		return fmt.Errorf("updating RDS DB Instance (%s) master user secret (%s) rotation: %w", id, secretARN, err)
+	}
+
+	return nil
+}
+
 func startInstance(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) error {
 	var err error
 
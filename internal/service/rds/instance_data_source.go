@@ -6,6 +6,9 @@ package rds
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -118,6 +122,10 @@ func dataSourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"latest_storage_autoscaling_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"license_model": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -150,6 +158,10 @@ func dataSourceInstance() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"max_allocated_storage_utilization": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 			"monitoring_interval": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -283,6 +295,12 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta an
 	d.Set(names.AttrEngineVersion, instance.EngineVersion)
 	d.Set(names.AttrIOPS, instance.Iops)
 	d.Set(names.AttrKMSKeyID, instance.KmsKeyId)
+	// The event lookup requires the additional rds:DescribeEvents permission, so failures are reported as warnings.
+	latestStorageAutoscaling, err := latestStorageAutoscalingTime(ctx, conn, instance)
+	if err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading RDS DB Instance (%s) storage autoscaling events: %s", d.Id(), err)
+	}
+	d.Set("latest_storage_autoscaling_time", latestStorageAutoscaling)
 	d.Set("license_model", instance.LicenseModel)
 	d.Set("master_username", instance.MasterUsername)
 	if instance.MasterUserSecret != nil {
@@ -291,6 +309,7 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 	d.Set("max_allocated_storage", instance.MaxAllocatedStorage)
+	d.Set("max_allocated_storage_utilization", maxAllocatedStorageUtilization(instance))
 	d.Set("monitoring_interval", instance.MonitoringInterval)
 	d.Set("monitoring_role_arn", instance.MonitoringRoleArn)
 	d.Set("multi_az", instance.MultiAZ)
@@ -329,3 +348,80 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta an
 
 	return diags
 }
+
+// latestStorageAutoscalingTime returns the time of the DB instance's most recent storage autoscaling event in RFC 3339 format.
+// RDS only retains events for 14 days, so an empty string is returned if there was no storage autoscaling event in that period.
+func latestStorageAutoscalingTime(ctx context.Context, conn *rds.Client, v *types.DBInstance) (string, error) {
+	if aws.ToInt32(v.MaxAllocatedStorage) == 0 {
+		return "", nil
+	}
+
+	event, err := findLatestStorageAutoscalingEventByDBInstanceID(ctx, conn, aws.ToString(v.DBInstanceIdentifier))
+
+	if tfresource.NotFound(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToTime(event.Date).Format(time.RFC3339), nil
+}
+
+const (
+	// eventsRetentionPeriod is the maximum period, in minutes, for which RDS returns events.
+	eventsRetentionPeriod = 14 * 24 * 60
+	// eventCategoryNotification is the category of RDS-EVENT-0217 and RDS-EVENT-0218.
+	eventCategoryNotification = "notification"
+	// storageAutoscalingEventMessage is contained in the messages of RDS-EVENT-0217 and RDS-EVENT-0218.
+	storageAutoscalingEventMessage = "autoscaling-initiated modification to allocated storage"
+)
+
+func findLatestStorageAutoscalingEventByDBInstanceID(ctx context.Context, conn *rds.Client, id string) (*types.Event, error) {
+	input := &rds.DescribeEventsInput{
+		Duration:         aws.Int32(eventsRetentionPeriod),
+		EventCategories:  []string{eventCategoryNotification},
+		MaxRecords:       aws.Int32(100),
+		SourceIdentifier: aws.String(id),
+		SourceType:       types.SourceTypeDbInstance,
+	}
+	output, err := findEvents(ctx, conn, input, func(v *types.Event) bool {
+		return v.Date != nil && strings.Contains(strings.ToLower(aws.ToString(v.Message)), storageAutoscalingEventMessage)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	event := slices.MaxFunc(output, func(a, b types.Event) int {
+		return aws.ToTime(a.Date).Compare(aws.ToTime(b.Date))
+	})
+
+	return &event, nil
+}
+
+func findEvents(ctx context.Context, conn *rds.Client, input *rds.DescribeEventsInput, filter tfslices.Predicate[*types.Event]) ([]types.Event, error) {
+	var output []types.Event
+
+	pages := rds.NewDescribeEventsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Events {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
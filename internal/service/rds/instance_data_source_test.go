@@ -50,6 +50,7 @@ func TestAccRDSInstanceDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrIOPS, resourceName, names.AttrIOPS),
 					resource.TestCheckResourceAttrPair(dataSourceName, "master_username", resourceName, names.AttrUsername),
 					resource.TestCheckResourceAttrPair(dataSourceName, "max_allocated_storage", resourceName, "max_allocated_storage"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_allocated_storage_utilization", resourceName, "max_allocated_storage_utilization"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az", resourceName, "multi_az"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "network_type", resourceName, "network_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrPort, resourceName, names.AttrPort),
@@ -144,6 +145,7 @@ func TestAccRDSInstanceDataSource_matchTags(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrIOPS, resourceName, names.AttrIOPS),
 					resource.TestCheckResourceAttrPair(dataSourceName, "master_username", resourceName, names.AttrUsername),
 					resource.TestCheckResourceAttrPair(dataSourceName, "max_allocated_storage", resourceName, "max_allocated_storage"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_allocated_storage_utilization", resourceName, "max_allocated_storage_utilization"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az", resourceName, "multi_az"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "network_type", resourceName, "network_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrPort, resourceName, names.AttrPort),
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	pendingMaintenanceActionDBUpgrade = "db-upgrade"
+)
+
+// pendingMinorVersionUpgrade is an automatic minor version upgrade that RDS has scheduled for a DB instance.
+type pendingMinorVersionUpgrade struct {
+	action        types.PendingMaintenanceAction
+	engineVersion string
+}
+
+// findPendingMinorVersionUpgrade returns the automatic minor version upgrade pending for the specified DB instance, if any.
+// The target engine version is the engine version's automatic upgrade target, as the pending maintenance action doesn't include it.
+func findPendingMinorVersionUpgrade(ctx context.Context, conn *rds.Client, instance *types.DBInstance) (*pendingMinorVersionUpgrade, error) {
+	if !aws.ToBool(instance.AutoMinorVersionUpgrade) {
+		return nil, nil
+	}
+
+	actions, err := findPendingMaintenanceActionsByResourceARN(ctx, conn, aws.ToString(instance.DBInstanceArn))
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(actions, func(v types.PendingMaintenanceAction) bool {
+		return aws.ToString(v.Action) == pendingMaintenanceActionDBUpgrade
+	})
+
+	if i == -1 {
+		return nil, nil
+	}
+
+	input := rds.DescribeDBEngineVersionsInput{
+		Engine:        instance.Engine,
+		EngineVersion: instance.EngineVersion,
+	}
+	engineVersion, err := findDBEngineVersion(ctx, conn, &input, tfslices.PredicateTrue[*types.DBEngineVersion]())
+
+	switch {
+	case tfresource.NotFound(err):
+		engineVersion = &types.DBEngineVersion{}
+	case err != nil:
+		return nil, fmt.Errorf("reading RDS DB Engine Version (%s/%s): %w", aws.ToString(instance.Engine), aws.ToString(instance.EngineVersion), err)
+	}
+
+	upgrade := &pendingMinorVersionUpgrade{
+		action: actions[i],
+	}
+
+	if i := slices.IndexFunc(engineVersion.ValidUpgradeTarget, func(v types.UpgradeTarget) bool {
+		return aws.ToBool(v.AutoUpgrade) && !aws.ToBool(v.IsMajorVersionUpgrade)
+	}); i != -1 {
+		upgrade.engineVersion = aws.ToString(engineVersion.ValidUpgradeTarget[i].EngineVersion)
+	}
+
+	return upgrade, nil
+}
+
+func flattenPendingMinorVersionUpgrade(apiObject *pendingMinorVersionUpgrade) []any {
+	if apiObject == nil {
+		return []any{}
+	}
+
+	tfMap := map[string]any{
+		"auto_applied_after_date": flattenOptionalTime(apiObject.action.AutoAppliedAfterDate),
+		"current_apply_date":      flattenOptionalTime(apiObject.action.CurrentApplyDate),
+		names.AttrDescription:     aws.ToString(apiObject.action.Description),
+		names.AttrEngineVersion:   apiObject.engineVersion,
+		"forced_apply_date":       flattenOptionalTime(apiObject.action.ForcedApplyDate),
+	}
+
+	return []any{tfMap}
+}
+
+func flattenOptionalTime(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+
+	return aws.ToTime(v).Format(time.RFC3339)
+}
+
+// resourceInstancePendingMinorVersionUpgradeCustomizeDiff fails the plan when fail_on_pending_minor_version_upgrade is set
+// and RDS has scheduled an automatic minor version upgrade that the configuration doesn't account for.
+func resourceInstancePendingMinorVersionUpgradeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.Get("fail_on_pending_minor_version_upgrade").(bool) {
+		return nil
+	}
+
+	// Disabling automatic minor version upgrades prevents the pending upgrade.
+	if !d.Get(names.AttrAutoMinorVersionUpgrade).(bool) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	instance, err := findDBInstanceByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS DB Instance (%s): %w", d.Id(), err)
+	}
+
+	upgrade, err := findPendingMinorVersionUpgrade(ctx, conn, instance)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
+			return fmt.Errorf(`"fail_on_pending_minor_version_upgrade" requires permission to read RDS pending maintenance actions and DB engine versions: %w`, err)
+		}
+
+		return fmt.Errorf("reading RDS DB Instance (%s) pending minor version upgrade: %w", d.Id(), err)
+	}
+
+	if upgrade == nil {
+		return nil
+	}
+
+	// Upgrading to the target engine version in this apply makes the pending upgrade a no-op.
+	if upgrade.engineVersion != "" && d.HasChange(names.AttrEngineVersion) {
+		// A configured version may omit the patch value, e.g. "15" or "15.4" for "15.4.1".
+		if v, ok := knownConfigString(d.GetRawConfig().GetAttr(names.AttrEngineVersion)); ok && (v == upgrade.engineVersion || strings.HasPrefix(upgrade.engineVersion, v+".")) {
+			return nil
+		}
+	}
+
+	targetEngineVersion := upgrade.engineVersion
+	if targetEngineVersion == "" {
+		targetEngineVersion = "(unknown)"
+	}
+
+	return fmt.Errorf(`RDS DB Instance (%s) has a pending automatic minor version upgrade to engine version %s (%s), applied after %s; set "engine_version" to the target version or "auto_minor_version_upgrade" to false, or unset "fail_on_pending_minor_version_upgrade"`,
+		d.Id(), targetEngineVersion, aws.ToString(upgrade.action.Description), flattenOptionalTime(upgrade.action.AutoAppliedAfterDate))
+}
@@ -0,0 +1,746 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of read replicas of a source DB instance.
+	instanceReplicaFleetMaxReplicas = 15
+)
+
+// @FrameworkResource("aws_db_instance_replica_fleet", name="DB Instance Replica Fleet")
+func newInstanceReplicaFleetResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &instanceReplicaFleetResource{}
+
+	r.SetDefaultCreateTimeout(40 * time.Minute)
+	r.SetDefaultUpdateTimeout(80 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type instanceReplicaFleetResource struct {
+	framework.ResourceWithModel[instanceReplicaFleetResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *instanceReplicaFleetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"identifier_prefix": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_class": schema.StringAttribute{
+				Required: true,
+			},
+			"replica_count": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, instanceReplicaFleetMaxReplicas),
+				},
+			},
+			"replicas": framework.ResourceComputedListOfObjectsAttribute[instanceReplicaFleetReplicaModel](ctx),
+			"source_db_instance_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"replica_override": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[instanceReplicaFleetReplicaOverrideModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, instanceReplicaFleetMaxReplicas-1),
+							},
+						},
+						"instance_class": schema.StringAttribute{
+							Optional: true,
+						},
+						"promotion_tier": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 15),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *instanceReplicaFleetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data instanceReplicaFleetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	specs, diags := expandInstanceReplicaFleetReplicaSpecs(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	identifierPrefix := data.IdentifierPrefix.ValueString()
+	data.ID = fwflex.StringValueToFramework(ctx, identifierPrefix)
+
+	deadline := inttypes.NewDeadline(r.CreateTimeout(ctx, data.Timeouts))
+	if err := reconcileInstanceReplicaFleet(ctx, conn, data.SourceDBInstanceIdentifier.ValueString(), specs, nil, deadline); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS DB Instance Replica Fleet (%s)", identifierPrefix), err.Error())
+
+		// Save state so that any replicas that were created are tracked and deleted when the resource is replaced.
+		data.Replicas = fwtypes.NewListNestedObjectValueOfNull[instanceReplicaFleetReplicaModel](ctx)
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	output, err := findInstanceReplicaFleetReplicas(ctx, conn, identifierPrefix, len(specs))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS DB Instance Replica Fleet (%s)", identifierPrefix), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Replicas = flattenInstanceReplicaFleetReplicas(ctx, identifierPrefix, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *instanceReplicaFleetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data instanceReplicaFleetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	identifierPrefix := data.IdentifierPrefix.ValueString()
+	n, diags := instanceReplicaFleetIndexUpperBound(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findInstanceReplicaFleetReplicas(ctx, conn, identifierPrefix, n)
+
+	if err == nil && len(output) == 0 {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS DB Instance Replica Fleet (%s)", identifierPrefix), err.Error())
+
+		return
+	}
+
+	// Missing replicas show as a change in replica_count and are recreated on the next apply.
+	data.ReplicaCount = fwflex.Int64ValueToFramework(ctx, int64(len(output)))
+	data.Replicas = flattenInstanceReplicaFleetReplicas(ctx, identifierPrefix, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *instanceReplicaFleetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new instanceReplicaFleetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	specs, diags := expandInstanceReplicaFleetReplicaSpecs(ctx, &new)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	n, diags := instanceReplicaFleetIndexUpperBound(ctx, &old)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	identifierPrefix := new.IdentifierPrefix.ValueString()
+	existing, err := findInstanceReplicaFleetReplicas(ctx, conn, identifierPrefix, max(n, len(specs)))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS DB Instance Replica Fleet (%s)", identifierPrefix), err.Error())
+
+		return
+	}
+
+	deadline := inttypes.NewDeadline(r.UpdateTimeout(ctx, new.Timeouts))
+	if err := reconcileInstanceReplicaFleet(ctx, conn, new.SourceDBInstanceIdentifier.ValueString(), specs, existing, deadline); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating RDS DB Instance Replica Fleet (%s)", identifierPrefix), err.Error())
+
+		return
+	}
+
+	output, err := findInstanceReplicaFleetReplicas(ctx, conn, identifierPrefix, len(specs))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS DB Instance Replica Fleet (%s)", identifierPrefix), err.Error())
+
+		return
+	}
+
+	new.Replicas = flattenInstanceReplicaFleetReplicas(ctx, identifierPrefix, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *instanceReplicaFleetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data instanceReplicaFleetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	n, diags := instanceReplicaFleetIndexUpperBound(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	identifierPrefix := data.IdentifierPrefix.ValueString()
+	ids := instanceReplicaFleetReplicaIdentifiers(identifierPrefix, n)
+
+	if err := forEachInstanceReplicaFleetReplica(ids, func(id string) error {
+		return deleteInstanceReplicaFleetReplica(ctx, conn, id)
+	}); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting RDS DB Instance Replica Fleet (%s)", identifierPrefix), err.Error())
+
+		return
+	}
+
+	if _, err := waitDBInstancesDeleted(ctx, conn, ids, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS DB Instance Replica Fleet (%s) delete", identifierPrefix), err.Error())
+
+		return
+	}
+}
+
+// instanceReplicaFleetReplicaSpec is the desired configuration of a single replica in a fleet.
+type instanceReplicaFleetReplicaSpec struct {
+	identifier    string
+	instanceClass string
+	promotionTier *int32
+}
+
+func expandInstanceReplicaFleetReplicaSpecs(ctx context.Context, data *instanceReplicaFleetResourceModel) ([]instanceReplicaFleetReplicaSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	n := int(data.ReplicaCount.ValueInt64())
+	specs := make([]instanceReplicaFleetReplicaSpec, n)
+	for i, id := range instanceReplicaFleetReplicaIdentifiers(data.IdentifierPrefix.ValueString(), n) {
+		specs[i] = instanceReplicaFleetReplicaSpec{
+			identifier:    id,
+			instanceClass: data.InstanceClass.ValueString(),
+		}
+	}
+
+	overrides, d := data.ReplicaOverrides.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, override := range overrides {
+		index := int(override.Index.ValueInt64())
+		if index >= n {
+			diags.AddError("invalid replica_override", fmt.Sprintf("index (%d) must be less than replica_count (%d)", index, n))
+
+			continue
+		}
+
+		if v := override.InstanceClass.ValueString(); v != "" {
+			specs[index].instanceClass = v
+		}
+
+		if !override.PromotionTier.IsNull() {
+			specs[index].promotionTier = fwflex.Int32FromFrameworkInt64(ctx, override.PromotionTier)
+		}
+	}
+
+	return specs, diags
+}
+
+// reconcileInstanceReplicaFleet creates, modifies and deletes replicas so that the fleet matches the desired specifications.
+// All replicas are changed in parallel and a single waiter polls the status of the whole fleet.
+func reconcileInstanceReplicaFleet(ctx context.Context, conn *rds.Client, sourceDBInstanceID string, specs []instanceReplicaFleetReplicaSpec, existing []awstypes.DBInstance, deadline inttypes.Deadline) error {
+	existingByID := make(map[string]awstypes.DBInstance, len(existing))
+	for _, v := range existing {
+		existingByID[aws.ToString(v.DBInstanceIdentifier)] = v
+	}
+
+	var create, modify []instanceReplicaFleetReplicaSpec
+	for _, spec := range specs {
+		v, ok := existingByID[spec.identifier]
+		if !ok {
+			create = append(create, spec)
+
+			continue
+		}
+
+		delete(existingByID, spec.identifier)
+
+		if aws.ToString(v.DBInstanceClass) != spec.instanceClass || (spec.promotionTier != nil && aws.ToInt32(spec.promotionTier) != aws.ToInt32(v.PromotionTier)) {
+			modify = append(modify, spec)
+		}
+	}
+
+	// Any remaining replicas are no longer wanted.
+	var remove []string
+	for id := range existingByID {
+		remove = append(remove, id)
+	}
+
+	var createErr, modifyErr, removeErr error
+	var wg sync.WaitGroup
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+
+		createErr = forEachInstanceReplicaFleetReplica(create, func(spec instanceReplicaFleetReplicaSpec) error {
+			return createInstanceReplicaFleetReplica(ctx, conn, sourceDBInstanceID, spec, deadline.Remaining())
+		})
+	}()
+	go func() {
+		defer wg.Done()
+
+		modifyErr = forEachInstanceReplicaFleetReplica(modify, func(spec instanceReplicaFleetReplicaSpec) error {
+			return modifyInstanceReplicaFleetReplica(ctx, conn, spec, deadline.Remaining())
+		})
+	}()
+	go func() {
+		defer wg.Done()
+
+		removeErr = forEachInstanceReplicaFleetReplica(remove, func(id string) error {
+			return deleteInstanceReplicaFleetReplica(ctx, conn, id)
+		})
+	}()
+	wg.Wait()
+
+	if err := errors.Join(createErr, modifyErr, removeErr); err != nil {
+		return err
+	}
+
+	if ids := tfslices.ApplyToAll(append(create, modify...), func(spec instanceReplicaFleetReplicaSpec) string { return spec.identifier }); len(ids) > 0 {
+		if _, err := waitDBInstancesAvailable(ctx, conn, ids, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for replicas (%s) available: %w", strings.Join(ids, ", "), err)
+		}
+	}
+
+	if len(remove) > 0 {
+		if _, err := waitDBInstancesDeleted(ctx, conn, remove, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for replicas (%s) delete: %w", strings.Join(remove, ", "), err)
+		}
+	}
+
+	// The promotion tier can't be set when a read replica is created.
+	create = tfslices.Filter(create, func(spec instanceReplicaFleetReplicaSpec) bool { return spec.promotionTier != nil })
+	if len(create) == 0 {
+		return nil
+	}
+
+	if err := forEachInstanceReplicaFleetReplica(create, func(spec instanceReplicaFleetReplicaSpec) error {
+		return modifyInstanceReplicaFleetReplica(ctx, conn, spec, deadline.Remaining())
+	}); err != nil {
+		return err
+	}
+
+	ids := tfslices.ApplyToAll(create, func(spec instanceReplicaFleetReplicaSpec) string { return spec.identifier })
+	if _, err := waitDBInstancesAvailable(ctx, conn, ids, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for replicas (%s) available: %w", strings.Join(ids, ", "), err)
+	}
+
+	return nil
+}
+
+// forEachInstanceReplicaFleetReplica calls f concurrently for each element and returns the combined errors.
+func forEachInstanceReplicaFleetReplica[T any](s []T, f func(T) error) error {
+	results := make([]error, len(s))
+	var wg sync.WaitGroup
+
+	for i, v := range s {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			results[i] = f(v)
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(results...)
+}
+
+func createInstanceReplicaFleetReplica(ctx context.Context, conn *rds.Client, sourceDBInstanceID string, spec instanceReplicaFleetReplicaSpec, timeout time.Duration) error {
+	input := rds.CreateDBInstanceReadReplicaInput{
+		DBInstanceClass:            aws.String(spec.instanceClass),
+		DBInstanceIdentifier:       aws.String(spec.identifier),
+		SourceDBInstanceIdentifier: aws.String(sourceDBInstanceID),
+	}
+
+	// The source DB instance may be busy creating another replica.
+	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidDBInstanceStateFault](ctx, timeout, func() (any, error) {
+		return conn.CreateDBInstanceReadReplica(ctx, &input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("creating replica (%s): %w", spec.identifier, err)
+	}
+
+	return nil
+}
+
+func modifyInstanceReplicaFleetReplica(ctx context.Context, conn *rds.Client, spec instanceReplicaFleetReplicaSpec, timeout time.Duration) error {
+	input := rds.ModifyDBInstanceInput{
+		ApplyImmediately:     aws.Bool(true),
+		DBInstanceClass:      aws.String(spec.instanceClass),
+		DBInstanceIdentifier: aws.String(spec.identifier),
+		PromotionTier:        spec.promotionTier,
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidDBInstanceStateFault](ctx, timeout, func() (any, error) {
+		return conn.ModifyDBInstance(ctx, &input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("modifying replica (%s): %w", spec.identifier, err)
+	}
+
+	return nil
+}
+
+func deleteInstanceReplicaFleetReplica(ctx context.Context, conn *rds.Client, id string) error {
+	input := rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier:   aws.String(id),
+		DeleteAutomatedBackups: aws.Bool(true),
+		SkipFinalSnapshot:      aws.Bool(true),
+	}
+
+	_, err := conn.DeleteDBInstance(ctx, &input)
+
+	if errs.IsA[*awstypes.DBInstanceNotFoundFault](err) {
+		return nil
+	}
+
+	if err != nil && !errs.IsAErrorMessageContains[*awstypes.InvalidDBInstanceStateFault](err, "is already being deleted") {
+		return fmt.Errorf("deleting replica (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+// instanceReplicaFleetReplicaIdentifiers returns the identifiers of the replicas with indexes [0, n).
+func instanceReplicaFleetReplicaIdentifiers(identifierPrefix string, n int) []string {
+	ids := make([]string, n)
+	for i := range n {
+		ids[i] = fmt.Sprintf("%s-%d", identifierPrefix, i)
+	}
+
+	return ids
+}
+
+// instanceReplicaFleetReplicaIndex returns the index of the replica with the specified identifier.
+func instanceReplicaFleetReplicaIndex(identifierPrefix, id string) (int, bool) {
+	v, ok := strings.CutPrefix(id, identifierPrefix+"-")
+	if !ok {
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+
+	return index, true
+}
+
+// instanceReplicaFleetIndexUpperBound returns one more than the highest replica index recorded in state.
+func instanceReplicaFleetIndexUpperBound(ctx context.Context, data *instanceReplicaFleetResourceModel) (int, diag.Diagnostics) {
+	n := int(data.ReplicaCount.ValueInt64())
+
+	replicas, diags := data.Replicas.ToSlice(ctx)
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	for _, v := range replicas {
+		n = max(n, int(v.Index.ValueInt64())+1)
+	}
+
+	return n, diags
+}
+
+func findInstanceReplicaFleetReplicas(ctx context.Context, conn *rds.Client, identifierPrefix string, n int) ([]awstypes.DBInstance, error) {
+	if n == 0 {
+		return nil, nil
+	}
+
+	output, err := findDBInstancesByIDs(ctx, conn, instanceReplicaFleetReplicaIdentifiers(identifierPrefix, n))
+
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(output, func(a, b awstypes.DBInstance) int {
+		i, _ := instanceReplicaFleetReplicaIndex(identifierPrefix, aws.ToString(a.DBInstanceIdentifier))
+		j, _ := instanceReplicaFleetReplicaIndex(identifierPrefix, aws.ToString(b.DBInstanceIdentifier))
+
+		return cmp.Compare(i, j)
+	})
+
+	return output, nil
+}
+
+// findDBInstancesByIDs returns the DB instances with the specified identifiers in a single paginated call.
+// Instances that don't exist are omitted from the result.
+func findDBInstancesByIDs(ctx context.Context, conn *rds.Client, ids []string) ([]awstypes.DBInstance, error) {
+	input := rds.DescribeDBInstancesInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String("db-instance-id"),
+				Values: ids,
+			},
+		},
+	}
+
+	return findDBInstances(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.DBInstance]())
+}
+
+// statusDBInstances returns the status of a group of DB instances.
+// The group's status is that of the first instance that isn't available, or "creating" if any instance doesn't yet exist.
+func statusDBInstances(ctx context.Context, conn *rds.Client, ids []string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDBInstancesByIDs(ctx, conn, ids)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output) == 0 {
+			return nil, "", nil
+		}
+
+		for _, v := range output {
+			if status := aws.ToString(v.DBInstanceStatus); status != instanceStatusAvailable && status != instanceStatusStorageOptimization {
+				return output, status, nil
+			}
+		}
+
+		if len(output) < len(ids) {
+			return output, instanceStatusCreating, nil
+		}
+
+		return output, instanceStatusAvailable, nil
+	}
+}
+
+func waitDBInstancesAvailable(ctx context.Context, conn *rds.Client, ids []string, timeout time.Duration) ([]awstypes.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			instanceStatusBackingUp,
+			instanceStatusConfiguringEnhancedMonitoring,
+			instanceStatusConfiguringIAMDatabaseAuth,
+			instanceStatusConfiguringLogExports,
+			instanceStatusCreating,
+			instanceStatusMaintenance,
+			instanceStatusModifying,
+			instanceStatusMovingToVPC,
+			instanceStatusRebooting,
+			instanceStatusRenaming,
+			instanceStatusResettingMasterCredentials,
+			instanceStatusStarting,
+			instanceStatusStopping,
+			instanceStatusStorageFull,
+			instanceStatusUpgrading,
+		},
+		Target:                    []string{instanceStatusAvailable},
+		Refresh:                   statusDBInstances(ctx, conn, ids),
+		Timeout:                   timeout,
+		Delay:                     1 * time.Minute,
+		PollInterval:              10 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstancesDeleted(ctx context.Context, conn *rds.Client, ids []string, timeout time.Duration) ([]awstypes.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			instanceStatusAvailable,
+			instanceStatusBackingUp,
+			instanceStatusConfiguringEnhancedMonitoring,
+			instanceStatusConfiguringLogExports,
+			instanceStatusCreating,
+			instanceStatusDeletePreCheck,
+			instanceStatusDeleting,
+			instanceStatusIncompatibleParameters,
+			instanceStatusIncompatibleRestore,
+			instanceStatusModifying,
+			instanceStatusStarting,
+			instanceStatusStopping,
+			instanceStatusStorageFull,
+			instanceStatusStorageOptimization,
+		},
+		Target:       []string{},
+		Refresh:      statusDBInstances(ctx, conn, ids),
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenInstanceReplicaFleetReplicas(ctx context.Context, identifierPrefix string, apiObjects []awstypes.DBInstance) fwtypes.ListNestedObjectValueOf[instanceReplicaFleetReplicaModel] {
+	replicas := make([]instanceReplicaFleetReplicaModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		id := aws.ToString(apiObject.DBInstanceIdentifier)
+		index, _ := instanceReplicaFleetReplicaIndex(identifierPrefix, id)
+		replica := instanceReplicaFleetReplicaModel{
+			ARN:           fwflex.StringToFramework(ctx, apiObject.DBInstanceArn),
+			Identifier:    fwflex.StringValueToFramework(ctx, id),
+			Index:         fwflex.Int64ValueToFramework(ctx, int64(index)),
+			InstanceClass: fwflex.StringToFramework(ctx, apiObject.DBInstanceClass),
+			PromotionTier: fwflex.Int32ToFrameworkInt64(ctx, apiObject.PromotionTier),
+			ResourceID:    fwflex.StringToFramework(ctx, apiObject.DbiResourceId),
+		}
+
+		if v := apiObject.Endpoint; v != nil {
+			replica.Address = fwflex.StringToFramework(ctx, v.Address)
+			replica.Endpoint = fwflex.StringValueToFramework(ctx, fmt.Sprintf("%s:%d", aws.ToString(v.Address), aws.ToInt32(v.Port)))
+			replica.Port = fwflex.Int32ToFrameworkInt64(ctx, v.Port)
+		} else {
+			replica.Address = types.StringNull()
+			replica.Endpoint = types.StringNull()
+			replica.Port = types.Int64Null()
+		}
+
+		replicas = append(replicas, replica)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, replicas)
+}
+
+type instanceReplicaFleetResourceModel struct {
+	framework.WithRegionModel
+	ID                         types.String                                                             `tfsdk:"id"`
+	IdentifierPrefix           types.String                                                             `tfsdk:"identifier_prefix"`
+	InstanceClass              types.String                                                             `tfsdk:"instance_class"`
+	ReplicaCount               types.Int64                                                              `tfsdk:"replica_count"`
+	ReplicaOverrides           fwtypes.SetNestedObjectValueOf[instanceReplicaFleetReplicaOverrideModel] `tfsdk:"replica_override"`
+	Replicas                   fwtypes.ListNestedObjectValueOf[instanceReplicaFleetReplicaModel]        `tfsdk:"replicas"`
+	SourceDBInstanceIdentifier types.String                                                             `tfsdk:"source_db_instance_identifier"`
+	Timeouts                   timeouts.Value                                                           `tfsdk:"timeouts"`
+}
+
+type instanceReplicaFleetReplicaOverrideModel struct {
+	Index         types.Int64  `tfsdk:"index"`
+	InstanceClass types.String `tfsdk:"instance_class"`
+	PromotionTier types.Int64  `tfsdk:"promotion_tier"`
+}
+
+type instanceReplicaFleetReplicaModel struct {
+	Address       types.String `tfsdk:"address"`
+	ARN           types.String `tfsdk:"arn"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	Identifier    types.String `tfsdk:"identifier"`
+	Index         types.Int64  `tfsdk:"index"`
+	InstanceClass types.String `tfsdk:"instance_class"`
+	Port          types.Int64  `tfsdk:"port"`
+	PromotionTier types.Int64  `tfsdk:"promotion_tier"`
+	ResourceID    types.String `tfsdk:"resource_id"`
+}
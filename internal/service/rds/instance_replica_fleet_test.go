@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSInstanceReplicaFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_replica_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceReplicaFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceReplicaFleetConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceReplicaFleetExists(ctx, resourceName, 2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("identifier_prefix"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replica_count"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replicas"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replicas").AtSliceIndex(0).AtMapKey(names.AttrIdentifier), knownvalue.StringExact(rName+"-0")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replicas").AtSliceIndex(1).AtMapKey(names.AttrIdentifier), knownvalue.StringExact(rName+"-1")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceReplicaFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_replica_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceReplicaFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceReplicaFleetConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceReplicaFleetExists(ctx, resourceName, 1),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfrds.ResourceInstanceReplicaFleet, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceReplicaFleet_replicaCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_replica_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceReplicaFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceReplicaFleetConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceReplicaFleetExists(ctx, resourceName, 1),
+				),
+			},
+			{
+				Config: testAccInstanceReplicaFleetConfig_basic(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceReplicaFleetExists(ctx, resourceName, 3),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replicas"), knownvalue.ListSizeExact(3)),
+				},
+			},
+			{
+				Config: testAccInstanceReplicaFleetConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceReplicaFleetExists(ctx, resourceName, 2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replicas"), knownvalue.ListSizeExact(2)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceReplicaFleet_replicaOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_replica_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceReplicaFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceReplicaFleetConfig_replicaOverride(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceReplicaFleetExists(ctx, resourceName, 2),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replicas").AtSliceIndex(1).AtMapKey("promotion_tier"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				Config: testAccInstanceReplicaFleetConfig_replicaOverride(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceReplicaFleetExists(ctx, resourceName, 2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("replicas").AtSliceIndex(1).AtMapKey("promotion_tier"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceReplicaFleetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_db_instance_replica_fleet" {
+				continue
+			}
+
+			n, err := strconv.Atoi(rs.Primary.Attributes["replica_count"])
+			if err != nil {
+				return err
+			}
+
+			output, err := tfrds.FindInstanceReplicaFleetReplicas(ctx, conn, rs.Primary.Attributes["identifier_prefix"], n)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("RDS DB Instance Replica Fleet %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInstanceReplicaFleetExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindInstanceReplicaFleetReplicas(ctx, conn, rs.Primary.Attributes["identifier_prefix"], tfrds.InstanceReplicaFleetMaxReplicas)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("RDS DB Instance Replica Fleet %s has %d replicas, want %d", rs.Primary.ID, got, want)
+		}
+
+		for _, v := range output {
+			if got, want := aws.ToString(v.ReadReplicaSourceDBInstanceIdentifier), rs.Primary.Attributes["source_db_instance_identifier"]; got != want {
+				return fmt.Errorf("RDS DB Instance Replica Fleet %s replica (%s) source is %s, want %s", rs.Primary.ID, aws.ToString(v.DBInstanceIdentifier), got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccInstanceReplicaFleetConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "source" {
+  allocated_storage       = 5
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  identifier              = "%[1]s-source"
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  password_wo             = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version     = 1
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+}
+`, rName))
+}
+
+func testAccInstanceReplicaFleetConfig_basic(rName string, replicaCount int) string {
+	return acctest.ConfigCompose(testAccInstanceReplicaFleetConfig_base(rName), fmt.Sprintf(`
+resource "aws_db_instance_replica_fleet" "test" {
+  identifier_prefix             = %[1]q
+  instance_class                = aws_db_instance.source.instance_class
+  replica_count                 = %[2]d
+  source_db_instance_identifier = aws_db_instance.source.identifier
+}
+`, rName, replicaCount))
+}
+
+func testAccInstanceReplicaFleetConfig_replicaOverride(rName string, promotionTier int) string {
+	return acctest.ConfigCompose(testAccInstanceReplicaFleetConfig_base(rName), fmt.Sprintf(`
+resource "aws_db_instance_replica_fleet" "test" {
+  identifier_prefix             = %[1]q
+  instance_class                = aws_db_instance.source.instance_class
+  replica_count                 = 2
+  source_db_instance_identifier = aws_db_instance.source.identifier
+
+  replica_override {
+    index          = 1
+    promotion_tier = %[2]d
+  }
+}
+`, rName, promotionTier))
+}
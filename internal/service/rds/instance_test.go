@@ -479,6 +479,78 @@ func TestAccRDSInstance_networkType(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_networkTypeUnsupportedBySubnetGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// All RDS Instance tests should skip for testing.Short() except the 20 shortest running tests.
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_networkTypeUnsupportedBySubnetGroupBase(rName),
+			},
+			{
+				Config:      testAccInstanceConfig_networkTypeUnsupportedBySubnetGroup(rName),
+				ExpectError: regexache.MustCompile(`"network_type" \(DUAL\) is not supported by DB subnet group`),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstance_failOnPendingMinorVersionUpgrade(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// All RDS Instance tests should skip for testing.Short() except the 20 shortest running tests.
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_failOnPendingMinorVersionUpgrade(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAutoMinorVersionUpgrade, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_pending_minor_version_upgrade", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "pending_minor_version_upgrade.#"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_failOnPendingMinorVersionUpgrade(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_pending_minor_version_upgrade", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "pending_minor_version_upgrade.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_optionGroup(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -787,6 +859,49 @@ func TestAccRDSInstance_FinalSnapshotIdentifier_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_FinalSnapshotIdentifier_suffixStrategy(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// All RDS Instance tests should skip for testing.Short() except the 20 shortest running tests.
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		// testAccCheckInstanceDestroyWithFinalSnapshot verifies a database snapshot is
+		// created with the suffixed identifier, and subsequently deletes it
+		CheckDestroy: testAccCheckInstanceDestroyWithFinalSnapshot(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_finalSnapshotIDSuffixStrategy(rName, "timestamp"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_identifier_suffix_strategy", "timestamp"),
+					resource.TestMatchResourceAttr(resourceName, "final_snapshot_identifier_actual", regexache.MustCompile(`^`+rName+`-\d{14}$`)),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_finalSnapshotIDSuffixStrategy(rName, "uuid"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_identifier_suffix_strategy", "uuid"),
+					resource.TestMatchResourceAttr(resourceName, "final_snapshot_identifier_actual", regexache.MustCompile(`^`+rName+`-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_FinalSnapshotIdentifier_skipFinalSnapshot(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -891,6 +1006,7 @@ func TestAccRDSInstance_Storage_maxAllocated(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
 					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage", "10"),
+					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage_utilization", "50"),
 				),
 			},
 			{
@@ -898,6 +1014,7 @@ func TestAccRDSInstance_Storage_maxAllocated(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
 					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage_utilization", "0"),
 				),
 			},
 			{
@@ -905,6 +1022,7 @@ func TestAccRDSInstance_Storage_maxAllocated(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
 					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage", "15"),
+					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage_utilization", "33.33"),
 				),
 			},
 			{
@@ -912,6 +1030,7 @@ func TestAccRDSInstance_Storage_maxAllocated(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
 					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage_utilization", "0"),
 				),
 			},
 		},
@@ -1073,6 +1192,59 @@ func TestAccRDSInstance_ManageMasterPassword_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_ManageMasterPassword_rotationRules(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_manageMasterPasswordRotationRules(rName, "automatically_after_days = 14"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "manage_master_user_password", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotate_immediately", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation_rules.0.automatically_after_days", "14"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation_rules.0.schedule_expression", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrApplyImmediately,
+					names.AttrFinalSnapshotIdentifier,
+					"manage_master_user_password",
+					"master_user_secret_rotate_immediately",
+					"master_user_secret_rotation_rules",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_manageMasterPasswordRotationRules(rName, `schedule_expression = "rate(10 days)"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "master_user_secret_rotation_rules.0.schedule_expression", "rate(10 days)"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_ErrorOnConvertToManageOnStoppedInstance(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -1295,6 +1467,76 @@ func TestAccRDSInstance_ReplicateSourceDB_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_ReplicateSourceDB_replicaLagThreshold(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// All RDS Instance tests should skip for testing.Short() except the 20 shortest running tests.
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance, sourceDbInstance types.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	sourceResourceName := "aws_db_instance.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_replicaLagThreshold(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, sourceResourceName, &sourceDbInstance),
+					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
+					testAccCheckInstanceReplicaAttributes(&sourceDbInstance, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "replica_lag_threshold", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrApplyImmediately,
+					names.AttrPassword,
+					"replica_lag_threshold",
+				},
+			},
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_replicaLagThreshold(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "replica_lag_threshold", "30"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: testAccInstanceConfig_ReplicateSourceDB_replicaLagThreshold(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "replica_lag_threshold", "0"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_ReplicateSourceDB_promoteNull(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -4158,6 +4400,55 @@ func TestAccRDSInstance_SnapshotIdentifier_parameterGroupName(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_SnapshotIdentifier_parameterGroupFamilyMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// All RDS Instance tests should skip for testing.Short() except the 20 shortest running tests.
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance, sourceDbInstance types.DBInstance
+	var dbSnapshot types.DBSnapshot
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDbResourceName := "aws_db_instance.source"
+	snapshotResourceName := "aws_db_snapshot.test"
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_SnapshotID_parameterGroupFamilyBase(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, sourceDbResourceName, &sourceDbInstance),
+					testAccCheckDBSnapshotExists(ctx, snapshotResourceName, &dbSnapshot),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_SnapshotID_parameterGroupFamily(rName, "other"),
+				ExpectError: regexache.MustCompile(`"parameter_group_name" \(` + rName + `-other\) has family mysql8.0`),
+			},
+			{
+				Config: testAccInstanceConfig_SnapshotID_parameterGroupFamily(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, names.AttrParameterGroupName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_engine", sourceDbResourceName, names.AttrEngine),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_engine_version", sourceDbResourceName, "engine_version_actual"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_SnapshotIdentifier_port(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -6107,6 +6398,61 @@ func TestAccRDSInstance_Oracle_nationalCharacterSet(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_Oracle_multiTenant(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// All RDS Instance tests should skip for testing.Short() except the 20 shortest running tests.
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance types.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_Oracle_multiTenant(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "multi_tenant", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_Oracle_multiTenant(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "multi_tenant", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_Oracle_multiTenant(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_Oracle_noNationalCharacterSet(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -7905,6 +8251,9 @@ func testAccCheckInstanceDestroyWithFinalSnapshot(ctx context.Context) resource.
 			}
 
 			finalSnapshotID := rs.Primary.Attributes[names.AttrFinalSnapshotIdentifier]
+			if v := rs.Primary.Attributes["final_snapshot_identifier_actual"]; v != "" {
+				finalSnapshotID = v
+			}
 			output, err := tfrds.FindDBSnapshotByID(ctx, conn, finalSnapshotID)
 			if err != nil {
 				return err
@@ -7957,6 +8306,9 @@ func testAccCheckInstanceDestroyWithoutFinalSnapshot(ctx context.Context) resour
 			}
 
 			finalSnapshotID := rs.Primary.Attributes[names.AttrFinalSnapshotIdentifier]
+			if v := rs.Primary.Attributes["final_snapshot_identifier_actual"]; v != "" {
+				finalSnapshotID = v
+			}
 			_, err := tfrds.FindDBSnapshotByID(ctx, conn, finalSnapshotID)
 
 			if err != nil {
@@ -8095,6 +8447,10 @@ func testAccInstanceConfig_orderableClassCustomSQLServerWeb() string {
 	return testAccInstanceConfig_orderableClass("custom-sqlserver-web", "", "gp2")
 }
 
+func testAccInstanceConfig_orderableClassOracleEnterpriseCDB() string {
+	return testAccInstanceConfig_orderableClass(tfrds.InstanceEngineOracleEnterpriseCDB, "bring-your-own-license", "gp3")
+}
+
 func testAccInstanceConfig_orderableClassOracleEnterprise() string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
@@ -8139,6 +8495,29 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_failOnPendingMinorVersionUpgrade(rName string, failOnPendingMinorVersionUpgrade bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 0
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  skip_final_snapshot     = true
+  password_wo             = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version     = 1
+  username                = "tfacctest"
+
+  auto_minor_version_upgrade            = true
+  fail_on_pending_minor_version_upgrade = %[2]t
+}
+`, rName, failOnPendingMinorVersionUpgrade))
+}
+
 func testAccInstanceConfig_basicApplyImmediately(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigRandomPassword(),
@@ -8468,6 +8847,37 @@ resource "aws_db_instance" "test" {
 `, rName, networkType))
 }
 
+func testAccInstanceConfig_networkTypeUnsupportedBySubnetGroupBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+`, rName))
+}
+
+func testAccInstanceConfig_networkTypeUnsupportedBySubnetGroup(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccInstanceConfig_orderableClassMySQL(),
+		testAccInstanceConfig_networkTypeUnsupportedBySubnetGroupBase(rName),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage       = 5
+  backup_retention_period = 1
+  db_subnet_group_name    = aws_db_subnet_group.test.name
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  identifier              = %[1]q
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  password_wo             = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version     = 1
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+  network_type            = "DUAL"
+}
+`, rName))
+}
+
 func testAccInstanceConfig_optionGroup(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigRandomPassword(),
@@ -8755,6 +9165,37 @@ resource "aws_db_instance" "test" {
 `, rName1, rName2))
 }
 
+func testAccInstanceConfig_finalSnapshotIDSuffixStrategy(rName, strategy string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier = %[1]q
+
+  allocated_storage       = 5
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  password_wo             = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version     = 1
+  username                = "tfacctest"
+  backup_retention_period = 1
+
+  parameter_group_name = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+
+  copy_tags_to_snapshot                     = true
+  final_snapshot_identifier                 = %[1]q
+  final_snapshot_identifier_suffix_strategy = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, strategy))
+}
+
 func testAccInstanceConfig_monitoringInterval(rName string, monitoringInterval int) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigRandomPassword(),
@@ -10102,6 +10543,28 @@ resource "aws_db_instance" "test" {
 `, tfrds.InstanceEngineOracleStandard2, mainInstanceClasses, rName))
 }
 
+func testAccInstanceConfig_Oracle_multiTenant(rName string, multiTenant bool) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassOracleEnterpriseCDB(),
+		acctest.ConfigRandomPassword(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage   = 20
+  apply_immediately   = true
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  engine_version      = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  license_model       = "bring-your-own-license"
+  multi_tenant        = %[2]t
+  password_wo         = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version = 1
+  skip_final_snapshot = true
+  username            = "tfacctest"
+}
+`, rName, multiTenant))
+}
+
 func testAccInstanceConfig_Oracle_noNationalCharacterSet(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigRandomPassword(),
@@ -10238,6 +10701,30 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_manageMasterPasswordRotationRules(rName, rule string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage           = 5
+  backup_retention_period     = 0
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  engine_version              = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  manage_master_user_password = true
+  skip_final_snapshot         = true
+  username                    = "tfacctest"
+
+  master_user_secret_rotate_immediately = false
+
+  master_user_secret_rotation_rules {
+    %[2]s
+  }
+}
+`, rName, rule))
+}
+
 func testAccInstanceConfig_passwordWithStoppedInstance(rName, password string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -10343,6 +10830,33 @@ resource "aws_db_instance" "source" {
 `, rName))
 }
 
+func testAccInstanceConfig_ReplicateSourceDB_replicaLagThreshold(rName string, threshold int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier            = %[1]q
+  instance_class        = aws_db_instance.source.instance_class
+  replicate_source_db   = aws_db_instance.source.identifier
+  replica_lag_threshold = %[2]d
+  skip_final_snapshot   = true
+}
+
+resource "aws_db_instance" "source" {
+  allocated_storage       = 5
+  backup_retention_period = 1
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  identifier              = "%[1]s-source"
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  password_wo             = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version     = 1
+  username                = "tfacctest"
+  skip_final_snapshot     = true
+}
+`, rName, threshold))
+}
+
 func testAccInstanceConfig_ReplicateSourceDB_sourceARN(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigRandomPassword(),
@@ -13156,6 +13670,54 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_SnapshotID_parameterGroupFamilyBase(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigRandomPassword(),
+		testAccInstanceConfig_orderableClassMariadb(),
+		fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  family = data.aws_rds_engine_version.default.parameter_group_family
+  name   = %[1]q
+}
+
+resource "aws_db_parameter_group" "other" {
+  family = "mysql8.0"
+  name   = "%[1]s-other"
+}
+
+resource "aws_db_instance" "source" {
+  allocated_storage   = 5
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  engine_version      = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier          = "%[1]s-source"
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  password_wo         = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version = 1
+  username            = "tfacctest"
+  skip_final_snapshot = true
+}
+
+resource "aws_db_snapshot" "test" {
+  db_instance_identifier = aws_db_instance.source.identifier
+  db_snapshot_identifier = %[1]q
+}
+`, rName))
+}
+
+func testAccInstanceConfig_SnapshotID_parameterGroupFamily(rName, parameterGroup string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_SnapshotID_parameterGroupFamilyBase(rName),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier           = %[1]q
+  instance_class       = aws_db_instance.source.instance_class
+  parameter_group_name = aws_db_parameter_group.%[2]s.name
+  snapshot_identifier  = aws_db_snapshot.test.id
+  skip_final_snapshot  = true
+}
+`, rName, parameterGroup))
+}
+
 func testAccInstanceConfig_SnapshotID_port(rName string, port int) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigRandomPassword(),
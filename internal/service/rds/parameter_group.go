@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
@@ -27,6 +28,7 @@ import (
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -44,6 +46,12 @@ func resourceParameterGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(80 * time.Minute),
+		},
+
+		CustomizeDiff: resourceParameterGroupStaticParameterApplyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -99,11 +107,44 @@ func resourceParameterGroup() *schema.Resource {
 				},
 				Set: parameterHash,
 			},
+			"pending_reboot_db_instances": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrSkipDestroy: {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"static_parameter_apply": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_instance_identifiers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"maintenance_window": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidOnceAWeekWindowFormat,
+							StateFunc: func(v any) string {
+								return strings.ToLower(v.(string))
+							},
+						},
+						"strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(staticParameterApplyStrategy_Values(), false),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -229,6 +270,22 @@ func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
 
+	var pendingRebootInstanceIDs []string
+	if tfMap := staticParameterApplyConfig(d.Get("static_parameter_apply").([]any)); tfMap != nil {
+		instances, err := findStaticParameterApplyDBInstances(ctx, conn, d.Id(), tfMap)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS DB Parameter Group (%s) DB Instances: %s", d.Id(), err)
+		}
+
+		for _, v := range instances {
+			if dbInstanceParameterApplyStatus(&v, d.Id()) == parameterApplyStatusPendingReboot {
+				pendingRebootInstanceIDs = append(pendingRebootInstanceIDs, aws.ToString(v.DBInstanceIdentifier))
+			}
+		}
+	}
+	d.Set("pending_reboot_db_instances", pendingRebootInstanceIDs)
+
 	// Support in-place update of non-refreshable attribute.
 	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
 
@@ -289,6 +346,28 @@ func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if tfMap := staticParameterApplyConfig(d.Get("static_parameter_apply").([]any)); tfMap != nil && !d.IsNewResource() {
+		open, err := staticParameterApplyWindowOpen(tfMap, time.Now())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "applying RDS DB Parameter Group (%s) static parameters: %s", d.Id(), err)
+		}
+
+		if open {
+			retained, err := applyStaticParameters(ctx, conn, d.Id(), tfMap, d.Timeout(schema.TimeoutUpdate))
+
+			for _, v := range retained {
+				diags = sdkdiag.AppendWarningf(diags, "RDS DB Parameter Group (%s) static parameters were applied by Blue/Green Deployment switchover. The Blue environment's RDS DB Instance (%s) has been retained; delete it once it's no longer needed.", d.Id(), v)
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "applying RDS DB Parameter Group (%s) static parameters: %s", d.Id(), err)
+			}
+		} else if o, _ := d.GetChange("pending_reboot_db_instances"); d.HasChange(names.AttrParameter) || o.(*schema.Set).Len() > 0 {
+			diags = sdkdiag.AppendWarningf(diags, "RDS DB Parameter Group (%s) static parameters were not applied because the static_parameter_apply maintenance window (%s) is not open. They will be applied by the first update made while it's open.", d.Id(), tfMap["maintenance_window"].(string))
+		}
+	}
+
 	return append(diags, resourceParameterGroupRead(ctx, d, meta)...)
 }
 
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// resourceParameterGroupStaticParameterApplyCustomizeDiff plans an update that applies static parameters
+// when the parameters or the targeted DB instances change, or when targeted DB instances are pending reboot
+// and the static parameter apply maintenance window is open.
+func resourceParameterGroupStaticParameterApplyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	tfMap := staticParameterApplyConfig(d.Get("static_parameter_apply").([]any))
+	if tfMap == nil {
+		return nil
+	}
+
+	if d.HasChanges(names.AttrParameter, "static_parameter_apply") {
+		return d.SetNewComputed("pending_reboot_db_instances")
+	}
+
+	if d.Get("pending_reboot_db_instances").(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	open, err := staticParameterApplyWindowOpen(tfMap, time.Now())
+
+	if err != nil {
+		return err
+	}
+
+	if open {
+		return d.SetNewComputed("pending_reboot_db_instances")
+	}
+
+	return nil
+}
+
+func staticParameterApplyConfig(tfList []any) map[string]any {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	return tfList[0].(map[string]any)
+}
+
+func staticParameterApplyWindowOpen(tfMap map[string]any, now time.Time) (bool, error) {
+	v, ok := tfMap["maintenance_window"].(string)
+	if !ok || v == "" {
+		return true, nil
+	}
+
+	window, err := parseOnceAWeekWindow(v)
+
+	if err != nil {
+		return false, err
+	}
+
+	return window.contains(minuteOfWeek(now)), nil
+}
+
+// applyStaticParameters reboots, or switches over to a Blue/Green Deployment of, the targeted DB instances that are pending reboot
+// for the specified DB parameter group.
+// The caller must check that the configured maintenance window is open.
+// DB instances that aren't targeted, including other DB instances that use the DB parameter group, are never modified.
+// The identifiers of the Blue environments' DB instances that were retained after switchover are returned, even on error.
+func applyStaticParameters(ctx context.Context, conn *rds.Client, parameterGroupName string, tfMap map[string]any, timeout time.Duration) ([]string, error) {
+	deadline := tfresource.NewDeadline(timeout)
+
+	instances, err := findStaticParameterApplyDBInstances(ctx, conn, parameterGroupName, tfMap)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading RDS DB Instances: %w", err)
+	}
+
+	// Static parameters of DB cluster members are applied by rebooting through the DB cluster, which may be managed elsewhere.
+	for _, v := range instances {
+		if v.DBClusterIdentifier != nil {
+			return nil, fmt.Errorf("RDS DB Instance (%s) is a member of RDS DB Cluster (%s) and can't be targeted", aws.ToString(v.DBInstanceIdentifier), aws.ToString(v.DBClusterIdentifier))
+		}
+	}
+
+	var retained []string
+	strategy := tfMap["strategy"].(string)
+	for _, v := range instances {
+		id := aws.ToString(v.DBInstanceIdentifier)
+
+		// Parameter group modifications are propagated to DB instances asynchronously.
+		instance, err := waitDBInstanceParameterApplied(ctx, conn, id, parameterGroupName, deadline.Remaining())
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return retained, fmt.Errorf("waiting for RDS DB Instance (%s) parameter apply: %w", id, err)
+		}
+
+		if dbInstanceParameterApplyStatus(instance, parameterGroupName) != parameterApplyStatusPendingReboot {
+			continue
+		}
+
+		if strategy == staticParameterApplyStrategyBlueGreen {
+			var sourceID string
+			sourceID, err = switchOverDBInstanceForStaticParameters(ctx, conn, instance, parameterGroupName, deadline.Remaining())
+			if sourceID != "" {
+				retained = append(retained, sourceID)
+			}
+		} else {
+			err = rebootDBInstanceForStaticParameters(ctx, conn, id, deadline.Remaining())
+		}
+
+		if err != nil {
+			return retained, err
+		}
+	}
+
+	return retained, nil
+}
+
+func rebootDBInstanceForStaticParameters(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Rebooting RDS DB Instance (%s) to apply static parameters", id)
+	input := rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+	}
+	_, err := conn.RebootDBInstance(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("rebooting RDS DB Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitDBInstanceAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS DB Instance (%s) reboot: %w", id, err)
+	}
+
+	return nil
+}
+
+// switchOverDBInstanceForStaticParameters creates a Blue/Green Deployment whose Green environment uses the DB parameter group and switches over to it.
+// The Blue environment's DB instance, which may be managed by another resource, is retained after switchover and its identifier is returned.
+func switchOverDBInstanceForStaticParameters(ctx context.Context, conn *rds.Client, instance *types.DBInstance, parameterGroupName string, timeout time.Duration) (string, error) {
+	id := aws.ToString(instance.DBInstanceIdentifier)
+	deadline := tfresource.NewDeadline(timeout)
+	orchestrator := newBlueGreenOrchestrator(conn)
+	defer orchestrator.CleanUp(ctx)
+
+	log.Printf("[DEBUG] Switching over RDS DB Instance (%s) to apply static parameters", id)
+	input := rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName:    aws.String(id),
+		Source:                     instance.DBInstanceArn,
+		TargetDBParameterGroupName: aws.String(parameterGroupName),
+	}
+	dep, err := orchestrator.CreateDeployment(ctx, &input)
+
+	if err != nil {
+		return "", fmt.Errorf("RDS DB Instance (%s): %w", id, err)
+	}
+
+	deploymentIdentifier := aws.ToString(dep.BlueGreenDeploymentIdentifier)
+	defer func() {
+		input := rds.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: aws.String(deploymentIdentifier),
+		}
+		if dep == nil || aws.ToString(dep.Status) != "SWITCHOVER_COMPLETED" {
+			input.DeleteTarget = aws.Bool(true)
+		}
+
+		if _, err := conn.DeleteBlueGreenDeployment(ctx, &input); err != nil {
+			log.Printf("[WARN] deleting RDS Blue/Green Deployment (%s): %s", deploymentIdentifier, err)
+			return
+		}
+
+		orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+			if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, deploymentIdentifier, deadline.Remaining(), optFns...); err != nil {
+				log.Printf("[WARN] waiting for RDS Blue/Green Deployment (%s) delete: %s", deploymentIdentifier, err)
+			}
+		})
+	}()
+
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, deploymentIdentifier, deadline.Remaining())
+
+	if err != nil {
+		return "", fmt.Errorf("RDS DB Instance (%s): %w", id, err)
+	}
+
+	targetARN, err := parseDBInstanceARN(aws.ToString(dep.Target))
+
+	if err != nil {
+		return "", fmt.Errorf("RDS DB Instance (%s): Blue/Green Deployment target: %w", id, err)
+	}
+
+	if _, err := waitDBInstanceAvailable(ctx, conn, targetARN.Identifier, deadline.Remaining()); err != nil {
+		return "", fmt.Errorf("waiting for RDS DB Instance (%s) Green environment: %w", id, err)
+	}
+
+	dep, err = orchestrator.Switchover(ctx, deploymentIdentifier, deadline.Remaining())
+
+	if err != nil {
+		return "", fmt.Errorf("RDS DB Instance (%s): %w", id, err)
+	}
+
+	sourceARN, err := parseDBInstanceARN(aws.ToString(dep.Source))
+
+	if err != nil {
+		return "", fmt.Errorf("RDS DB Instance (%s): Blue/Green Deployment source: %w", id, err)
+	}
+
+	return sourceARN.Identifier, nil
+}
+
+// findStaticParameterApplyDBInstances returns the targeted DB instances that use the specified DB parameter group.
+func findStaticParameterApplyDBInstances(ctx context.Context, conn *rds.Client, parameterGroupName string, tfMap map[string]any) ([]types.DBInstance, error) {
+	ids := flex.ExpandStringValueSet(tfMap["db_instance_identifiers"].(*schema.Set))
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	instances, err := findDBInstancesByIDs(ctx, conn, ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(instances, func(v types.DBInstance) bool {
+		return dbInstanceParameterApplyStatus(&v, parameterGroupName) == ""
+	}), nil
+}
+
+// dbInstanceParameterApplyStatus returns the status of the specified DB parameter group's parameters on a DB instance.
+func dbInstanceParameterApplyStatus(instance *types.DBInstance, parameterGroupName string) string {
+	for _, v := range instance.DBParameterGroups {
+		if aws.ToString(v.DBParameterGroupName) == parameterGroupName {
+			return aws.ToString(v.ParameterApplyStatus)
+		}
+	}
+
+	return ""
+}
+
+func statusDBInstanceParameterApply(ctx context.Context, conn *rds.Client, id, parameterGroupName string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDBInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, dbInstanceParameterApplyStatus(output, parameterGroupName), nil
+	}
+}
+
+func waitDBInstanceParameterApplied(ctx context.Context, conn *rds.Client, id, parameterGroupName string, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{parameterApplyStatusApplying},
+		Target:  []string{parameterApplyStatusInSync, parameterApplyStatusPendingReboot},
+		Refresh: statusDBInstanceParameterApply(ctx, conn, id, parameterGroupName),
+		Timeout: timeout,
+		// The modification isn't visible on the DB instance immediately.
+		Delay:                     10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// parseOnceAWeekWindow parses a "ddd:hh24:mi-ddd:hh24:mi" window into a range of minutes of the week starting on Monday.
+func parseOnceAWeekWindow(v string) (minuteRange, error) {
+	from, to, ok := strings.Cut(v, "-")
+
+	if !ok {
+		return minuteRange{}, fmt.Errorf("(%s) must satisfy the format of \"ddd:hh24:mi-ddd:hh24:mi\"", v)
+	}
+
+	start, err := parseMinuteOfWeek(from)
+
+	if err != nil {
+		return minuteRange{}, err
+	}
+
+	end, err := parseMinuteOfWeek(to)
+
+	if err != nil {
+		return minuteRange{}, err
+	}
+
+	return minuteRange{start: start, end: end}, nil
+}
+
+func parseMinuteOfWeek(v string) (int, error) {
+	day, minuteOfDay, ok := strings.Cut(v, ":")
+
+	if !ok {
+		return 0, fmt.Errorf("(%s) must satisfy the format of \"ddd:hh24:mi\"", v)
+	}
+
+	i := slices.Index(fleetWindowDays, strings.ToLower(day))
+
+	if i == -1 {
+		return 0, fmt.Errorf("(%s) is not a valid day of the week", day)
+	}
+
+	minutes, err := parseMinuteOfDay(minuteOfDay)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return i*minutesPerDay + minutes, nil
+}
+
+// minuteOfWeek returns the UTC minute of the week, starting on Monday, of the specified time.
+func minuteOfWeek(t time.Time) int {
+	t = t.UTC()
+	day := (int(t.Weekday()) + 6) % 7 // time.Sunday is 0.
+
+	return day*minutesPerDay + t.Hour()*60 + t.Minute()
+}
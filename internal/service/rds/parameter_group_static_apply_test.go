@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStaticParameterApplyWindowOpen(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday.
+	now := time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		window        string
+		expected      bool
+		expectedError bool
+	}{
+		"no window": {
+			expected: true,
+		},
+		"open": {
+			window:   "wed:10:00-wed:11:00",
+			expected: true,
+		},
+		"closed": {
+			window: "wed:11:00-wed:12:00",
+		},
+		"end is exclusive": {
+			window: "wed:09:30-wed:10:30",
+		},
+		"spans days": {
+			window:   "tue:23:00-thu:01:00",
+			expected: true,
+		},
+		"wraps week": {
+			window: "sun:23:00-mon:01:00",
+		},
+		"wraps week open": {
+			window:   "sat:00:00-thu:00:00",
+			expected: true,
+		},
+		"mixed case": {
+			window:   "Wed:10:00-WED:11:00",
+			expected: true,
+		},
+		"invalid day": {
+			window:        "xyz:10:00-wed:11:00",
+			expectedError: true,
+		},
+		"invalid format": {
+			window:        "wed:10:00",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := staticParameterApplyWindowOpen(map[string]any{"maintenance_window": testCase.window}, now)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("err = %v, want error: %t", err, want)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("open = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
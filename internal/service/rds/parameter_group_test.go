@@ -1151,6 +1151,49 @@ func TestAccRDSParameterGroup_skipDestroy(t *testing.T) {
 	})
 }
 
+func TestAccRDSParameterGroup_staticParameterApply(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBParameterGroup
+	resourceName := "aws_db_parameter_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig_staticParameterApply(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "static_parameter_apply.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "static_parameter_apply.0.strategy", "reboot"),
+					resource.TestCheckResourceAttr(resourceName, "static_parameter_apply.0.db_instance_identifiers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_db_instances.#", "0"),
+				),
+			},
+			{
+				Config: testAccParameterGroupConfig_staticParameterApply(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(ctx, resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "performance_schema",
+						names.AttrValue: "1",
+					}),
+					// The static parameter change was applied by rebooting the DB instance.
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_db_instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckParameterGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
@@ -1292,6 +1335,40 @@ resource "aws_db_parameter_group" "test" {
 `, rName)
 }
 
+func testAccParameterGroupConfig_staticParameterApply(rName, performanceSchema string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.default.parameter_group_family
+
+  parameter {
+    apply_method = "pending-reboot"
+    name         = "performance_schema"
+    value        = %[2]q
+  }
+
+  static_parameter_apply {
+    # A reference to aws_db_instance.test.identifier would be a dependency cycle.
+    db_instance_identifiers = [%[1]q]
+    strategy                = "reboot"
+  }
+}
+
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 0
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  parameter_group_name    = aws_db_parameter_group.test.name
+  password                = "avoid-plaintext-passwords"
+  skip_final_snapshot     = true
+  username                = "tfacctest"
+}
+`, rName, performanceSchema))
+}
+
 func testAccParameterGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_db_parameter_group" "test" {
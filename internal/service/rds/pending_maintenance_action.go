@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_rds_pending_maintenance_action", name="Pending Maintenance Action")
+func newPendingMaintenanceActionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &pendingMaintenanceActionResource{}, nil
+}
+
+const (
+	pendingMaintenanceActionOptInTypeImmediate       = "immediate"
+	pendingMaintenanceActionOptInTypeNextMaintenance = "next-maintenance"
+	pendingMaintenanceActionOptInTypeUndoOptIn       = "undo-opt-in"
+)
+
+func pendingMaintenanceActionOptInType_Values() []string {
+	return []string{
+		pendingMaintenanceActionOptInTypeImmediate,
+		pendingMaintenanceActionOptInTypeNextMaintenance,
+		pendingMaintenanceActionOptInTypeUndoOptIn,
+	}
+}
+
+const (
+	pendingMaintenanceActionIDPartCount = 2
+)
+
+type pendingMaintenanceActionResource struct {
+	framework.ResourceWithModel[pendingMaintenanceActionResourceModel]
+	framework.WithImportByID
+}
+
+func (r *pendingMaintenanceActionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAction: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_applied_after_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"current_apply_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"forced_apply_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"opt_in_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"opt_in_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(pendingMaintenanceActionOptInType_Values()...),
+				},
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *pendingMaintenanceActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pendingMaintenanceActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	resourceARN, action := data.ResourceARN.ValueString(), data.Action.ValueString()
+	id, err := intflex.FlattenResourceId([]string{resourceARN, action}, pendingMaintenanceActionIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS Pending Maintenance Action (%s/%s)", resourceARN, action), err.Error())
+
+		return
+	}
+
+	if err := applyPendingMaintenanceAction(ctx, conn, resourceARN, action, data.OptInType.ValueString()); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS Pending Maintenance Action (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	actions, err := findPendingMaintenanceActionsByResourceARN(ctx, conn, resourceARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Pending Maintenance Action (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenPendingMaintenanceActions(ctx, actions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *pendingMaintenanceActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pendingMaintenanceActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), pendingMaintenanceActionIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Pending Maintenance Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ResourceARN = fwtypes.ARNValue(parts[0])
+	data.Action = fwflex.StringValueToFramework(ctx, parts[1])
+
+	// Once applied a maintenance action is no longer pending, so the resource is only removed from state
+	// if the DB instance or cluster no longer exists.
+	actions, err := findPendingMaintenanceActionsByResourceARN(ctx, conn, parts[0])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Pending Maintenance Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenPendingMaintenanceActions(ctx, actions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.OptInType.IsNull() {
+		// Import. A maintenance action that hasn't been opted in to has no opt-in status.
+		if optInStatus := data.OptInStatus.ValueString(); optInStatus != "" {
+			data.OptInType = fwflex.StringValueToFramework(ctx, optInStatus)
+		} else {
+			data.OptInType = fwflex.StringValueToFramework(ctx, pendingMaintenanceActionOptInTypeUndoOptIn)
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pendingMaintenanceActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old pendingMaintenanceActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	if !new.OptInType.Equal(old.OptInType) {
+		if err := applyPendingMaintenanceAction(ctx, conn, new.ResourceARN.ValueString(), new.Action.ValueString(), new.OptInType.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Pending Maintenance Action (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	actions, err := findPendingMaintenanceActionsByResourceARN(ctx, conn, new.ResourceARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Pending Maintenance Action (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flattenPendingMaintenanceActions(ctx, actions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pendingMaintenanceActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pendingMaintenanceActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only an opt-in for the next maintenance window can be withdrawn.
+	if data.OptInType.ValueString() != pendingMaintenanceActionOptInTypeNextMaintenance {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	resourceARN, action := data.ResourceARN.ValueString(), data.Action.ValueString()
+	output, err := findPendingMaintenanceActionByTwoPartKey(ctx, conn, resourceARN, action)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Pending Maintenance Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if aws.ToString(output.OptInStatus) != pendingMaintenanceActionOptInTypeNextMaintenance {
+		return
+	}
+
+	err = applyPendingMaintenanceAction(ctx, conn, resourceARN, action, pendingMaintenanceActionOptInTypeUndoOptIn)
+
+	if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting RDS Pending Maintenance Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func applyPendingMaintenanceAction(ctx context.Context, conn *rds.Client, resourceARN, action, optInType string) error {
+	input := rds.ApplyPendingMaintenanceActionInput{
+		ApplyAction:        aws.String(action),
+		OptInType:          aws.String(optInType),
+		ResourceIdentifier: aws.String(resourceARN),
+	}
+	_, err := conn.ApplyPendingMaintenanceAction(ctx, &input)
+
+	return err
+}
+
+func findPendingMaintenanceActionByTwoPartKey(ctx context.Context, conn *rds.Client, resourceARN, action string) (*awstypes.PendingMaintenanceAction, error) {
+	output, err := findPendingMaintenanceActionsByResourceARN(ctx, conn, resourceARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.ToString(v.Action) == action {
+			return &v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(action)
+}
+
+func findPendingMaintenanceActionsByResourceARN(ctx context.Context, conn *rds.Client, resourceARN string) ([]awstypes.PendingMaintenanceAction, error) {
+	input := rds.DescribePendingMaintenanceActionsInput{
+		ResourceIdentifier: aws.String(resourceARN),
+	}
+	var output []awstypes.PendingMaintenanceAction
+
+	pages := rds.NewDescribePendingMaintenanceActionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PendingMaintenanceActions {
+			if aws.ToString(v.ResourceIdentifier) == resourceARN {
+				output = append(output, v.PendingMaintenanceActionDetails...)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type pendingMaintenanceActionResourceModel struct {
+	framework.WithRegionModel
+	Action               types.String      `tfsdk:"action"`
+	AutoAppliedAfterDate timetypes.RFC3339 `tfsdk:"auto_applied_after_date"`
+	CurrentApplyDate     timetypes.RFC3339 `tfsdk:"current_apply_date"`
+	Description          types.String      `tfsdk:"description"`
+	ForcedApplyDate      timetypes.RFC3339 `tfsdk:"forced_apply_date"`
+	ID                   types.String      `tfsdk:"id"`
+	OptInStatus          types.String      `tfsdk:"opt_in_status"`
+	OptInType            types.String      `tfsdk:"opt_in_type"`
+	ResourceARN          fwtypes.ARN       `tfsdk:"resource_arn"`
+}
+
+// flattenPendingMaintenanceActions sets the model's computed attributes from the matching pending maintenance action.
+// Once a maintenance action has been applied it is no longer pending and the computed attributes are cleared.
+func (m *pendingMaintenanceActionResourceModel) flattenPendingMaintenanceActions(ctx context.Context, actions []awstypes.PendingMaintenanceAction) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range actions {
+		if aws.ToString(v.Action) == m.Action.ValueString() {
+			return fwflex.Flatten(ctx, &v, m)
+		}
+	}
+
+	m.AutoAppliedAfterDate = timetypes.NewRFC3339Null()
+	m.CurrentApplyDate = timetypes.NewRFC3339Null()
+	m.Description = types.StringNull()
+	m.ForcedApplyDate = timetypes.NewRFC3339Null()
+	m.OptInStatus = types.StringNull()
+
+	return diags
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Pending maintenance actions cannot be created on demand, so these tests require an existing
+// DB instance or cluster with a pending maintenance action that has not been opted in to.
+func TestAccRDSPendingMaintenanceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceARN := acctest.SkipIfEnvVarNotSet(t, "RDS_PENDING_MAINTENANCE_RESOURCE_ARN")
+	action := acctest.SkipIfEnvVarNotSet(t, "RDS_PENDING_MAINTENANCE_ACTION")
+	resourceName := "aws_rds_pending_maintenance_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPendingMaintenanceActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPendingMaintenanceActionConfig_basic(resourceARN, action, "next-maintenance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPendingMaintenanceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, action),
+					resource.TestCheckResourceAttr(resourceName, "opt_in_status", "next-maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "opt_in_type", "next-maintenance"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceARN, resourceARN),
+					resource.TestCheckResourceAttrSet(resourceName, "current_apply_date"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPendingMaintenanceActionConfig_basic(resourceARN, action, "undo-opt-in"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPendingMaintenanceActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "opt_in_type", "undo-opt-in"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPendingMaintenanceActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_pending_maintenance_action" {
+				continue
+			}
+
+			output, err := tfrds.FindPendingMaintenanceActionByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrResourceARN], rs.Primary.Attributes[names.AttrAction])
+
+			if err != nil {
+				return err
+			}
+
+			// Removing the resource withdraws any opt-in for the next maintenance window.
+			if v := output.OptInStatus; v != nil && *v == "next-maintenance" {
+				return fmt.Errorf("RDS Pending Maintenance Action %s is still opted in", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPendingMaintenanceActionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		_, err := tfrds.FindPendingMaintenanceActionByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrResourceARN], rs.Primary.Attributes[names.AttrAction])
+
+		return err
+	}
+}
+
+func testAccPendingMaintenanceActionConfig_basic(resourceARN, action, optInType string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_pending_maintenance_action" "test" {
+  resource_arn = %[1]q
+  action       = %[2]q
+  opt_in_type  = %[3]q
+}
+`, resourceARN, action, optInType)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_db_proxy_targets", name="DB Proxy Targets")
+func newProxyTargetsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &proxyTargetsDataSource{}, nil
+}
+
+const (
+	DSNameProxyTargets = "DB Proxy Targets Data Source"
+)
+
+type proxyTargetsDataSource struct {
+	framework.DataSourceWithModel[proxyTargetsDataSourceModel]
+}
+
+func (d *proxyTargetsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"db_proxy_name": schema.StringAttribute{
+				Required: true,
+			},
+			"target_group_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"targets": framework.DataSourceComputedListOfObjectAttribute[proxyTargetModel](ctx),
+		},
+	}
+}
+
+func (d *proxyTargetsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data proxyTargetsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().RDSClient(ctx)
+
+	if data.TargetGroupName.IsNull() {
+		data.TargetGroupName = fwflex.StringValueToFramework(ctx, "default")
+	}
+
+	dbProxyName := data.DBProxyName.ValueString()
+	input := rds.DescribeDBProxyTargetsInput{
+		DBProxyName:     aws.String(dbProxyName),
+		TargetGroupName: fwflex.StringFromFramework(ctx, data.TargetGroupName),
+	}
+	targets, err := findDBProxyTargets(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.DBProxyTarget]())
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.RDS, create.ErrActionReading, DSNameProxyTargets, dbProxyName, err), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, targets, &data.Targets)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type proxyTargetsDataSourceModel struct {
+	framework.WithRegionModel
+	DBProxyName     types.String                                      `tfsdk:"db_proxy_name"`
+	TargetGroupName types.String                                      `tfsdk:"target_group_name"`
+	Targets         fwtypes.ListNestedObjectValueOf[proxyTargetModel] `tfsdk:"targets"`
+}
+
+type proxyTargetModel struct {
+	Endpoint         types.String                                       `tfsdk:"endpoint"`
+	Port             types.Int64                                        `tfsdk:"port"`
+	RDSResourceID    types.String                                       `tfsdk:"rds_resource_id"`
+	Role             fwtypes.StringEnum[awstypes.TargetRole]            `tfsdk:"role"`
+	TargetARN        types.String                                       `tfsdk:"target_arn"`
+	TargetHealth     fwtypes.ListNestedObjectValueOf[targetHealthModel] `tfsdk:"target_health"`
+	TrackedClusterID types.String                                       `tfsdk:"tracked_cluster_id"`
+	Type             fwtypes.StringEnum[awstypes.TargetType]            `tfsdk:"type"`
+}
+
+type targetHealthModel struct {
+	Description types.String                                    `tfsdk:"description"`
+	Reason      fwtypes.StringEnum[awstypes.TargetHealthReason] `tfsdk:"reason"`
+	State       fwtypes.StringEnum[awstypes.TargetState]        `tfsdk:"state"`
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSProxyTargetsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	dataSourceName := "data.aws_db_proxy_targets.test"
+	resourceName := "aws_db_proxy_target.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDBProxyPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyTargetsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_proxy_name", resourceName, "db_proxy_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_group_name", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "targets.0.endpoint", "aws_db_instance.test", names.AttrAddress),
+					resource.TestCheckResourceAttrPair(dataSourceName, "targets.0.port", "aws_db_instance.test", names.AttrPort),
+					resource.TestCheckResourceAttr(dataSourceName, "targets.0.rds_resource_id", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "targets.0.target_health.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "targets.0.target_health.0.state"),
+					resource.TestCheckResourceAttr(dataSourceName, "targets.0.type", "RDS_INSTANCE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProxyTargetsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProxyTargetConfig_instance(rName), `
+data "aws_db_proxy_targets" "test" {
+  db_proxy_name = aws_db_proxy_target.test.db_proxy_name
+}
+`)
+}
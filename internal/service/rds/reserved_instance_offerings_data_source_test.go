@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSReservedInstanceOfferingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_reserved_instance_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedInstanceOfferingsDataSourceConfig_basic(testInstanceClass, "mysql"),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "offerings.#", 1),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "offerings.*", map[string]string{
+						"db_instance_class":   testInstanceClass,
+						names.AttrDuration:    "31536000",
+						"multi_az":            acctest.CtFalse,
+						"product_description": "mysql",
+					}),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offerings.0.currency_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offerings.0.fixed_price"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offerings.0.offering_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offerings.0.offering_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReservedInstanceOfferingsDataSourceConfig_basic(class, desc string) string {
+	return fmt.Sprintf(`
+data "aws_rds_reserved_instance_offerings" "test" {
+  db_instance_class   = %[1]q
+  duration            = 31536000
+  multi_az            = false
+  product_description = %[2]q
+}
+`, class, desc)
+}
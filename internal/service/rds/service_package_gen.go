@@ -20,17 +20,41 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newProxyTargetsDataSource,
+			TypeName: "aws_db_proxy_targets",
+			Name:     "DB Proxy Targets",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newClusterParameterGroupDataSource,
 			TypeName: "aws_rds_cluster_parameter_group",
 			Name:     "Cluster Parameter Group",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newClusterParameterGroupParametersDataSource,
+			TypeName: "aws_rds_cluster_parameter_group_parameters",
+			Name:     "Cluster Parameter Group Parameters",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newFleetWindowsDataSource,
+			TypeName: "aws_rds_fleet_windows",
+			Name:     "Fleet Windows",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newInstanceReplicaFleetResource,
+			TypeName: "aws_db_instance_replica_fleet",
+			Name:     "DB Instance Replica Fleet",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newClusterSnapshotCopyResource,
 			TypeName: "aws_rds_cluster_snapshot_copy",
@@ -65,6 +89,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newPendingMaintenanceActionResource,
+			TypeName: "aws_rds_pending_maintenance_action",
+			Name:     "Pending Maintenance Action",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newShardGroupResource,
 			TypeName: "aws_rds_shard_group",
@@ -167,6 +197,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Reserved Instance Offering",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  dataSourceReservedOfferings,
+			TypeName: "aws_rds_reserved_instance_offerings",
+			Name:     "Reserved Instance Offerings",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -373,6 +409,15 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceTenantDatabase,
+			TypeName: "aws_rds_tenant_database",
+			Name:     "Tenant Database",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
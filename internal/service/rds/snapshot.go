@@ -268,11 +268,11 @@ func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return diags
 }
 
-func findDBSnapshotByID(ctx context.Context, conn *rds.Client, id string) (*types.DBSnapshot, error) {
+func findDBSnapshotByID(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) (*types.DBSnapshot, error) {
 	input := &rds.DescribeDBSnapshotsInput{
 		DBSnapshotIdentifier: aws.String(id),
 	}
-	output, err := findDBSnapshot(ctx, conn, input, tfslices.PredicateTrue[*types.DBSnapshot]())
+	output, err := findDBSnapshot(ctx, conn, input, tfslices.PredicateTrue[*types.DBSnapshot](), optFns...)
 
 	if err != nil {
 		return nil, err
@@ -288,8 +288,8 @@ func findDBSnapshotByID(ctx context.Context, conn *rds.Client, id string) (*type
 	return output, nil
 }
 
-func findDBSnapshot(ctx context.Context, conn *rds.Client, input *rds.DescribeDBSnapshotsInput, filter tfslices.Predicate[*types.DBSnapshot]) (*types.DBSnapshot, error) {
-	output, err := findDBSnapshots(ctx, conn, input, filter)
+func findDBSnapshot(ctx context.Context, conn *rds.Client, input *rds.DescribeDBSnapshotsInput, filter tfslices.Predicate[*types.DBSnapshot], optFns ...func(*rds.Options)) (*types.DBSnapshot, error) {
+	output, err := findDBSnapshots(ctx, conn, input, filter, optFns...)
 
 	if err != nil {
 		return nil, err
@@ -298,12 +298,12 @@ func findDBSnapshot(ctx context.Context, conn *rds.Client, input *rds.DescribeDB
 	return tfresource.AssertSingleValueResult(output)
 }
 
-func findDBSnapshots(ctx context.Context, conn *rds.Client, input *rds.DescribeDBSnapshotsInput, filter tfslices.Predicate[*types.DBSnapshot]) ([]types.DBSnapshot, error) {
+func findDBSnapshots(ctx context.Context, conn *rds.Client, input *rds.DescribeDBSnapshotsInput, filter tfslices.Predicate[*types.DBSnapshot], optFns ...func(*rds.Options)) ([]types.DBSnapshot, error) {
 	var output []types.DBSnapshot
 
 	pages := rds.NewDescribeDBSnapshotsPaginator(conn, input)
 	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+		page, err := pages.NextPage(ctx, optFns...)
 
 		if errs.IsA[*types.DBSnapshotNotFoundFault](err) {
 			return nil, &retry.NotFoundError{
@@ -326,9 +326,9 @@ func findDBSnapshots(ctx context.Context, conn *rds.Client, input *rds.DescribeD
 	return output, nil
 }
 
-func statusDBSnapshot(ctx context.Context, conn *rds.Client, id string) retry.StateRefreshFunc {
+func statusDBSnapshot(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		output, err := findDBSnapshotByID(ctx, conn, id)
+		output, err := findDBSnapshotByID(ctx, conn, id, optFns...)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -362,6 +362,24 @@ func waitDBSnapshotCreated(ctx context.Context, conn *rds.Client, id string, tim
 	return nil, err
 }
 
+func waitDBSnapshotDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration, optFns ...func(*rds.Options)) (*types.DBSnapshot, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{dbSnapshotAvailable, dbSnapshotDeleting},
+		Target:     []string{},
+		Refresh:    statusDBSnapshot(ctx, conn, id, optFns...),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func findDBSnapshotAttributeByTwoPartKey(ctx context.Context, conn *rds.Client, id, attributeName string) (*types.DBSnapshotAttribute, error) {
 	input := &rds.DescribeDBSnapshotAttributesInput{
 		DBSnapshotIdentifier: aws.String(id),
@@ -5,11 +5,14 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -35,7 +38,7 @@ func resourceSnapshotCopy() *schema.Resource {
 		DeleteWithoutTimeout: resourceSnapshotCopyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSnapshotCopyImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -60,6 +63,11 @@ func resourceSnapshotCopy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"delete_source_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"destination_region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -153,11 +161,18 @@ func resourceSnapshotCopy() *schema.Resource {
 
 func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+	c := meta.(*conns.AWSClient)
+	conn := c.RDSClient(ctx)
+
+	sourceDBSnapshotID := d.Get("source_db_snapshot_identifier").(string)
+	sourceID, sourceRegion, err := snapshotCopySourceIDAndRegion(sourceDBSnapshotID, c.Region(ctx))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
 
 	targetDBSnapshotID := d.Get("target_db_snapshot_identifier").(string)
 	input := &rds.CopyDBSnapshotInput{
-		SourceDBSnapshotIdentifier: aws.String(d.Get("source_db_snapshot_identifier").(string)),
+		SourceDBSnapshotIdentifier: aws.String(sourceDBSnapshotID),
 		Tags:                       getTagsIn(ctx),
 		TargetDBSnapshotIdentifier: aws.String(targetDBSnapshotID),
 	}
@@ -188,6 +203,10 @@ func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		input.PreSignedUrl = aws.String(output.URL)
+	} else if _, ok := d.GetOk(names.AttrKMSKeyID); ok && sourceRegion != c.Region(ctx) {
+		// Copying a KMS-encrypted snapshot across Regions requires a presigned URL.
+		// Setting the source Region causes the AWS SDK to generate it.
+		input.SourceRegion = aws.String(sourceRegion)
 	}
 
 	output, err := conn.CopyDBSnapshot(ctx, input)
@@ -216,6 +235,27 @@ func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.Get("delete_source_snapshot").(bool) {
+		optFn := func(o *rds.Options) {
+			o.Region = sourceRegion
+		}
+
+		log.Printf("[DEBUG] Deleting RDS DB Snapshot Copy (%s) source snapshot: %s", d.Id(), sourceDBSnapshotID)
+		_, err := conn.DeleteDBSnapshot(ctx, &rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(sourceID),
+		}, optFn)
+
+		switch {
+		case errs.IsA[*types.DBSnapshotNotFoundFault](err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "deleting RDS DB Snapshot Copy (%s) source snapshot (%s): %s", d.Id(), sourceDBSnapshotID, err)
+		default:
+			if _, err := waitDBSnapshotDeleted(ctx, conn, sourceID, d.Timeout(schema.TimeoutCreate), optFn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Snapshot Copy (%s) source snapshot (%s) delete: %s", d.Id(), sourceDBSnapshotID, err)
+			}
+		}
+	}
+
 	return append(diags, resourceSnapshotCopyRead(ctx, d, meta)...)
 }
 
@@ -310,3 +350,29 @@ func resourceSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return diags
 }
+
+func resourceSnapshotCopyImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	// delete_source_snapshot only applies on create and cannot be fetched from any API call.
+	d.Set("delete_source_snapshot", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// snapshotCopySourceIDAndRegion returns the identifier and Region of a copy's source DB snapshot.
+// The source may be specified by ARN, which is required when copying across Regions, or by identifier.
+func snapshotCopySourceIDAndRegion(source, defaultRegion string) (string, string, error) {
+	if !arn.IsARN(source) {
+		return source, defaultRegion, nil
+	}
+
+	parsedARN, err := arn.Parse(source)
+	if err != nil {
+		return "", "", fmt.Errorf("could not parse ARN (%s): %w", source, err)
+	}
+
+	id, ok := strings.CutPrefix(parsedARN.Resource, "snapshot:")
+	if parsedARN.Service != "rds" || !ok || id == "" {
+		return "", "", fmt.Errorf("wrong ARN (%s) for a DB Snapshot", source)
+	}
+
+	return id, parsedARN.Region, nil
+}
@@ -216,6 +216,74 @@ func TestAccRDSSnapshotCopy_destinationRegion(t *testing.T) {
 	})
 }
 
+func TestAccRDSSnapshotCopy_deleteSourceSnapshot(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBSnapshot
+	resourceName := "aws_db_snapshot_copy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSnapshotCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfig_deleteSourceSnapshot(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCopyExists(ctx, resourceName, &v),
+					testAccCheckSnapshotCopySourceDeleted(ctx, rName+"-source"),
+					resource.TestCheckResourceAttr(resourceName, "delete_source_snapshot", acctest.CtTrue),
+				),
+				// The deleted source snapshot is planned for re-creation.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSSnapshotCopy_sourceRegionKMSKeyID(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBSnapshot
+	resourceName := "aws_db_snapshot_copy.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyConfig_sourceRegionKMSKeyID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSnapshotCopyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEncrypted, acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, kmsKeyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "source_region", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckSnapshotCopyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
@@ -262,6 +330,24 @@ func testAccCheckSnapshotCopyExists(ctx context.Context, n string, v *types.DBSn
 	}
 }
 
+func testAccCheckSnapshotCopySourceDeleted(ctx context.Context, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		_, err := tfrds.FindDBSnapshotByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("RDS DB Snapshot Copy source %s still exists", id)
+	}
+}
+
 func testAccSnapshotCopyConfig_base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
@@ -346,3 +432,74 @@ resource "aws_db_snapshot_copy" "test" {
   destination_region            = %[2]q
 }`, rName, acctest.AlternateRegion()))
 }
+
+func testAccSnapshotCopyConfig_deleteSourceSnapshot(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotCopyConfig_base(rName), fmt.Sprintf(`
+resource "aws_db_snapshot_copy" "test" {
+  source_db_snapshot_identifier = aws_db_snapshot.test.db_snapshot_arn
+  target_db_snapshot_identifier = "%[1]s-target"
+  delete_source_snapshot        = true
+}`, rName))
+}
+
+func testAccSnapshotCopyConfig_sourceRegionKMSKeyID(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_rds_engine_version" "default" {
+  provider = "awsalternate"
+
+  engine = "mysql"
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  provider = "awsalternate"
+
+  engine                     = data.aws_rds_engine_version.default.engine
+  engine_version             = data.aws_rds_engine_version.default.version
+  preferred_instance_classes = ["db.t3.small", "db.t2.small", "db.t2.medium"]
+}
+
+resource "aws_kms_key" "source" {
+  provider = "awsalternate"
+
+  description             = "%[1]s-source"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_db_instance" "test" {
+  provider = "awsalternate"
+
+  allocated_storage       = 10
+  engine                  = data.aws_rds_engine_version.default.engine
+  engine_version          = data.aws_rds_engine_version.default.version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name                 = "test"
+  identifier              = %[1]q
+  kms_key_id              = aws_kms_key.source.arn
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  backup_retention_period = 0
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+  storage_encrypted       = true
+}
+
+resource "aws_db_snapshot" "test" {
+  provider = "awsalternate"
+
+  db_instance_identifier = aws_db_instance.test.identifier
+  db_snapshot_identifier = "%[1]s-source"
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_db_snapshot_copy" "test" {
+  source_db_snapshot_identifier = aws_db_snapshot.test.db_snapshot_arn
+  target_db_snapshot_identifier = "%[1]s-target"
+  kms_key_id                    = aws_kms_key.test.arn
+}`, rName))
+}
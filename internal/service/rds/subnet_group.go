@@ -5,13 +5,18 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/planpreview"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -82,6 +89,14 @@ func resourceSubnetGroup() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("supported_network_types", func(_ context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChange(names.AttrSubnetIDs)
+			}),
+			resourceSubnetGroupSubnetsCustomizeDiff,
+			subnetGroupPlanOperationsCustomizeDiff,
+		),
 	}
 }
 
@@ -186,6 +201,107 @@ func resourceSubnetGroupDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// resourceSubnetGroupSubnetsCustomizeDiff rejects IPv6-only subnets, which can't be used by DB instances
+// of either network type, at plan time rather than leaving DB instance creation to fail.
+// The check is skipped if the caller isn't allowed to describe subnets.
+func resourceSubnetGroupSubnetsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChange(names.AttrSubnetIDs) || !d.NewValueKnown(names.AttrSubnetIDs) {
+		return nil
+	}
+
+	subnetIDs := flex.ExpandStringValueSet(d.Get(names.AttrSubnetIDs).(*schema.Set))
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// Subnets that don't exist yet, e.g. that are created in the same apply, aren't returned.
+	input := ec2.DescribeSubnetsInput{
+		Filters: []ec2types.Filter{
+			tfec2.NewFilter("subnet-id", subnetIDs),
+		},
+	}
+	subnets, err := tfec2.FindSubnets(ctx, conn, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Subnets: %w", err)
+	}
+
+	for _, subnet := range subnets {
+		if aws.ToBool(subnet.Ipv6Native) {
+			return fmt.Errorf("EC2 Subnet (%s) is IPv6-only; RDS DB subnet groups require subnets with an IPv4 CIDR block for both the %s and %s network types", aws.ToString(subnet.SubnetId), networkTypeIPv4, networkTypeDual)
+		}
+	}
+
+	return nil
+}
+
+// subnetGroupPlanOperationsCustomizeDiff records the AWS API operations that applying the planned change will make.
+// A replacement is recorded as the deletion of the existing subnet group followed by the creation of a new subnet group.
+func subnetGroupPlanOperationsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+	if !planpreview.Enabled(ctx) {
+		return nil
+	}
+
+	if planpreview.ResourceDiffRequiresReplacement(d, names.AttrName, names.AttrNamePrefix) {
+		planpreview.Record(ctx, "rds", "DeleteDBSubnetGroup", map[string]any{
+			"DBSubnetGroupName": d.Id(),
+		})
+
+		return nil
+	}
+
+	if d.Id() == "" {
+		planpreview.Record(ctx, "rds", "CreateDBSubnetGroup", map[string]any{
+			"DBSubnetGroupDescription": planpreview.ResourceDiffValue(d, names.AttrDescription),
+			"DBSubnetGroupName":        planpreview.ResourceDiffValue(d, names.AttrName),
+			"SubnetIds":                planpreview.ResourceDiffValue(d, names.AttrSubnetIDs),
+			"Tags":                     planpreview.ResourceDiffValue(d, names.AttrTagsAll),
+		})
+
+		return nil
+	}
+
+	if d.HasChanges(names.AttrDescription, names.AttrSubnetIDs) {
+		planpreview.Record(ctx, "rds", "ModifyDBSubnetGroup", map[string]any{
+			"DBSubnetGroupDescription": planpreview.ResourceDiffValue(d, names.AttrDescription),
+			"DBSubnetGroupName":        d.Id(),
+			"SubnetIds":                planpreview.ResourceDiffValue(d, names.AttrSubnetIDs),
+		})
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		arn := d.Get(names.AttrARN).(string)
+
+		if updated, removed, ok := planpreview.ResourceDiffTagsChange(ctx, d); !ok {
+			planpreview.Record(ctx, "rds", "AddTagsToResource", map[string]any{
+				"ResourceName": arn,
+				"Tags":         planpreview.Unknown,
+			})
+		} else {
+			if len(removed) > 0 {
+				planpreview.Record(ctx, "rds", "RemoveTagsFromResource", map[string]any{
+					"ResourceName": arn,
+					"TagKeys":      removed,
+				})
+			}
+			if len(updated) > 0 {
+				planpreview.Record(ctx, "rds", "AddTagsToResource", map[string]any{
+					"ResourceName": arn,
+					"Tags":         updated,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
 func findDBSubnetGroupByName(ctx context.Context, conn *rds.Client, name string) (*types.DBSubnetGroup, error) {
 	input := &rds.DescribeDBSubnetGroupsInput{
 		DBSubnetGroupName: aws.String(name),
@@ -210,6 +210,27 @@ func TestAccRDSSubnetGroup_dualStack(t *testing.T) {
 	})
 }
 
+func TestAccRDSSubnetGroup_ipv6OnlySubnet(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetGroupConfig_ipv6OnlySubnetBase(rName),
+			},
+			{
+				Config:      testAccSubnetGroupConfig_ipv6OnlySubnet(rName),
+				ExpectError: regexache.MustCompile(`is IPv6-only`),
+			},
+		},
+	})
+}
+
 func TestAccRDSSubnetGroup_updateDescription(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.DBSubnetGroup
@@ -390,6 +411,33 @@ resource "aws_db_subnet_group" "test" {
 `, rName))
 }
 
+func testAccSubnetGroupConfig_ipv6OnlySubnetBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnetsIPv6(rName, 1), fmt.Sprintf(`
+resource "aws_subnet" "ipv6_only" {
+  vpc_id                          = aws_vpc.test.id
+  availability_zone               = data.aws_availability_zones.available.names[1]
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  ipv6_native                     = true
+
+  enable_resource_name_dns_aaaa_record_on_launch = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccSubnetGroupConfig_ipv6OnlySubnet(rName string) string {
+	return acctest.ConfigCompose(testAccSubnetGroupConfig_ipv6OnlySubnetBase(rName), fmt.Sprintf(`
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = [aws_subnet.test[0].id, aws_subnet.ipv6_only.id]
+}
+`, rName))
+}
+
 func testAccDBSubnetGroupConfig_updatedDescription(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_db_subnet_group" "test" {
@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rds_tenant_database", name="Tenant Database")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func resourceTenantDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTenantDatabaseCreate,
+		ReadWithoutTimeout:   resourceTenantDatabaseRead,
+		UpdateWithoutTimeout: resourceTenantDatabaseUpdate,
+		DeleteWithoutTimeout: resourceTenantDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"character_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"db_instance_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dbi_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrFinalSnapshotIdentifier: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexache.MustCompile(`^[A-Za-z]`), "must begin with alphabetic character"),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]+$`), "must only contain alphanumeric characters and hyphens"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`-$`), "cannot end in a hyphen"),
+				),
+			},
+			"master_password_wo": {
+				Type:      schema.TypeString,
+				Required:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			"master_password_wo_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"master_username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nchar_character_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"skip_final_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tenant_database_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceTenantDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	dbInstanceIdentifier, tenantDBName := d.Get("db_instance_identifier").(string), d.Get("tenant_db_name").(string)
+	id := tenantDatabaseCreateResourceID(dbInstanceIdentifier, tenantDBName)
+	input := rds.CreateTenantDatabaseInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		MasterUserPassword:   aws.String(masterPasswordWO),
+		MasterUsername:       aws.String(d.Get("master_username").(string)),
+		Tags:                 getTagsIn(ctx),
+		TenantDBName:         aws.String(tenantDBName),
+	}
+
+	if v, ok := d.GetOk("character_set_name"); ok {
+		input.CharacterSetName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("nchar_character_set_name"); ok {
+		input.NcharCharacterSetName = aws.String(v.(string))
+	}
+
+	_, err := tfresource.RetryWhenIsA[*types.InvalidDBInstanceStateFault](ctx, d.Timeout(schema.TimeoutCreate), func() (any, error) {
+		return conn.CreateTenantDatabase(ctx, &input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Tenant Database (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitTenantDatabaseCreated(ctx, conn, dbInstanceIdentifier, tenantDBName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Tenant Database (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTenantDatabaseRead(ctx, d, meta)...)
+}
+
+func resourceTenantDatabaseRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dbInstanceIdentifier, tenantDBName, err := tenantDatabaseParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	tenantDatabase, err := findTenantDatabaseByTwoPartKey(ctx, conn, dbInstanceIdentifier, tenantDBName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Tenant Database (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Tenant Database (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, tenantDatabase.TenantDatabaseARN)
+	d.Set("character_set_name", tenantDatabase.CharacterSetName)
+	d.Set("db_instance_identifier", tenantDatabase.DBInstanceIdentifier)
+	d.Set("dbi_resource_id", tenantDatabase.DbiResourceId)
+	d.Set("master_username", tenantDatabase.MasterUsername)
+	d.Set("nchar_character_set_name", tenantDatabase.NcharCharacterSetName)
+	d.Set("tenant_database_resource_id", tenantDatabase.TenantDatabaseResourceId)
+	d.Set("tenant_db_name", tenantDatabase.TenantDBName)
+
+	setTagsOut(ctx, tenantDatabase.TagList)
+
+	return diags
+}
+
+func resourceTenantDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	if d.HasChanges("master_password_wo_version", "tenant_db_name") {
+		dbInstanceIdentifier, tenantDBName, err := tenantDatabaseParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := rds.ModifyTenantDatabaseInput{
+			DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+			TenantDBName:         aws.String(tenantDBName),
+		}
+
+		if d.HasChange("master_password_wo_version") {
+			masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if masterPasswordWO != "" {
+				input.MasterUserPassword = aws.String(masterPasswordWO)
+			}
+		}
+
+		if d.HasChange("tenant_db_name") {
+			tenantDBName = d.Get("tenant_db_name").(string)
+			input.NewTenantDBName = aws.String(tenantDBName)
+		}
+
+		_, err = tfresource.RetryWhenIsA[*types.InvalidDBInstanceStateFault](ctx, d.Timeout(schema.TimeoutUpdate), func() (any, error) {
+			return conn.ModifyTenantDatabase(ctx, &input)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Tenant Database (%s): %s", d.Id(), err)
+		}
+
+		d.SetId(tenantDatabaseCreateResourceID(dbInstanceIdentifier, tenantDBName))
+
+		if _, err := waitTenantDatabaseUpdated(ctx, conn, dbInstanceIdentifier, tenantDBName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Tenant Database (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTenantDatabaseRead(ctx, d, meta)...)
+}
+
+func resourceTenantDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dbInstanceIdentifier, tenantDBName, err := tenantDatabaseParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := rds.DeleteTenantDatabaseInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		SkipFinalSnapshot:    aws.Bool(d.Get("skip_final_snapshot").(bool)),
+		TenantDBName:         aws.String(tenantDBName),
+	}
+
+	if !d.Get("skip_final_snapshot").(bool) {
+		if v, ok := d.GetOk(names.AttrFinalSnapshotIdentifier); ok {
+			input.FinalDBSnapshotIdentifier = aws.String(v.(string))
+		} else {
+			return sdkdiag.AppendErrorf(diags, "deleting RDS Tenant Database (%s): %s is required when skip_final_snapshot is false", d.Id(), names.AttrFinalSnapshotIdentifier)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Tenant Database: %s", d.Id())
+	_, err = tfresource.RetryWhenIsA[*types.InvalidDBInstanceStateFault](ctx, d.Timeout(schema.TimeoutDelete), func() (any, error) {
+		return conn.DeleteTenantDatabase(ctx, &input)
+	})
+
+	if errs.IsA[*types.DBInstanceNotFoundFault](err) || errs.IsA[*types.TenantDatabaseNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Tenant Database (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTenantDatabaseDeleted(ctx, conn, dbInstanceIdentifier, tenantDBName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Tenant Database (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const tenantDatabaseResourceIDSeparator = ","
+
+func tenantDatabaseCreateResourceID(dbInstanceID, tenantDBName string) string {
+	parts := []string{dbInstanceID, tenantDBName}
+	id := strings.Join(parts, tenantDatabaseResourceIDSeparator)
+
+	return id
+}
+
+func tenantDatabaseParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, tenantDatabaseResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DB-INSTANCE-ID%[2]sTENANT-DB-NAME", id, tenantDatabaseResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findTenantDatabaseByTwoPartKey(ctx context.Context, conn *rds.Client, dbInstanceIdentifier, tenantDBName string) (*types.TenantDatabase, error) {
+	input := rds.DescribeTenantDatabasesInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		TenantDBName:         aws.String(tenantDBName),
+	}
+	output, err := findTenantDatabase(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.DBInstanceIdentifier) != dbInstanceIdentifier || aws.ToString(output.TenantDBName) != tenantDBName {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findTenantDatabase(ctx context.Context, conn *rds.Client, input *rds.DescribeTenantDatabasesInput) (*types.TenantDatabase, error) {
+	output, err := findTenantDatabases(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findTenantDatabases(ctx context.Context, conn *rds.Client, input *rds.DescribeTenantDatabasesInput) ([]types.TenantDatabase, error) {
+	var output []types.TenantDatabase
+
+	pages := rds.NewDescribeTenantDatabasesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) || errs.IsA[*types.TenantDatabaseNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.TenantDatabases...)
+	}
+
+	return output, nil
+}
+
+// Constants not currently provided by the AWS Go SDK
+const (
+	tenantDatabaseStatusAvailable = "available"
+	tenantDatabaseStatusCreating  = "creating"
+	tenantDatabaseStatusDeleting  = "deleting"
+	tenantDatabaseStatusModifying = "modifying"
+)
+
+func statusTenantDatabase(ctx context.Context, conn *rds.Client, dbInstanceIdentifier, tenantDBName string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findTenantDatabaseByTwoPartKey(ctx, conn, dbInstanceIdentifier, tenantDBName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitTenantDatabaseCreated(ctx context.Context, conn *rds.Client, dbInstanceIdentifier, tenantDBName string, timeout time.Duration) (*types.TenantDatabase, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tenantDatabaseStatusCreating, tenantDatabaseStatusModifying},
+		Target:  []string{tenantDatabaseStatusAvailable},
+		Refresh: statusTenantDatabase(ctx, conn, dbInstanceIdentifier, tenantDBName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.TenantDatabase); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTenantDatabaseUpdated(ctx context.Context, conn *rds.Client, dbInstanceIdentifier, tenantDBName string, timeout time.Duration) (*types.TenantDatabase, error) {
+	const (
+		delay = 30 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{tenantDatabaseStatusModifying},
+		Target:                    []string{tenantDatabaseStatusAvailable},
+		Refresh:                   statusTenantDatabase(ctx, conn, dbInstanceIdentifier, tenantDBName),
+		Delay:                     delay,
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+		NotFoundChecks:            20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.TenantDatabase); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTenantDatabaseDeleted(ctx context.Context, conn *rds.Client, dbInstanceIdentifier, tenantDBName string, timeout time.Duration) (*types.TenantDatabase, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tenantDatabaseStatusAvailable, tenantDatabaseStatusDeleting, tenantDatabaseStatusModifying},
+		Target:  []string{},
+		Refresh: statusTenantDatabase(ctx, conn, dbInstanceIdentifier, tenantDBName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.TenantDatabase); ok {
+		return output, err
+	}
+
+	return nil, err
+}
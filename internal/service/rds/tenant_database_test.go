@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSTenantDatabase_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var tenantDatabase types.TenantDatabase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dbInstanceResourceName := "aws_db_instance.test"
+	resourceName := "aws_rds_tenant_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckTenantDatabaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantDatabaseConfig_basic(rName, "tenant1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(ctx, resourceName, &tenantDatabase),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "rds", regexache.MustCompile(`tenant-database:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "db_instance_identifier", dbInstanceResourceName, names.AttrIdentifier),
+					resource.TestCheckResourceAttrPair(resourceName, "dbi_resource_id", dbInstanceResourceName, names.AttrResourceID),
+					resource.TestCheckResourceAttr(resourceName, "master_username", "tfacctest"),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_database_resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "tenant_db_name", "tenant1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"master_password_wo_version",
+					"skip_final_snapshot",
+				},
+			},
+			{
+				Config: testAccTenantDatabaseConfig_basic(rName, "tenant2", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTenantDatabaseExists(ctx, resourceName, &tenantDatabase),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tenant_db_name", "tenant2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSTenantDatabase_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var tenantDatabase types.TenantDatabase
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_tenant_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckTenantDatabaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantDatabaseConfig_basic(rName, "tenant1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTenantDatabaseExists(ctx, resourceName, &tenantDatabase),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrds.ResourceTenantDatabase(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTenantDatabaseExists(ctx context.Context, n string, v *types.TenantDatabase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindTenantDatabaseByTwoPartKey(ctx, conn, rs.Primary.Attributes["db_instance_identifier"], rs.Primary.Attributes["tenant_db_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTenantDatabaseDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_tenant_database" {
+				continue
+			}
+
+			_, err := tfrds.FindTenantDatabaseByTwoPartKey(ctx, conn, rs.Primary.Attributes["db_instance_identifier"], rs.Primary.Attributes["tenant_db_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS Tenant Database %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTenantDatabaseConfig_basic(rName, tenantDBName string, passwordVersion int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassOracleEnterpriseCDB(),
+		acctest.ConfigRandomPassword(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  allocated_storage   = 20
+  engine              = data.aws_rds_orderable_db_instance.test.engine
+  engine_version      = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier          = %[1]q
+  instance_class      = data.aws_rds_orderable_db_instance.test.instance_class
+  license_model       = "bring-your-own-license"
+  multi_tenant        = true
+  password_wo         = ephemeral.aws_secretsmanager_random_password.test.random_password
+  password_wo_version = 1
+  skip_final_snapshot = true
+  username            = "tfacctest"
+}
+
+resource "aws_rds_tenant_database" "test" {
+  db_instance_identifier     = aws_db_instance.test.identifier
+  master_password_wo         = ephemeral.aws_secretsmanager_random_password.test.random_password
+  master_password_wo_version = %[3]d
+  master_username            = "tfacctest"
+  skip_final_snapshot        = true
+  tenant_db_name             = %[2]q
+}
+`, rName, tenantDBName, passwordVersion))
+}
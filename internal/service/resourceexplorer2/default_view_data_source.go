@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_resourceexplorer2_default_view", name="Default View")
+func newDefaultViewDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &defaultViewDataSource{}, nil
+}
+
+type defaultViewDataSource struct {
+	framework.DataSourceWithModel[defaultViewDataSourceModel]
+}
+
+func (d *defaultViewDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"view_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *defaultViewDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data defaultViewDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ResourceExplorer2Client(ctx)
+
+	viewARN, err := findDefaultViewARN(ctx, conn)
+
+	if err == nil && viewARN == "" {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Resource Explorer Default View", tfresource.SingularDataSourceFindError("Resource Explorer Default View", err).Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region(ctx))
+	data.ViewARN = fwtypes.ARNValue(viewARN)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type defaultViewDataSourceModel struct {
+	framework.WithRegionModel
+	ID      types.String `tfsdk:"id"`
+	ViewARN fwtypes.ARN  `tfsdk:"view_arn"`
+}
@@ -5,9 +5,11 @@ package resourceexplorer2
 
 // Exports for use in tests only.
 var (
-	ResourceIndex = newIndexResource
-	ResourceView  = newViewResource
+	ResourceIndex                  = newIndexResource
+	ResourceOrganizationAggregator = newOrganizationAggregatorResource
+	ResourceView                   = newViewResource
 
-	FindIndex     = findIndex
-	FindViewByARN = findViewByARN
+	FindIndex         = findIndex
+	FindViewByARN     = findViewByARN
+	ParseFilterString = parseFilterString
 )
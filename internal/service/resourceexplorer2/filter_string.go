@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Filter names supported in Resource Explorer query strings.
+// See https://docs.aws.amazon.com/resource-explorer/latest/userguide/using-search-query-syntax.html#query-syntax-filters.
+var filterStringFilterNames = []string{
+	"accountid",
+	"application",
+	"id",
+	"region",
+	"resourcetype",
+	"resourcetype.supports",
+	"service",
+	"tag",
+	"tag.key",
+	"tag.value",
+}
+
+// parseFilterString checks the syntax of a Resource Explorer query string.
+// A query string is a space-separated list of free-form keywords and "name:value" filters.
+// Each keyword or filter may be prefixed with the "-" (NOT) operator, spaces in a value must be
+// enclosed in double quotes, and the "*" wildcard operator may only be used at the end of a keyword or value.
+func parseFilterString(s string) error {
+	terms, err := splitFilterStringTerms(s)
+
+	if err != nil {
+		return err
+	}
+
+	for _, term := range terms {
+		if err := parseFilterStringTerm(term); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitFilterStringTerms splits a query string into its terms.
+// Spaces enclosed in double quotes do not separate terms.
+func splitFilterStringTerms(s string) ([]string, error) {
+	var terms []string
+	var term strings.Builder
+	var quoted bool
+
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			term.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if term.Len() > 0 {
+				terms = append(terms, term.String())
+				term.Reset()
+			}
+		default:
+			term.WriteRune(r)
+		}
+	}
+
+	if quoted {
+		return nil, errors.New("unterminated double quote")
+	}
+
+	if term.Len() > 0 {
+		terms = append(terms, term.String())
+	}
+
+	return terms, nil
+}
+
+func parseFilterStringTerm(term string) error {
+	v := strings.TrimPrefix(term, "-")
+
+	if v == "" {
+		return fmt.Errorf("term %q: operator \"-\" must be followed by a keyword or filter", term)
+	}
+
+	if strings.HasPrefix(v, "-") {
+		return fmt.Errorf("term %q: operator \"-\" must not be repeated", term)
+	}
+
+	// A colon enclosed in double quotes is part of a keyword.
+	name, value, ok := strings.Cut(v, ":")
+	if !ok || strings.Contains(name, `"`) {
+		return validFilterStringValue(term, v)
+	}
+
+	if !slices.Contains(filterStringFilterNames, name) {
+		return fmt.Errorf("term %q: unsupported filter %q, must be one of %s", term, name, strings.Join(filterStringFilterNames, ", "))
+	}
+
+	if value == "" || value == `""` {
+		return fmt.Errorf("term %q: filter %q must have a value", term, name)
+	}
+
+	return validFilterStringValue(term, value)
+}
+
+func validFilterStringValue(term, value string) error {
+	value = strings.ReplaceAll(value, `"`, "")
+
+	if i := strings.Index(value, "*"); i >= 0 && i != len(value)-1 {
+		return fmt.Errorf("term %q: wildcard operator \"*\" is only supported at the end of a value", term)
+	}
+
+	return nil
+}
+
+// filterStringValidator validates that a string Attribute's value is a syntactically valid Resource Explorer query string.
+type filterStringValidator struct{}
+
+func (v filterStringValidator) Description(_ context.Context) string {
+	return "value must be a valid Resource Explorer query string"
+}
+
+func (v filterStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v filterStringValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseFilterString(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got %q: %s", request.Path, v.Description(ctx), request.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+func validFilterString() validator.String {
+	return filterStringValidator{}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2_test
+
+import (
+	"testing"
+
+	tfresourceexplorer2 "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
+)
+
+func TestParseFilterString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		filterString string
+		expectError  bool
+	}{
+		"empty": {
+			filterString: "",
+		},
+		"resource type": {
+			filterString: "resourcetype:ec2:instance",
+		},
+		"multiple filters": {
+			filterString: "region:us-west-2  service:s3 -tag:none",
+		},
+		"keyword": {
+			filterString: "production",
+		},
+		"quoted value": {
+			filterString: `tag.value:"my value"`,
+		},
+		"quoted tag key": {
+			filterString: `tag:"cost center"=1234`,
+		},
+		"quoted keyword with colon": {
+			filterString: `"a:b"`,
+		},
+		"trailing wildcard": {
+			filterString: "resourcetype:ec2:* tag.key:env*",
+		},
+		"unsupported filter": {
+			filterString: "resource:ec2:instance",
+			expectError:  true,
+		},
+		"filter name case": {
+			filterString: "Region:us-west-2",
+			expectError:  true,
+		},
+		"missing value": {
+			filterString: "region:",
+			expectError:  true,
+		},
+		"empty quoted value": {
+			filterString: `region:""`,
+			expectError:  true,
+		},
+		"unterminated quote": {
+			filterString: `tag.value:"my value`,
+			expectError:  true,
+		},
+		"lone operator": {
+			filterString: "region:global -",
+			expectError:  true,
+		},
+		"repeated operator": {
+			filterString: "--region:global",
+			expectError:  true,
+		},
+		"leading wildcard": {
+			filterString: "tag.key:*env",
+			expectError:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfresourceexplorer2.ParseFilterString(testCase.filterString)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ParseFilterString(%q) error = %v, expected error: %t", testCase.filterString, err, want)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -54,7 +55,12 @@ type indexResource struct {
 func (r *indexResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			names.AttrARN:     framework.ARNAttributeComputedOnly(),
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cooldown_wait": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			names.AttrID:      framework.IDAttribute(),
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
@@ -111,7 +117,7 @@ func (r *indexResource) Create(ctx context.Context, request resource.CreateReque
 			Type: awstypes.IndexTypeAggregator,
 		}
 
-		_, err := conn.UpdateIndexType(ctx, input)
+		err := updateIndexType(ctx, conn, input, data.CooldownWait.ValueBool(), createTimeout)
 
 		if err != nil {
 			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
@@ -162,6 +168,11 @@ func (r *indexResource) Read(ctx context.Context, request resource.ReadRequest,
 		return
 	}
 
+	// Set attributes for import.
+	if data.CooldownWait.IsNull() {
+		data.CooldownWait = types.BoolValue(false)
+	}
+
 	setTagsOut(ctx, output.Tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -186,7 +197,8 @@ func (r *indexResource) Update(ctx context.Context, request resource.UpdateReque
 			Type: new.Type.ValueEnum(),
 		}
 
-		_, err := conn.UpdateIndexType(ctx, input)
+		updateTimeout := r.UpdateTimeout(ctx, new.Timeouts)
+		err := updateIndexType(ctx, conn, input, new.CooldownWait.ValueBool(), updateTimeout)
 
 		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s)", new.ID.ValueString()), err.Error())
@@ -194,7 +206,7 @@ func (r *indexResource) Update(ctx context.Context, request resource.UpdateReque
 			return
 		}
 
-		if _, err := waitIndexUpdated(ctx, conn, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		if _, err := waitIndexUpdated(ctx, conn, updateTimeout); err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", new.ID.ValueString()), err.Error())
 
 			return
@@ -240,12 +252,50 @@ func (r *indexResource) Delete(ctx context.Context, request resource.DeleteReque
 // See https://docs.aws.amazon.com/resource-explorer/latest/apireference/API_Index.html.
 type indexResourceModel struct {
 	framework.WithRegionModel
-	ARN      types.String                           `tfsdk:"arn"`
-	ID       types.String                           `tfsdk:"id"`
-	Tags     tftags.Map                             `tfsdk:"tags"`
-	TagsAll  tftags.Map                             `tfsdk:"tags_all"`
-	Timeouts timeouts.Value                         `tfsdk:"timeouts"`
-	Type     fwtypes.StringEnum[awstypes.IndexType] `tfsdk:"type"`
+	ARN          types.String                           `tfsdk:"arn"`
+	CooldownWait types.Bool                             `tfsdk:"cooldown_wait"`
+	ID           types.String                           `tfsdk:"id"`
+	Tags         tftags.Map                             `tfsdk:"tags"`
+	TagsAll      tftags.Map                             `tfsdk:"tags_all"`
+	Timeouts     timeouts.Value                         `tfsdk:"timeouts"`
+	Type         fwtypes.StringEnum[awstypes.IndexType] `tfsdk:"type"`
+}
+
+// updateIndexType changes the type of the index.
+// If cooldownWait is true, the change is retried while the 24-hour cool down period from the previous type change is in effect.
+func updateIndexType(ctx context.Context, conn *resourceexplorer2.Client, input *resourceexplorer2.UpdateIndexTypeInput, cooldownWait bool, timeout time.Duration) error {
+	if !cooldownWait {
+		_, err := conn.UpdateIndexType(ctx, input)
+
+		return err
+	}
+
+	const (
+		pollInterval = 5 * time.Minute
+	)
+	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+		_, err := conn.UpdateIndexType(ctx, input)
+
+		if errs.Contains(err, "cool down period") {
+			tflog.Debug(ctx, "waiting for Resource Explorer Index type change cool down period to expire", map[string]any{
+				names.AttrARN: aws.ToString(input.Arn),
+			})
+
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	}, tfresource.WithPollInterval(pollInterval))
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.UpdateIndexType(ctx, input)
+	}
+
+	return err
 }
 
 func findIndex(ctx context.Context, conn *resourceexplorer2.Client) (*resourceexplorer2.GetIndexOutput, error) {
@@ -166,6 +166,45 @@ func testAccIndex_type(t *testing.T) {
 	})
 }
 
+func testAccIndex_cooldownWait(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourceexplorer2_index.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_cooldownWait("LOCAL", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cooldown_wait", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "LOCAL"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cooldown_wait"},
+			},
+			{
+				Config: testAccIndexConfig_cooldownWait("LOCAL", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cooldown_wait", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "LOCAL"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceExplorer2Index_Identity_ExistingResource(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_resourceexplorer2_index.test"
@@ -310,3 +349,12 @@ resource "aws_resourceexplorer2_index" "test" {
 }
 `, typ)
 }
+
+func testAccIndexConfig_cooldownWait(typ string, cooldownWait bool) string {
+	return fmt.Sprintf(`
+resource "aws_resourceexplorer2_index" "test" {
+  type          = %[1]q
+  cooldown_wait = %[2]t
+}
+`, typ, cooldownWait)
+}
@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	servicePrincipal = "resource-explorer-2.amazonaws.com"
+)
+
+// @FrameworkResource("aws_resourceexplorer2_organization_aggregator", name="Organization Aggregator")
+// @Testing(serialize=true)
+// @Testing(generator=false)
+func newOrganizationAggregatorResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &organizationAggregatorResource{}
+
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type organizationAggregatorResource struct {
+	framework.ResourceWithModel[organizationAggregatorResourceModel]
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *organizationAggregatorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"delegated_administrator_account_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"adopted_index_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IndexType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"index_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"view_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"view_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z-]+$`), `can include letters, digits, and the dash (-) character`),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *organizationAggregatorResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data organizationAggregatorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+	organizationsConn := r.Meta().OrganizationsClient(ctx)
+
+	organization, err := tforganizations.FindOrganization(ctx, organizationsConn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Organizations Organization", err.Error())
+
+		return
+	}
+
+	// Trusted access lets Resource Explorer discover the organization's accounts.
+	servicePrincipalNames, err := tforganizations.FindEnabledServicePrincipalNames(ctx, organizationsConn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Organizations enabled service principals", err.Error())
+
+		return
+	}
+
+	if !slices.Contains(servicePrincipalNames, servicePrincipal) {
+		if err := tforganizations.EnableServicePrincipal(ctx, organizationsConn, servicePrincipal); err != nil {
+			response.Diagnostics.AddError("creating Resource Explorer Organization Aggregator", err.Error())
+
+			return
+		}
+	}
+
+	if accountID := data.DelegatedAdministratorAccountID.ValueString(); accountID != "" {
+		input := organizations.RegisterDelegatedAdministratorInput{
+			AccountId:        aws.String(accountID),
+			ServicePrincipal: aws.String(servicePrincipal),
+		}
+
+		_, err := organizationsConn.RegisterDelegatedAdministrator(ctx, &input)
+
+		if err != nil && !errs.IsA[*organizationstypes.AccountAlreadyRegisteredException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("registering Resource Explorer delegated administrator (%s)", accountID), err.Error())
+
+			return
+		}
+	}
+
+	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
+
+	// Any existing local index in the Region is promoted to the aggregator index.
+	index, err := findIndex(ctx, conn)
+
+	// Record the type of any adopted index so that it is restored, rather than deleted, on destroy.
+	data.AdoptedIndexType = fwtypes.StringEnumNull[awstypes.IndexType]()
+	if err == nil {
+		data.AdoptedIndexType = fwtypes.StringEnumValue(index.Type)
+	}
+
+	if tfresource.NotFound(err) {
+		input := resourceexplorer2.CreateIndexInput{
+			ClientToken: aws.String(sdkid.UniqueId()),
+		}
+
+		_, err = conn.CreateIndex(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError("creating Resource Explorer Index", err.Error())
+
+			return
+		}
+
+		index, err = waitIndexCreated(ctx, conn, createTimeout)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Resource Explorer Index", err.Error())
+
+		return
+	}
+
+	indexARN := aws.ToString(index.Arn)
+	data.ID = fwflex.StringValueToFramework(ctx, indexARN)
+	data.IndexARN = fwflex.StringValueToFramework(ctx, indexARN)
+
+	if index.Type != awstypes.IndexTypeAggregator {
+		input := resourceexplorer2.UpdateIndexTypeInput{
+			Arn:  aws.String(indexARN),
+			Type: awstypes.IndexTypeAggregator,
+		}
+
+		_, err := conn.UpdateIndexType(ctx, &input)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s)", indexARN), err.Error())
+
+			return
+		}
+
+		if _, err := waitIndexUpdated(ctx, conn, createTimeout); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", indexARN), err.Error())
+
+			return
+		}
+	}
+
+	input := resourceexplorer2.CreateViewInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		Scope:       organization.Arn,
+		ViewName:    fwflex.StringFromFramework(ctx, data.ViewName),
+	}
+
+	output, err := conn.CreateView(ctx, &input)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError("creating Resource Explorer View", err.Error())
+
+		return
+	}
+
+	viewARN := aws.ToString(output.View.ViewArn)
+	data.OrganizationARN = fwflex.StringToFramework(ctx, organization.Arn)
+	data.ViewARN = fwflex.StringValueToFramework(ctx, viewARN)
+
+	associateInput := resourceexplorer2.AssociateDefaultViewInput{
+		ViewArn: aws.String(viewARN),
+	}
+
+	_, err = conn.AssociateDefaultView(ctx, &associateInput)
+
+	if err != nil {
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...) // Set state so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting Resource Explorer View (%s) as the default", viewARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationAggregatorResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data organizationAggregatorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	index, err := findIndex(ctx, conn)
+
+	// The aggregator index has been replaced or demoted.
+	if err == nil && (aws.ToString(index.Arn) != data.IndexARN.ValueString() || index.Type != awstypes.IndexTypeAggregator) {
+		err = &retry.NotFoundError{
+			Message: fmt.Sprintf("Resource Explorer Index (%s) is not the aggregator index", data.IndexARN.ValueString()),
+		}
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer Index (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	view, err := findViewByARN(ctx, conn, data.ViewARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer View (%s)", data.ViewARN.ValueString()), err.Error())
+
+		return
+	}
+
+	if accountID := data.DelegatedAdministratorAccountID.ValueString(); accountID != "" {
+		_, err := tforganizations.FindDelegatedAdministratorByTwoPartKey(ctx, r.Meta().OrganizationsClient(ctx), accountID, servicePrincipal)
+
+		if tfresource.NotFound(err) {
+			response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+			response.State.RemoveResource(ctx)
+
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Resource Explorer delegated administrator (%s)", accountID), err.Error())
+
+			return
+		}
+	}
+
+	data.OrganizationARN = fwflex.StringToFramework(ctx, view.View.Scope)
+	data.ViewName = fwflex.StringToFramework(ctx, view.View.ViewName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationAggregatorResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data organizationAggregatorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceExplorer2Client(ctx)
+
+	viewARN := data.ViewARN.ValueString()
+	if viewARN != "" {
+		defaultViewARN, err := findDefaultViewARN(ctx, conn)
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Resource Explorer Default View", err.Error())
+
+			return
+		}
+
+		if defaultViewARN == viewARN {
+			input := resourceexplorer2.DisassociateDefaultViewInput{}
+
+			_, err := conn.DisassociateDefaultView(ctx, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("unsetting Resource Explorer View (%s) as the default", viewARN), err.Error())
+
+				return
+			}
+		}
+
+		tflog.Debug(ctx, "deleting Resource Explorer View", map[string]any{
+			"view_arn": viewARN,
+		})
+		input := resourceexplorer2.DeleteViewInput{
+			ViewArn: aws.String(viewARN),
+		}
+		_, err = conn.DeleteView(ctx, &input)
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) && !errs.IsA[*awstypes.UnauthorizedException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer View (%s)", viewARN), err.Error())
+
+			return
+		}
+	}
+
+	switch indexARN, adoptedIndexType := data.IndexARN.ValueString(), data.AdoptedIndexType.ValueEnum(); adoptedIndexType {
+	case "":
+		// The index was created by this resource.
+		tflog.Debug(ctx, "deleting Resource Explorer Index", map[string]any{
+			"index_arn": indexARN,
+		})
+		input := resourceexplorer2.DeleteIndexInput{
+			Arn: aws.String(indexARN),
+		}
+		_, err := conn.DeleteIndex(ctx, &input)
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) && !errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "The index is DELETED") {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Explorer Index (%s)", indexARN), err.Error())
+
+			return
+		}
+
+		if _, err := waitIndexDeleted(ctx, conn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) delete", indexARN), err.Error())
+
+			return
+		}
+	case awstypes.IndexTypeAggregator:
+		// The adopted index was already the aggregator index and is left as is.
+	default:
+		// The adopted index is restored to its original type.
+		index, err := findIndex(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			break
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Resource Explorer Index", err.Error())
+
+			return
+		}
+
+		if aws.ToString(index.Arn) != indexARN || index.Type == adoptedIndexType {
+			break
+		}
+
+		tflog.Debug(ctx, "updating Resource Explorer Index type", map[string]any{
+			"index_arn":  indexARN,
+			"index_type": adoptedIndexType,
+		})
+		input := resourceexplorer2.UpdateIndexTypeInput{
+			Arn:  aws.String(indexARN),
+			Type: adoptedIndexType,
+		}
+		_, err = conn.UpdateIndexType(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Explorer Index (%s)", indexARN), err.Error())
+
+			return
+		}
+
+		if _, err := waitIndexUpdated(ctx, conn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Resource Explorer Index (%s) update", indexARN), err.Error())
+
+			return
+		}
+	}
+
+	if accountID := data.DelegatedAdministratorAccountID.ValueString(); accountID != "" {
+		input := organizations.DeregisterDelegatedAdministratorInput{
+			AccountId:        aws.String(accountID),
+			ServicePrincipal: aws.String(servicePrincipal),
+		}
+
+		_, err := r.Meta().OrganizationsClient(ctx).DeregisterDelegatedAdministrator(ctx, &input)
+
+		if errs.IsA[*organizationstypes.AccountNotRegisteredException](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deregistering Resource Explorer delegated administrator (%s)", accountID), err.Error())
+
+			return
+		}
+	}
+}
+
+type organizationAggregatorResourceModel struct {
+	framework.WithRegionModel
+	AdoptedIndexType                fwtypes.StringEnum[awstypes.IndexType] `tfsdk:"adopted_index_type"`
+	DelegatedAdministratorAccountID types.String                           `tfsdk:"delegated_administrator_account_id"`
+	ID                              types.String                           `tfsdk:"id"`
+	IndexARN                        types.String                           `tfsdk:"index_arn"`
+	OrganizationARN                 types.String                           `tfsdk:"organization_arn"`
+	Timeouts                        timeouts.Value                         `tfsdk:"timeouts"`
+	ViewARN                         types.String                           `tfsdk:"view_arn"`
+	ViewName                        types.String                           `tfsdk:"view_name"`
+}
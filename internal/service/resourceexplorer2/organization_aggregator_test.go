@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourceexplorer2 "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOrganizationAggregator_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourceexplorer2_organization_aggregator.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAggregatorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAggregatorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationAggregatorExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "delegated_administrator_account_id"),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "index_arn", "resource-explorer-2", regexache.MustCompile(`index/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_arn", "data.aws_organizations_organization.current", names.AttrARN),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "view_arn", "resource-explorer-2", regexache.MustCompile(`view/`+rName+`/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "view_name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationAggregator_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourceexplorer2_organization_aggregator.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAggregatorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAggregatorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationAggregatorExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfresourceexplorer2.ResourceOrganizationAggregator, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationAggregatorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceExplorer2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourceexplorer2_organization_aggregator" {
+				continue
+			}
+
+			_, err := tfresourceexplorer2.FindIndex(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resource Explorer Organization Aggregator %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationAggregatorExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceExplorer2Client(ctx)
+
+		index, err := tfresourceexplorer2.FindIndex(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if index.Type != awstypes.IndexTypeAggregator {
+			return fmt.Errorf("Resource Explorer Index (%s) is %s", rs.Primary.ID, index.Type)
+		}
+
+		_, err = tfresourceexplorer2.FindViewByARN(ctx, conn, rs.Primary.Attributes["view_arn"])
+
+		return err
+	}
+}
+
+func testAccOrganizationAggregatorConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "current" {}
+
+resource "aws_resourceexplorer2_organization_aggregator" "test" {
+  view_name = %[1]q
+}
+`, rName)
+}
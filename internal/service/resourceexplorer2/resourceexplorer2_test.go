@@ -16,17 +16,26 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 			acctest.CtDisappears: testAccIndex_disappears,
 			"tags":               testAccIndex_tags,
 			"type":               testAccIndex_type,
+			"cooldownWait":       testAccIndex_cooldownWait,
 			"Identity":           testAccResourceExplorer2Index_IdentitySerial,
 		},
+		"OrganizationAggregator": {
+			acctest.CtBasic:      testAccOrganizationAggregator_basic,
+			acctest.CtDisappears: testAccOrganizationAggregator_disappears,
+		},
 		"View": {
 			acctest.CtBasic:      testAccView_basic,
 			"defaultView":        testAccView_defaultView,
 			acctest.CtDisappears: testAccView_disappears,
 			"filter":             testAccView_filter,
+			"filterInvalid":      testAccView_filterInvalid,
 			"scope":              testAccView_scope,
 			"tags":               testAccView_tags,
 			"Identity":           testAccResourceExplorer2View_IdentitySerial,
 		},
+		"DefaultViewDataSource": {
+			acctest.CtBasic: testAccDefaultViewDataSource_basic,
+		},
 		"SearchDataSource": {
 			acctest.CtBasic: testAccSearchDataSource_basic,
 			"indexType":     testAccSearchDataSource_IndexType,
@@ -20,6 +20,12 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newDefaultViewDataSource,
+			TypeName: "aws_resourceexplorer2_default_view",
+			Name:     "Default View",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSearchDataSource,
 			TypeName: "aws_resourceexplorer2_search",
@@ -44,6 +50,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newOrganizationAggregatorResource,
+			TypeName: "aws_resourceexplorer2_organization_aggregator",
+			Name:     "Organization Aggregator",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newViewResource,
 			TypeName: "aws_resourceexplorer2_view",
@@ -20,6 +20,13 @@ func RegisterSweepers() {
 	resource.AddTestSweepers("aws_resourceexplorer2_index", &resource.Sweeper{
 		Name: "aws_resourceexplorer2_index",
 		F:    sweepIndexes,
+		Dependencies: []string{
+			"aws_resourceexplorer2_view",
+		},
+	})
+	resource.AddTestSweepers("aws_resourceexplorer2_view", &resource.Sweeper{
+		Name: "aws_resourceexplorer2_view",
+		F:    sweepViews,
 	})
 }
 
@@ -61,3 +68,42 @@ func sweepIndexes(region string) error {
 
 	return nil
 }
+
+func sweepViews(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.ResourceExplorer2Client(ctx)
+	input := &resourceexplorer2.ListViewsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := resourceexplorer2.NewListViewsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Resource Explorer View sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Resource Explorer Views (%s): %w", region, err)
+		}
+
+		for _, v := range page.Views {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newViewResource, client,
+				framework.NewAttribute(names.AttrARN, v),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Resource Explorer Views (%s): %w", region, err)
+	}
+
+	return nil
+}
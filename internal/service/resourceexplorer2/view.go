@@ -91,6 +91,7 @@ func (r *viewResource) Schema(ctx context.Context, request resource.SchemaReques
 							Required: true,
 							Validators: []validator.String{
 								stringvalidator.LengthAtMost(2048),
+								validFilterString(),
 							},
 						},
 					},
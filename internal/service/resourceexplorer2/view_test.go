@@ -185,6 +185,31 @@ func testAccView_filter(t *testing.T) {
 	})
 }
 
+func testAccView_filterInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ResourceExplorer2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceExplorer2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckViewDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccViewConfig_filter(rName, "resource:ec2:instance"),
+				ExpectError: regexache.MustCompile(`unsupported filter "resource"`),
+			},
+			{
+				Config:      testAccViewConfig_filter(rName, `tag.value:"my value`),
+				ExpectError: regexache.MustCompile(`unterminated double quote`),
+			},
+		},
+	})
+}
+
 func testAccView_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v resourceexplorer2.GetViewOutput
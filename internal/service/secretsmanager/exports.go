@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+// Exports for use in other modules.
+var (
+	ExpandRotationRules  = expandRotationRules
+	FindSecretByID       = findSecretByID
+	FlattenRotationRules = flattenRotationRules
+)
@@ -33,6 +33,7 @@ func resourceAccountAssignment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountAssignmentCreate,
 		ReadWithoutTimeout:   resourceAccountAssignmentRead,
+		UpdateWithoutTimeout: resourceAccountAssignmentUpdate,
 		DeleteWithoutTimeout: resourceAccountAssignmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -58,14 +59,21 @@ func resourceAccountAssignment() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"principal_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"principal_id", "principal_name"},
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(1, 47),
 					validation.StringMatch(regexache.MustCompile(`^([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`), "must match ([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}"),
 				),
 			},
+			"principal_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
 			"principal_type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -85,6 +93,8 @@ func resourceAccountAssignment() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.TargetType](),
 			},
 		},
+
+		CustomizeDiff: resourceAccountAssignmentCustomizeDiff,
 	}
 }
 
@@ -99,6 +109,17 @@ func resourceAccountAssignmentCreate(ctx context.Context, d *schema.ResourceData
 	targetID := d.Get("target_id").(string)
 	targetType := d.Get("target_type").(string)
 
+	if principalID == "" {
+		principalName := d.Get("principal_name").(string)
+		id, err := findAccountAssignmentPrincipalID(ctx, meta.(*conns.AWSClient), instanceARN, awstypes.PrincipalType(principalType), principalName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resolving SSO %s (%s) ID: %s", principalType, principalName, err)
+		}
+
+		principalID = id
+	}
+
 	// We need to check if the assignment exists before creating it since the AWS SSO API doesn't prevent us from creating duplicates.
 	_, err := findAccountAssignmentByFivePartKey(ctx, conn, principalID, principalType, targetID, permissionSetARN, instanceARN)
 
@@ -170,6 +191,14 @@ func resourceAccountAssignmentRead(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceAccountAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// principal_name is only used to resolve principal_id, which forces a new resource when it changes.
+
+	return append(diags, resourceAccountAssignmentRead(ctx, d, meta)...)
+}
+
 func resourceAccountAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
@@ -212,6 +241,47 @@ func resourceAccountAssignmentDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+func resourceAccountAssignmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	// Resolve principal_name at plan time so that a principal re-created with a new ID is reassigned.
+	principalName := d.Get("principal_name").(string)
+
+	if d.NewValueKnown("principal_name") && principalName == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("instance_arn") || !d.NewValueKnown("principal_name") || !d.NewValueKnown("principal_type") {
+		return d.SetNewComputed("principal_id")
+	}
+
+	principalType := d.Get("principal_type").(string)
+	principalID, err := findAccountAssignmentPrincipalID(ctx, meta.(*conns.AWSClient), d.Get("instance_arn").(string), awstypes.PrincipalType(principalType), principalName)
+
+	// The principal may be created in the same apply.
+	if tfresource.NotFound(err) {
+		return d.SetNewComputed("principal_id")
+	}
+
+	if err != nil {
+		return fmt.Errorf("resolving SSO %s (%s) ID: %w", principalType, principalName, err)
+	}
+
+	if d.Get("principal_id").(string) != principalID {
+		return d.SetNew("principal_id", principalID)
+	}
+
+	return nil
+}
+
+func findAccountAssignmentPrincipalID(ctx context.Context, c *conns.AWSClient, instanceARN string, principalType awstypes.PrincipalType, principalName string) (string, error) {
+	identityStoreID, err := findIdentityStoreIDByInstanceARN(ctx, c.SSOAdminClient(ctx), instanceARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	return findPrincipalIDByName(ctx, c.IdentityStoreClient(ctx), identityStoreID, principalType, principalName)
+}
+
 func ParseAccountAssignmentID(id string) ([]string, error) {
 	idParts := strings.Split(id, ",")
 	if len(idParts) != 6 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" ||
@@ -88,6 +88,41 @@ func TestAccSSOAdminAccountAssignment_Basic_user(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminAccountAssignment_principalName(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	userName := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreUserName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentConfig_principalName(userName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "principal_name", userName),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", "USER"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "data.aws_identitystore_user.test", "user_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"principal_name"},
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminAccountAssignment_MissingPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -264,6 +299,30 @@ resource "aws_ssoadmin_account_assignment" "test" {
 `, userName))
 }
 
+func testAccAccountAssignmentConfig_principalName(userName, rName string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentConfig_base(rName), fmt.Sprintf(`
+data "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    unique_attribute {
+      attribute_path  = "UserName"
+      attribute_value = %[1]q
+    }
+  }
+}
+
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_type        = "AWS_ACCOUNT"
+  target_id          = data.aws_caller_identity.current.account_id
+  principal_type     = "USER"
+  principal_name     = %[1]q
+}
+`, userName))
+}
+
 func testAccPreCheckIdentityStoreGroupName(t *testing.T) {
 	if os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME") == "" {
 		t.Skip("AWS_IDENTITY_STORE_GROUP_NAME env var must be set for AWS Identity Store Group acceptance test. " +
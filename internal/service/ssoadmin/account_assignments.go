@@ -0,0 +1,499 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Maximum number of account assignment operations submitted before waiting for them to complete.
+	accountAssignmentsOperationBatchSize = 25
+)
+
+// @SDKResource("aws_ssoadmin_account_assignments", name="Account Assignments")
+func resourceAccountAssignments() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountAssignmentsCreate,
+		ReadWithoutTimeout:   resourceAccountAssignmentsRead,
+		UpdateWithoutTimeout: resourceAccountAssignmentsUpdate,
+		DeleteWithoutTimeout: resourceAccountAssignmentsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAccountAssignmentsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceAccountAssignmentsDryRunCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"assignment": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permission_set_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 47),
+								validation.StringMatch(regexache.MustCompile(`^([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`), "must match ([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}"),
+							),
+						},
+						"principal_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PrincipalType](),
+						},
+						"target_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+					},
+				},
+			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"dry_run_operations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceAccountAssignmentsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	instanceARN := d.Get("instance_arn").(string)
+	n := expandAccountAssignmentKeys(d.Get("assignment").(*schema.Set).List())
+
+	// The resource is authoritative for each account and permission set combination that it manages:
+	// any existing assignments for those combinations that aren't configured are removed.
+	o, err := findAccountAssignmentKeysByTargetAndPermissionSet(ctx, conn, instanceARN, n)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments (%s): %s", instanceARN, err)
+	}
+
+	if d.Get("dry_run").(bool) {
+		if details := accountAssignmentsDryRunOperations(ctx, instanceARN, o, n); len(details) > 0 {
+			return sdkdiag.AppendErrorf(diags, "creating SSO Account Assignments (%s): %s", instanceARN, dryRunError(details...))
+		}
+	} else if err := syncAccountAssignments(ctx, conn, instanceARN, o, n, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSO Account Assignments (%s): %s", instanceARN, err)
+	}
+
+	d.SetId(instanceARN)
+
+	return append(diags, resourceAccountAssignmentsRead(ctx, d, meta)...)
+}
+
+func resourceAccountAssignmentsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	assignments, err := findAccountAssignmentKeysByTargetAndPermissionSet(ctx, conn, d.Id(), expandAccountAssignmentKeys(d.Get("assignment").(*schema.Set).List()))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	if !d.IsNewResource() && len(assignments) == 0 {
+		log.Printf("[WARN] SSO Account Assignments (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("assignment", flattenAccountAssignmentKeys(assignments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting assignment: %s", err)
+	}
+	d.Set("dry_run_operations", nil)
+	d.Set("instance_arn", d.Id())
+
+	return diags
+}
+
+func resourceAccountAssignmentsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	if d.HasChange("assignment") {
+		o, n := d.GetChange("assignment")
+		ns := expandAccountAssignmentKeys(n.(*schema.Set).List())
+
+		// As on create, existing assignments for newly configured account and permission set combinations
+		// that aren't configured are removed.
+		os, err := findAccountAssignmentKeysByTargetAndPermissionSet(ctx, conn, d.Id(), append(expandAccountAssignmentKeys(o.(*schema.Set).List()), ns...))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSO Account Assignments (%s): %s", d.Id(), err)
+		}
+
+		if d.Get("dry_run").(bool) {
+			if details := accountAssignmentsDryRunOperations(ctx, d.Id(), os, ns); len(details) > 0 {
+				// Leave the assignments in state unchanged.
+				d.Partial(true)
+				return sdkdiag.AppendErrorf(diags, "updating SSO Account Assignments (%s): %s", d.Id(), dryRunError(details...))
+			}
+		} else if err := syncAccountAssignments(ctx, conn, d.Id(), os, ns, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSO Account Assignments (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAccountAssignmentsRead(ctx, d, meta)...)
+}
+
+func resourceAccountAssignmentsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	o := expandAccountAssignmentKeys(d.Get("assignment").(*schema.Set).List())
+
+	// Keep the resource in state while its assignments still exist.
+	if d.Get("dry_run").(bool) {
+		if details := accountAssignmentsDryRunOperations(ctx, d.Id(), o, nil); len(details) > 0 {
+			return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignments (%s): %s", d.Id(), dryRunError(details...))
+		}
+
+		return diags
+	}
+
+	log.Printf("[INFO] Deleting SSO Account Assignments: %s", d.Id())
+	if err := syncAccountAssignments(ctx, conn, d.Id(), o, nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignments (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceAccountAssignmentsDryRunCustomizeDiff plans dry_run_operations as the account assignment operations that won't be performed
+// because of dry_run, so that they're shown in the plan. SDKv2 CustomizeDiff can't return warnings.
+// Applying the plan fails, listing the operations, without changing state.
+func resourceAccountAssignmentsDryRunCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.Get("dry_run").(bool) {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChange("assignment") {
+		return nil
+	}
+
+	if !d.NewValueKnown("assignment") || !d.NewValueKnown("instance_arn") {
+		return nil
+	}
+
+	instanceARN := d.Get("instance_arn").(string)
+	o, n := d.GetChange("assignment")
+	os, ns := expandAccountAssignmentKeys(o.(*schema.Set).List()), expandAccountAssignmentKeys(n.(*schema.Set).List())
+
+	// Plan the same operations as are performed when applying.
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+	os, err := findAccountAssignmentKeysByTargetAndPermissionSet(ctx, conn, instanceARN, append(os, ns...))
+
+	if err != nil {
+		return fmt.Errorf("reading SSO Account Assignments (%s): %w", instanceARN, err)
+	}
+
+	if details := accountAssignmentsDryRunOperations(ctx, instanceARN, os, ns); len(details) > 0 {
+		return d.SetNew("dry_run_operations", details)
+	}
+
+	return nil
+}
+
+// resourceAccountAssignmentsImport imports every account assignment of every provisioned permission set in the instance.
+func resourceAccountAssignmentsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
+
+	assignments, err := findAccountAssignmentKeysByInstanceARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading SSO Account Assignments (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("assignment", flattenAccountAssignmentKeys(assignments)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type accountAssignmentKey struct {
+	permissionSetARN string
+	principalID      string
+	principalType    awstypes.PrincipalType
+	targetID         string
+}
+
+func (k accountAssignmentKey) String() string {
+	return strings.Join([]string{k.principalID, string(k.principalType), k.targetID, k.permissionSetARN}, ",")
+}
+
+// diffAccountAssignments returns the account assignments in the old set that aren't in the new set
+// and the account assignments in the new set that aren't in the old set.
+func diffAccountAssignments(o, n []accountAssignmentKey) ([]accountAssignmentKey, []accountAssignmentKey) {
+	os, ns := make(map[accountAssignmentKey]struct{}, len(o)), make(map[accountAssignmentKey]struct{}, len(n))
+	for _, v := range o {
+		os[v] = struct{}{}
+	}
+	for _, v := range n {
+		ns[v] = struct{}{}
+	}
+
+	var del, add []accountAssignmentKey
+
+	for _, v := range o {
+		if _, ok := ns[v]; !ok {
+			del = append(del, v)
+		}
+	}
+
+	for _, v := range n {
+		if _, ok := os[v]; !ok {
+			add = append(add, v)
+		}
+	}
+
+	return del, add
+}
+
+// syncAccountAssignments deletes the account assignments in the old set that aren't in the new set and then
+// creates the account assignments in the new set that aren't in the old set.
+// Operations are submitted in batches and each batch's operations are waited on before the next batch is submitted.
+// All waits share the specified timeout.
+func syncAccountAssignments(ctx context.Context, conn *ssoadmin.Client, instanceARN string, o, n []accountAssignmentKey, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+	del, add := diffAccountAssignments(o, n)
+
+	for chunk := range slices.Chunk(del, accountAssignmentsOperationBatchSize) {
+		requestIDs := make(map[accountAssignmentKey]string, len(chunk))
+
+		for _, v := range chunk {
+			input := ssoadmin.DeleteAccountAssignmentInput{
+				InstanceArn:      aws.String(instanceARN),
+				PermissionSetArn: aws.String(v.permissionSetARN),
+				PrincipalId:      aws.String(v.principalID),
+				PrincipalType:    v.principalType,
+				TargetId:         aws.String(v.targetID),
+				TargetType:       awstypes.TargetTypeAwsAccount,
+			}
+
+			output, err := conn.DeleteAccountAssignment(ctx, &input)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting SSO Account Assignment (%s): %w", v, err)
+			}
+
+			requestIDs[v] = aws.ToString(output.AccountAssignmentDeletionStatus.RequestId)
+		}
+
+		for v, requestID := range requestIDs {
+			if _, err := waitAccountAssignmentDeleted(ctx, conn, instanceARN, requestID, deadline.Remaining()); err != nil {
+				return fmt.Errorf("waiting for SSO Account Assignment (%s) delete: %w", v, err)
+			}
+		}
+	}
+
+	for chunk := range slices.Chunk(add, accountAssignmentsOperationBatchSize) {
+		requestIDs := make(map[accountAssignmentKey]string, len(chunk))
+
+		for _, v := range chunk {
+			input := ssoadmin.CreateAccountAssignmentInput{
+				InstanceArn:      aws.String(instanceARN),
+				PermissionSetArn: aws.String(v.permissionSetARN),
+				PrincipalId:      aws.String(v.principalID),
+				PrincipalType:    v.principalType,
+				TargetId:         aws.String(v.targetID),
+				TargetType:       awstypes.TargetTypeAwsAccount,
+			}
+
+			output, err := conn.CreateAccountAssignment(ctx, &input)
+
+			if err != nil {
+				return fmt.Errorf("creating SSO Account Assignment (%s): %w", v, err)
+			}
+
+			requestIDs[v] = aws.ToString(output.AccountAssignmentCreationStatus.RequestId)
+		}
+
+		for v, requestID := range requestIDs {
+			if _, err := waitAccountAssignmentCreated(ctx, conn, instanceARN, requestID, deadline.Remaining()); err != nil {
+				return fmt.Errorf("waiting for SSO Account Assignment (%s) create: %w", v, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findAccountAssignmentKeysByTargetAndPermissionSet returns all account assignments for each
+// account and permission set combination referenced by the specified account assignments.
+func findAccountAssignmentKeysByTargetAndPermissionSet(ctx context.Context, conn *ssoadmin.Client, instanceARN string, assignments []accountAssignmentKey) ([]accountAssignmentKey, error) {
+	type targetAndPermissionSet struct {
+		permissionSetARN string
+		targetID         string
+	}
+	var combinations []targetAndPermissionSet
+
+	for _, v := range assignments {
+		if c := (targetAndPermissionSet{permissionSetARN: v.permissionSetARN, targetID: v.targetID}); !slices.Contains(combinations, c) {
+			combinations = append(combinations, c)
+		}
+	}
+
+	var output []accountAssignmentKey
+
+	for _, c := range combinations {
+		input := ssoadmin.ListAccountAssignmentsInput{
+			AccountId:        aws.String(c.targetID),
+			InstanceArn:      aws.String(instanceARN),
+			PermissionSetArn: aws.String(c.permissionSetARN),
+		}
+
+		apiObjects, err := findAccountAssignments(ctx, conn, &input, tfslices.PredicateTrue[awstypes.AccountAssignment]())
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, apiObject := range apiObjects {
+			output = append(output, accountAssignmentKey{
+				permissionSetARN: aws.ToString(apiObject.PermissionSetArn),
+				principalID:      aws.ToString(apiObject.PrincipalId),
+				principalType:    apiObject.PrincipalType,
+				targetID:         aws.ToString(apiObject.AccountId),
+			})
+		}
+	}
+
+	return output, nil
+}
+
+// findAccountAssignmentKeysByInstanceARN returns all account assignments for all permission sets provisioned in the instance.
+func findAccountAssignmentKeysByInstanceARN(ctx context.Context, conn *ssoadmin.Client, instanceARN string) ([]accountAssignmentKey, error) {
+	var assignments []accountAssignmentKey
+
+	input := ssoadmin.ListPermissionSetsInput{
+		InstanceArn: aws.String(instanceARN),
+	}
+	pages := ssoadmin.NewListPermissionSetsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, permissionSetARN := range page.PermissionSets {
+			input := ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+				InstanceArn:      aws.String(instanceARN),
+				PermissionSetArn: aws.String(permissionSetARN),
+			}
+			pages := ssoadmin.NewListAccountsForProvisionedPermissionSetPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, accountID := range page.AccountIds {
+					assignments = append(assignments, accountAssignmentKey{
+						permissionSetARN: permissionSetARN,
+						targetID:         accountID,
+					})
+				}
+			}
+		}
+	}
+
+	return findAccountAssignmentKeysByTargetAndPermissionSet(ctx, conn, instanceARN, assignments)
+}
+
+func expandAccountAssignmentKeys(tfList []any) []accountAssignmentKey {
+	var apiObjects []accountAssignmentKey
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, accountAssignmentKey{
+			permissionSetARN: tfMap["permission_set_arn"].(string),
+			principalID:      tfMap["principal_id"].(string),
+			principalType:    awstypes.PrincipalType(tfMap["principal_type"].(string)),
+			targetID:         tfMap["target_id"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenAccountAssignmentKeys(apiObjects []accountAssignmentKey) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"permission_set_arn": apiObject.permissionSetARN,
+			"principal_id":       apiObject.principalID,
+			"principal_type":     string(apiObject.principalType),
+			"target_id":          apiObject.targetID,
+		})
+	}
+
+	return tfList
+}
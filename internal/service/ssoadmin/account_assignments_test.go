@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminAccountAssignments_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "assignment.*", map[string]string{
+						"principal_type": "GROUP",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "assignment.*.permission_set_arn", "aws_ssoadmin_permission_set.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "assignment.*.permission_set_arn", "aws_ssoadmin_permission_set.test.1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Import reads all of the instance's account assignments, not only those of the test's permission sets.
+				ImportStateVerifyIgnore: []string{"assignment", "dry_run"},
+			},
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "assignment.*.permission_set_arn", "aws_ssoadmin_permission_set.test.0", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_updateRemovesUnmanaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+	userName := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+			testAccPreCheckIdentityStoreUserName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		CheckDestroy: testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_unmanaged(groupName, userName, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+				),
+			},
+			{
+				// Stop managing the user's assignment to the second permission set without deleting it.
+				Config: testAccAccountAssignmentsConfig_unmanaged(groupName, userName, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+				),
+			},
+			{
+				// Adding the second permission set removes the user's unmanaged assignment to it, so the apply is idempotent.
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "assignment.*", map[string]string{
+						"principal_type": "GROUP",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_dryRun(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+				),
+			},
+			{
+				// The second assignment is shown in the plan, and applying it fails without creating it or changing state.
+				Config: testAccAccountAssignmentsConfig_dryRun(groupName, rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("dry_run_operations"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringRegexp(regexache.MustCompile(`^CreateAccountAssignment: `)),
+						})),
+					},
+				},
+				ExpectError: regexache.MustCompile(`dry_run is set`),
+			},
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dry_run", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig_basic(groupName, rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssoadmin.ResourceAccountAssignments(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccAccountAssignmentsFromState returns the principal ID, principal type, target ID and permission set ARN
+// of each account assignment in the resource's state.
+func testAccAccountAssignmentsFromState(rs *terraform.ResourceState) [][4]string {
+	var assignments [][4]string
+
+	for k, v := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "assignment.") || !strings.HasSuffix(k, ".principal_id") {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(k, "principal_id")
+		assignments = append(assignments, [4]string{
+			v,
+			rs.Primary.Attributes[prefix+"principal_type"],
+			rs.Primary.Attributes[prefix+"target_id"],
+			rs.Primary.Attributes[prefix+"permission_set_arn"],
+		})
+	}
+
+	return assignments
+}
+
+func testAccCheckAccountAssignmentsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssoadmin_account_assignments" {
+				continue
+			}
+
+			for _, v := range testAccAccountAssignmentsFromState(rs) {
+				_, err := tfssoadmin.FindAccountAssignmentByFivePartKey(ctx, conn, v[0], v[1], v[2], v[3], rs.Primary.ID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("SSO Account Assignment for Principal (%s) still exists", v[0])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountAssignmentsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		for _, v := range testAccAccountAssignmentsFromState(rs) {
+			if _, err := tfssoadmin.FindAccountAssignmentByFivePartKey(ctx, conn, v[0], v[1], v[2], v[3], rs.Primary.ID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountAssignmentsConfig_basic(groupName, rName string, n int) string {
+	return testAccAccountAssignmentsConfig_base(groupName, rName, n, false)
+}
+
+func testAccAccountAssignmentsConfig_dryRun(groupName, rName string, n int) string {
+	return testAccAccountAssignmentsConfig_base(groupName, rName, n, true)
+}
+
+func testAccAccountAssignmentsConfig_unmanaged(groupName, userName, rName string, removed bool) string {
+	config := fmt.Sprintf(`
+data "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    unique_attribute {
+      attribute_path  = "UserName"
+      attribute_value = %[1]q
+    }
+  }
+}
+
+resource "aws_ssoadmin_account_assignment" "unmanaged" {
+  instance_arn       = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arn = aws_ssoadmin_permission_set.test[1].arn
+  principal_id       = data.aws_identitystore_user.test.user_id
+  principal_type     = "USER"
+  target_id          = data.aws_caller_identity.current.account_id
+  target_type        = "AWS_ACCOUNT"
+}
+`, userName)
+
+	if removed {
+		config = `
+removed {
+  from = aws_ssoadmin_account_assignment.unmanaged
+
+  lifecycle {
+    destroy = false
+  }
+}
+`
+	}
+
+	return acctest.ConfigCompose(testAccAccountAssignmentsConfig_basic(groupName, rName, 1), config)
+}
+
+func testAccAccountAssignmentsConfig_base(groupName, rName string, n int, dryRun bool) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    unique_attribute {
+      attribute_path  = "DisplayName"
+      attribute_value = %[1]q
+    }
+  }
+}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  count = 2
+
+  name         = "%[2]s${count.index}"
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  dry_run      = %[4]t
+
+  dynamic "assignment" {
+    for_each = slice(aws_ssoadmin_permission_set.test, 0, %[3]d)
+
+    content {
+      permission_set_arn = assignment.value.arn
+      principal_id       = data.aws_identitystore_group.test.group_id
+      principal_type     = "GROUP"
+      target_id          = data.aws_caller_identity.current.account_id
+    }
+  }
+}
+`, groupName, rName, n, dryRun)
+}
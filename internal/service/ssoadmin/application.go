@@ -6,10 +6,12 @@ package ssoadmin
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -38,12 +40,19 @@ import (
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ssoadmin;ssoadmin.DescribeApplicationOutput")
 // @Testing(preCheckWithRegion="github.com/hashicorp/terraform-provider-aws/internal/acctest;acctest.PreCheckSSOAdminInstancesWithRegion")
 func newApplicationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &applicationResource{}, nil
+	r := &applicationResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
 }
 
 type applicationResource struct {
 	framework.ResourceWithModel[applicationResourceModel]
 	framework.WithImportByIdentity
+	framework.WithTimeouts
 }
 
 func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -61,6 +70,9 @@ func (r *applicationResource) Schema(ctx context.Context, request resource.Schem
 				Required:   true,
 			},
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"assignment_required": schema.BoolAttribute{
+				Optional: true,
+			},
 			"client_token": schema.StringAttribute{
 				Optional: true,
 			},
@@ -99,13 +111,10 @@ func (r *applicationResource) Schema(ctx context.Context, request resource.Schem
 							Optional:   true,
 							Computed:   true,
 							PlanModifiers: []planmodifier.String{
+								// UpdateApplication can't change an application's visibility.
+								stringplanmodifier.RequiresReplace(),
 								stringplanmodifier.UseStateForUnknown(),
 							},
-							Validators: []validator.String{
-								// If explicitly set, require that sign_in_options also be configured
-								// to ensure the flattener correctly reads both values into state.
-								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("sign_in_options")),
-							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -131,6 +140,11 @@ func (r *applicationResource) Schema(ctx context.Context, request resource.Schem
 					},
 				},
 			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -142,6 +156,9 @@ func (r *applicationResource) Create(ctx context.Context, request resource.Creat
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, data.Timeouts))
+	defer cancel()
+
 	conn := r.Meta().SSOAdminClient(ctx)
 
 	name := fwflex.StringValueFromFramework(ctx, data.Name)
@@ -167,6 +184,14 @@ func (r *applicationResource) Create(ctx context.Context, request resource.Creat
 	data.ApplicationARN = data.ARN
 	data.ID = data.ARN
 
+	if !data.AssignmentRequired.IsNull() {
+		if err := putApplicationAssignmentConfiguration(ctx, conn, data.ID.ValueString(), data.AssignmentRequired.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting SSO Application (%s) assignment configuration", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
 	// Read after create to get computed attributes omitted from the create response.
 	app, err := findApplicationByID(ctx, conn, data.ID.ValueString())
 
@@ -176,11 +201,7 @@ func (r *applicationResource) Create(ctx context.Context, request resource.Creat
 		return
 	}
 
-	// Skip writing to state if only the visibilty attribute is returned
-	// to avoid a nested computed attribute causing a diff.
-	if app.PortalOptions != nil && app.PortalOptions.SignInOptions == nil {
-		app.PortalOptions = nil
-	}
+	app.PortalOptions = portalOptionsForState(app.PortalOptions, data.PortalOptions)
 
 	response.Diagnostics.Append(fwflex.Flatten(ctx, app, &data)...)
 	if response.Diagnostics.HasError() {
@@ -214,11 +235,7 @@ func (r *applicationResource) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
-	// Skip writing to state if only the visibilty attribute is returned
-	// to avoid a nested computed attribute causing a diff.
-	if output.PortalOptions != nil && output.PortalOptions.SignInOptions == nil {
-		output.PortalOptions = nil
-	}
+	output.PortalOptions = portalOptionsForState(output.PortalOptions, data.PortalOptions)
 
 	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
 	if response.Diagnostics.HasError() {
@@ -226,6 +243,22 @@ func (r *applicationResource) Read(ctx context.Context, request resource.ReadReq
 	}
 	data.ARN = data.ApplicationARN
 
+	// The assignment configuration is only read if it's managed by this resource.
+	if !data.AssignmentRequired.IsNull() {
+		assignmentConfiguration, err := findApplicationAssignmentConfigurationByID(ctx, conn, data.ID.ValueString())
+
+		switch {
+		case errs.IsA[*awstypes.AccessDeniedException](err):
+			// Keep the prior value if not allowed to read the assignment configuration.
+		case err != nil:
+			response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application (%s) assignment configuration", data.ID.ValueString()), err.Error())
+
+			return
+		default:
+			data.AssignmentRequired = fwflex.BoolToFramework(ctx, assignmentConfiguration.AssignmentRequired)
+		}
+	}
+
 	// listTags requires both application and instance ARN, so must be called
 	// explicitly rather than with transparent tagging.
 	tags, err := listTags(ctx, conn, data.ARN.ValueString(), data.InstanceARN.ValueString())
@@ -247,11 +280,15 @@ func (r *applicationResource) Update(ctx context.Context, request resource.Updat
 	if response.Diagnostics.HasError() {
 		return
 	}
+
 	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.UpdateTimeout(ctx, new.Timeouts))
+	defer cancel()
+
 	conn := r.Meta().SSOAdminClient(ctx)
 
 	if !new.Description.Equal(old.Description) ||
@@ -273,6 +310,14 @@ func (r *applicationResource) Update(ctx context.Context, request resource.Updat
 		}
 	}
 
+	if !new.AssignmentRequired.IsNull() && !new.AssignmentRequired.Equal(old.AssignmentRequired) {
+		if err := putApplicationAssignmentConfiguration(ctx, conn, new.ID.ValueString(), new.AssignmentRequired.ValueBool()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting SSO Application (%s) assignment configuration", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
 	// updateTags requires both application and instance ARN, so must be called
 	// explicitly rather than with transparent tagging.
 	if oldTagsAll, newTagsAll := old.TagsAll, new.TagsAll; !newTagsAll.Equal(oldTagsAll) {
@@ -293,6 +338,9 @@ func (r *applicationResource) Delete(ctx context.Context, request resource.Delet
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, data.Timeouts))
+	defer cancel()
+
 	conn := r.Meta().SSOAdminClient(ctx)
 
 	input := ssoadmin.DeleteApplicationInput{
@@ -335,12 +383,34 @@ func findApplicationByID(ctx context.Context, conn *ssoadmin.Client, id string)
 	return output, nil
 }
 
+func putApplicationAssignmentConfiguration(ctx context.Context, conn *ssoadmin.Client, arn string, assignmentRequired bool) error {
+	input := ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     aws.String(arn),
+		AssignmentRequired: aws.Bool(assignmentRequired),
+	}
+	_, err := conn.PutApplicationAssignmentConfiguration(ctx, &input)
+
+	return err
+}
+
+// portalOptionsForState returns the portal options to write to state.
+// If only the visibility attribute is returned and portal options aren't configured,
+// nothing is written to avoid a nested computed attribute causing a diff.
+func portalOptionsForState(apiObject *awstypes.PortalOptions, configured fwtypes.ListNestedObjectValueOf[portalOptionsModel]) *awstypes.PortalOptions {
+	if apiObject != nil && apiObject.SignInOptions == nil && len(configured.Elements()) == 0 {
+		return nil
+	}
+
+	return apiObject
+}
+
 type applicationResourceModel struct {
 	framework.WithRegionModel
 	ApplicationAccount     types.String                                        `tfsdk:"application_account"`
 	ApplicationARN         types.String                                        `tfsdk:"application_arn"`
 	ApplicationProviderARN fwtypes.ARN                                         `tfsdk:"application_provider_arn"`
 	ARN                    types.String                                        `tfsdk:"arn"`
+	AssignmentRequired     types.Bool                                          `tfsdk:"assignment_required" autoflex:"-"`
 	ClientToken            types.String                                        `tfsdk:"client_token"`
 	Description            types.String                                        `tfsdk:"description"`
 	ID                     types.String                                        `tfsdk:"id"`
@@ -350,6 +420,7 @@ type applicationResourceModel struct {
 	Status                 fwtypes.StringEnum[awstypes.ApplicationStatus]      `tfsdk:"status"`
 	Tags                   tftags.Map                                          `tfsdk:"tags"`
 	TagsAll                tftags.Map                                          `tfsdk:"tags_all"`
+	Timeouts               timeouts.Value                                      `tfsdk:"timeouts"`
 }
 
 type portalOptionsModel struct {
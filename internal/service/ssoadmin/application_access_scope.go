@@ -6,10 +6,12 @@ package ssoadmin
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -29,7 +31,12 @@ import (
 
 // @FrameworkResource("aws_ssoadmin_application_access_scope", name="Application Access Scope")
 func newApplicationAccessScopeResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &applicationAccessScopeResource{}, nil
+	r := &applicationAccessScopeResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
 }
 
 const (
@@ -41,6 +48,7 @@ const (
 type applicationAccessScopeResource struct {
 	framework.ResourceWithModel[applicationAccessScopeResourceModel]
 	framework.WithImportByID
+	framework.WithTimeouts
 }
 
 func (r *applicationAccessScopeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -69,6 +77,12 @@ func (r *applicationAccessScopeResource) Schema(ctx context.Context, req resourc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -81,6 +95,9 @@ func (r *applicationAccessScopeResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, plan.Timeouts))
+	defer cancel()
+
 	in := &ssoadmin.PutApplicationAccessScopeInput{
 		ApplicationArn: plan.ApplicationARN.ValueStringPointer(),
 		Scope:          plan.Scope.ValueStringPointer(),
@@ -177,6 +194,9 @@ func (r *applicationAccessScopeResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, state.Timeouts))
+	defer cancel()
+
 	in := &ssoadmin.DeleteApplicationAccessScopeInput{
 		ApplicationArn: state.ApplicationARN.ValueStringPointer(),
 		Scope:          state.Scope.ValueStringPointer(),
@@ -231,4 +251,5 @@ type applicationAccessScopeResourceModel struct {
 	AuthorizedTargets fwtypes.ListOfString `tfsdk:"authorized_targets"`
 	ID                types.String         `tfsdk:"id"`
 	Scope             types.String         `tfsdk:"scope"`
+	Timeouts          timeouts.Value       `tfsdk:"timeouts"`
 }
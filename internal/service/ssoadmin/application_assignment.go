@@ -5,16 +5,25 @@ package ssoadmin
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -22,12 +31,23 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource("aws_ssoadmin_application_assignment", name="Application Assignment")
+// @IdentityAttribute("application_arn")
+// @IdentityAttribute("principal_id")
+// @IdentityAttribute("principal_type")
+// @ImportIDHandler("applicationAssignmentImportID", setIDAttribute=true)
+// @Testing(preCheckWithRegion="github.com/hashicorp/terraform-provider-aws/internal/acctest;acctest.PreCheckSSOAdminInstancesWithRegion")
 func newApplicationAssignmentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &applicationAssignmentResource{}, nil
+	r := &applicationAssignmentResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
 }
 
 const (
@@ -38,7 +58,8 @@ const (
 
 type applicationAssignmentResource struct {
 	framework.ResourceWithModel[applicationAssignmentResourceModel]
-	framework.WithImportByID
+	framework.WithImportByIdentity
+	framework.WithTimeouts
 }
 
 func (r *applicationAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -51,11 +72,27 @@ func (r *applicationAssignmentResource) Schema(ctx context.Context, req resource
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"dry_run": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			names.AttrID: framework.IDAttribute(),
 			"principal_id": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("principal_id"), path.MatchRoot("principal_name")),
+				},
+			},
+			"principal_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
 				},
 			},
 			"principal_type": schema.StringAttribute{
@@ -66,6 +103,12 @@ func (r *applicationAssignmentResource) Schema(ctx context.Context, req resource
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -77,10 +120,28 @@ func (r *applicationAssignmentResource) Create(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, plan.Timeouts))
+	defer cancel()
+
 	applicationARN := plan.ApplicationARN.ValueString()
-	principalID := plan.PrincipalID.ValueString()
 	principalType := plan.PrincipalType.ValueString()
 
+	if plan.PrincipalID.IsUnknown() {
+		principalName := plan.PrincipalName.ValueString()
+		principalID, err := findApplicationAssignmentPrincipalID(ctx, r.Meta(), applicationARN, plan.PrincipalType.ValueEnum(), principalName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationAssignment, principalName, err),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.PrincipalID = types.StringValue(principalID)
+	}
+	principalID := plan.PrincipalID.ValueString()
+
 	idParts := []string{
 		applicationARN,
 		principalID,
@@ -95,6 +156,15 @@ func (r *applicationAssignmentResource) Create(ctx context.Context, req resource
 		PrincipalType:  awstypes.PrincipalType(principalType),
 	}
 
+	if plan.DryRun.ValueBool() {
+		err := dryRunError(applicationAssignmentDryRunDetail(ctx, dryRunOperationCreate, applicationARN, principalType, principalID))
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplicationAssignment, plan.ApplicationARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
 	_, err := conn.CreateApplicationAssignment(ctx, in)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -132,6 +202,9 @@ func (r *applicationAssignmentResource) Read(ctx context.Context, req resource.R
 	state.ApplicationARN = fwtypes.ARNValue(aws.ToString(out.ApplicationArn))
 	state.PrincipalID = flex.StringToFramework(ctx, out.PrincipalId)
 	state.PrincipalType = fwtypes.StringEnumValue(out.PrincipalType)
+	if state.DryRun.IsNull() {
+		state.DryRun = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -149,6 +222,25 @@ func (r *applicationAssignmentResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, state.Timeouts))
+	defer cancel()
+
+	// Keep the resource in state while the assignment still exists.
+	if state.DryRun.ValueBool() {
+		_, err := findApplicationAssignmentByID(ctx, conn, state.ID.ValueString())
+		if tfresource.NotFound(err) {
+			return
+		}
+		if err == nil {
+			err = dryRunError(applicationAssignmentDryRunDetail(ctx, dryRunOperationDelete, state.ApplicationARN.ValueString(), state.PrincipalType.ValueString(), state.PrincipalID.ValueString()))
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionDeleting, ResNameApplicationAssignment, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
 	in := &ssoadmin.DeleteApplicationAssignmentInput{
 		ApplicationArn: state.ApplicationARN.ValueStringPointer(),
 		PrincipalId:    state.PrincipalID.ValueStringPointer(),
@@ -168,6 +260,78 @@ func (r *applicationAssignmentResource) Delete(ctx context.Context, req resource
 	}
 }
 
+// ModifyPlan reports, as warnings, the assignment operations that won't be performed because of dry_run.
+// Applying them fails without changing the resource's state.
+func (r *applicationAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state applicationAssignmentResourceModel
+
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	}
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve principal_name at plan time so that a principal re-created with a new ID is reassigned.
+	if !req.Plan.Raw.IsNull() && !plan.PrincipalName.IsNull() {
+		if plan.ApplicationARN.IsUnknown() || plan.PrincipalName.IsUnknown() || plan.PrincipalType.IsUnknown() {
+			plan.PrincipalID = types.StringUnknown()
+		} else {
+			principalName := plan.PrincipalName.ValueString()
+			principalID, err := findApplicationAssignmentPrincipalID(ctx, r.Meta(), plan.ApplicationARN.ValueString(), plan.PrincipalType.ValueEnum(), principalName)
+			switch {
+			case tfresource.NotFound(err):
+				// The principal may be created in the same apply.
+				plan.PrincipalID = types.StringUnknown()
+			case err != nil:
+				resp.Diagnostics.AddError(fmt.Sprintf("resolving SSO %s (%s) ID", plan.PrincipalType.ValueString(), principalName), err.Error())
+				return
+			default:
+				plan.PrincipalID = types.StringValue(principalID)
+			}
+		}
+
+		if !req.State.Raw.IsNull() && !plan.PrincipalID.Equal(state.PrincipalID) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("principal_id"))
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("principal_id"), plan.PrincipalID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	switch {
+	case req.State.Raw.IsNull():
+		if plan.DryRun.ValueBool() {
+			resp.Diagnostics.AddWarning(dryRunWarningSummary, applicationAssignmentDryRunDetail(ctx, dryRunOperationCreate, plan.ApplicationARN.ValueString(), plan.PrincipalType.ValueString(), plan.PrincipalID.ValueString()))
+		}
+	case req.Plan.Raw.IsNull():
+		if state.DryRun.ValueBool() {
+			resp.Diagnostics.AddWarning(dryRunWarningSummary, applicationAssignmentDryRunDetail(ctx, dryRunOperationDelete, state.ApplicationARN.ValueString(), state.PrincipalType.ValueString(), state.PrincipalID.ValueString()))
+		}
+	case !plan.ApplicationARN.Equal(state.ApplicationARN) || !plan.PrincipalID.Equal(state.PrincipalID) || !plan.PrincipalType.Equal(state.PrincipalType):
+		if state.DryRun.ValueBool() {
+			resp.Diagnostics.AddWarning(dryRunWarningSummary, applicationAssignmentDryRunDetail(ctx, dryRunOperationDelete, state.ApplicationARN.ValueString(), state.PrincipalType.ValueString(), state.PrincipalID.ValueString()))
+		}
+		if plan.DryRun.ValueBool() {
+			resp.Diagnostics.AddWarning(dryRunWarningSummary, applicationAssignmentDryRunDetail(ctx, dryRunOperationCreate, plan.ApplicationARN.ValueString(), plan.PrincipalType.ValueString(), plan.PrincipalID.ValueString()))
+		}
+	}
+}
+
+func findApplicationAssignmentPrincipalID(ctx context.Context, c *conns.AWSClient, applicationARN string, principalType awstypes.PrincipalType, principalName string) (string, error) {
+	identityStoreID, err := findIdentityStoreIDByApplicationARN(ctx, c.SSOAdminClient(ctx), applicationARN)
+	if err != nil {
+		return "", err
+	}
+
+	return findPrincipalIDByName(ctx, c.IdentityStoreClient(ctx), identityStoreID, principalType, principalName)
+}
+
 func findApplicationAssignmentByID(ctx context.Context, conn *ssoadmin.Client, id string) (*ssoadmin.DescribeApplicationAssignmentOutput, error) {
 	parts, err := intflex.ExpandResourceId(id, applicationAssignmentIDPartCount, false)
 	if err != nil {
@@ -202,7 +366,44 @@ func findApplicationAssignmentByID(ctx context.Context, conn *ssoadmin.Client, i
 type applicationAssignmentResourceModel struct {
 	framework.WithRegionModel
 	ApplicationARN fwtypes.ARN                                `tfsdk:"application_arn"`
+	DryRun         types.Bool                                 `tfsdk:"dry_run"`
 	ID             types.String                               `tfsdk:"id"`
 	PrincipalID    types.String                               `tfsdk:"principal_id"`
+	PrincipalName  types.String                               `tfsdk:"principal_name"`
 	PrincipalType  fwtypes.StringEnum[awstypes.PrincipalType] `tfsdk:"principal_type"`
+	Timeouts       timeouts.Value                             `tfsdk:"timeouts"`
+}
+
+var (
+	_ inttypes.ImportIDParser           = applicationAssignmentImportID{}
+	_ inttypes.FrameworkImportIDCreator = applicationAssignmentImportID{}
+)
+
+type applicationAssignmentImportID struct{}
+
+func (applicationAssignmentImportID) Parse(id string) (string, map[string]string, error) {
+	parts, err := intflex.ExpandResourceId(id, applicationAssignmentIDPartCount, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]string{
+		"application_arn": parts[0],
+		"principal_id":    parts[1],
+		"principal_type":  parts[2],
+	}
+
+	return id, result, nil
+}
+
+func (applicationAssignmentImportID) Create(ctx context.Context, state tfsdk.State) string {
+	var applicationARN, principalID, principalType types.String
+
+	state.GetAttribute(ctx, path.Root("application_arn"), &applicationARN)
+	state.GetAttribute(ctx, path.Root("principal_id"), &principalID)
+	state.GetAttribute(ctx, path.Root("principal_type"), &principalType)
+
+	id, _ := intflex.FlattenResourceId([]string{applicationARN.ValueString(), principalID.ValueString(), principalType.ValueString()}, applicationAssignmentIDPartCount, false)
+
+	return id
 }
@@ -5,10 +5,12 @@ package ssoadmin
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,7 +31,13 @@ import (
 // @ArnFormat(global=true)
 // @Testing(preCheckWithRegion="github.com/hashicorp/terraform-provider-aws/internal/acctest;acctest.PreCheckSSOAdminInstancesWithRegion")
 func newApplicationAssignmentConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &applicationAssignmentConfigurationResource{}, nil
+	r := &applicationAssignmentConfigurationResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
 }
 
 const (
@@ -39,6 +47,7 @@ const (
 type applicationAssignmentConfigurationResource struct {
 	framework.ResourceWithModel[applicationAssignmentConfigurationResourceModel]
 	framework.WithImportByIdentity
+	framework.WithTimeouts
 }
 
 func (r *applicationAssignmentConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -55,6 +64,13 @@ func (r *applicationAssignmentConfigurationResource) Schema(ctx context.Context,
 			},
 			names.AttrID: framework.IDAttributeDeprecatedWithAlternate(path.Root("application_arn")),
 		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -66,6 +82,10 @@ func (r *applicationAssignmentConfigurationResource) Create(ctx context.Context,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, plan.Timeouts))
+	defer cancel()
+
 	plan.ID = types.StringValue(plan.ApplicationARN.ValueString())
 
 	in := &ssoadmin.PutApplicationAssignmentConfigurationInput{
@@ -122,6 +142,9 @@ func (r *applicationAssignmentConfigurationResource) Update(ctx context.Context,
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.UpdateTimeout(ctx, plan.Timeouts))
+	defer cancel()
+
 	if !plan.AssignmentRequired.Equal(state.AssignmentRequired) {
 		in := &ssoadmin.PutApplicationAssignmentConfigurationInput{
 			ApplicationArn:     plan.ApplicationARN.ValueStringPointer(),
@@ -152,6 +175,9 @@ func (r *applicationAssignmentConfigurationResource) Delete(ctx context.Context,
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, state.Timeouts))
+	defer cancel()
+
 	in := &ssoadmin.PutApplicationAssignmentConfigurationInput{
 		ApplicationArn:     state.ApplicationARN.ValueStringPointer(),
 		AssignmentRequired: aws.Bool(true),
@@ -192,7 +218,8 @@ func findApplicationAssignmentConfigurationByID(ctx context.Context, conn *ssoad
 
 type applicationAssignmentConfigurationResourceModel struct {
 	framework.WithRegionModel
-	ApplicationARN     types.String `tfsdk:"application_arn"`
-	AssignmentRequired types.Bool   `tfsdk:"assignment_required"`
-	ID                 types.String `tfsdk:"id"`
+	ApplicationARN     types.String   `tfsdk:"application_arn"`
+	AssignmentRequired types.Bool     `tfsdk:"assignment_required"`
+	ID                 types.String   `tfsdk:"id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ssoadmin_application_authentication_method", name="Application Authentication Method")
+func newApplicationAuthenticationMethodResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationAuthenticationMethodResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	applicationAuthenticationMethodIDPartCount = 2
+)
+
+type applicationAuthenticationMethodResource struct {
+	framework.ResourceWithModel[applicationAuthenticationMethodResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *applicationAuthenticationMethodResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authentication_method_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AuthenticationMethodType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"iam": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[iamAuthenticationMethodModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"actor_policy": schema.StringAttribute{
+							CustomType: fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+							Required:   true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *applicationAuthenticationMethodResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationAuthenticationMethodResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	applicationARN, authenticationMethodType := data.ApplicationARN.ValueString(), data.AuthenticationMethodType.ValueString()
+	id, err := intflex.FlattenResourceId([]string{applicationARN, authenticationMethodType}, applicationAuthenticationMethodIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application (%s) Authentication Method (%s)", applicationARN, authenticationMethodType), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(putApplicationAuthenticationMethod(ctx, conn, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationAuthenticationMethodResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationAuthenticationMethodResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), applicationAuthenticationMethodIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Authentication Method (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	applicationARN, authenticationMethodType := parts[0], awstypes.AuthenticationMethodType(parts[1])
+	output, err := findApplicationAuthenticationMethodByTwoPartKey(ctx, conn, applicationARN, authenticationMethodType)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Authentication Method (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Application ARN and authentication method type are not returned by GetApplicationAuthenticationMethod.
+	data.ApplicationARN = fwtypes.ARNValue(applicationARN)
+	data.AuthenticationMethodType = fwtypes.StringEnumValue(authenticationMethodType)
+
+	switch v := output.AuthenticationMethod.(type) {
+	case *awstypes.AuthenticationMethodMemberIam:
+		var iam iamAuthenticationMethodModel
+		response.Diagnostics.Append(fwflex.Flatten(ctx, v.Value, &iam)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		data.IAM = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &iam)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationAuthenticationMethodResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new applicationAuthenticationMethodResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.UpdateTimeout(ctx, new.Timeouts))
+	defer cancel()
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	// PutApplicationAuthenticationMethod replaces the authentication method in place.
+	response.Diagnostics.Append(putApplicationAuthenticationMethod(ctx, conn, new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationAuthenticationMethodResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationAuthenticationMethodResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	input := ssoadmin.DeleteApplicationAuthenticationMethodInput{
+		ApplicationArn:           fwflex.StringFromFramework(ctx, data.ApplicationARN),
+		AuthenticationMethodType: data.AuthenticationMethodType.ValueEnum(),
+	}
+	_, err := conn.DeleteApplicationAuthenticationMethod(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application Authentication Method (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func putApplicationAuthenticationMethod(ctx context.Context, conn *ssoadmin.Client, data applicationAuthenticationMethodResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var authenticationMethod awstypes.AuthenticationMethodMemberIam
+	diags.Append(fwflex.Expand(ctx, data.IAM, &authenticationMethod.Value)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := ssoadmin.PutApplicationAuthenticationMethodInput{
+		ApplicationArn:           fwflex.StringFromFramework(ctx, data.ApplicationARN),
+		AuthenticationMethod:     &authenticationMethod,
+		AuthenticationMethodType: data.AuthenticationMethodType.ValueEnum(),
+	}
+	_, err := conn.PutApplicationAuthenticationMethod(ctx, &input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("putting SSO Application (%s) Authentication Method (%s)", data.ApplicationARN.ValueString(), data.AuthenticationMethodType.ValueString()), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+func findApplicationAuthenticationMethodByTwoPartKey(ctx context.Context, conn *ssoadmin.Client, applicationARN string, authenticationMethodType awstypes.AuthenticationMethodType) (*ssoadmin.GetApplicationAuthenticationMethodOutput, error) {
+	input := ssoadmin.GetApplicationAuthenticationMethodInput{
+		ApplicationArn:           aws.String(applicationARN),
+		AuthenticationMethodType: authenticationMethodType,
+	}
+	output, err := conn.GetApplicationAuthenticationMethod(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AuthenticationMethod == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type applicationAuthenticationMethodResourceModel struct {
+	framework.WithRegionModel
+	ApplicationARN           fwtypes.ARN                                                   `tfsdk:"application_arn"`
+	AuthenticationMethodType fwtypes.StringEnum[awstypes.AuthenticationMethodType]         `tfsdk:"authentication_method_type"`
+	IAM                      fwtypes.ListNestedObjectValueOf[iamAuthenticationMethodModel] `tfsdk:"iam"`
+	ID                       types.String                                                  `tfsdk:"id"`
+	Timeouts                 timeouts.Value                                                `tfsdk:"timeouts"`
+}
+
+type iamAuthenticationMethodModel struct {
+	ActorPolicy fwtypes.SmithyJSON[document.Interface] `tfsdk:"actor_policy"`
+}
@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ssoadmin_application_grant", name="Application Grant")
+func newApplicationGrantResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationGrantResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	applicationGrantIDPartCount = 2
+)
+
+type applicationGrantResource struct {
+	framework.ResourceWithModel[applicationGrantResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *applicationGrantResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grant_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GrantType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"authorization_code": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[authorizationCodeGrantModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"redirect_uris": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"jwt_bearer": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jwtBearerGrantModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"authorized_token_issuer": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[authorizedTokenIssuerModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeBetween(1, 10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authorized_audiences": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"trusted_token_issuer_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *applicationGrantResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationGrantResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	applicationARN, grantType := data.ApplicationARN.ValueString(), data.GrantType.ValueString()
+	id, err := intflex.FlattenResourceId([]string{applicationARN, grantType}, applicationGrantIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSO Application (%s) Grant (%s)", applicationARN, grantType), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(putApplicationGrant(ctx, conn, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationGrantResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationGrantResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), applicationGrantIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Grant (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	applicationARN, grantType := parts[0], awstypes.GrantType(parts[1])
+	output, err := findApplicationGrantByTwoPartKey(ctx, conn, applicationARN, grantType)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Grant (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Application ARN and grant type are not returned by GetApplicationGrant.
+	data.ApplicationARN = fwtypes.ARNValue(applicationARN)
+	data.GrantType = fwtypes.StringEnumValue(grantType)
+
+	response.Diagnostics.Append(data.flattenGrant(ctx, output.Grant)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationGrantResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new applicationGrantResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.UpdateTimeout(ctx, new.Timeouts))
+	defer cancel()
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	// PutApplicationGrant replaces the grant in place, preserving client registrations.
+	response.Diagnostics.Append(putApplicationGrant(ctx, conn, new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationGrantResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationGrantResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	input := ssoadmin.DeleteApplicationGrantInput{
+		ApplicationArn: fwflex.StringFromFramework(ctx, data.ApplicationARN),
+		GrantType:      data.GrantType.ValueEnum(),
+	}
+	_, err := conn.DeleteApplicationGrant(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSO Application Grant (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func putApplicationGrant(ctx context.Context, conn *ssoadmin.Client, data applicationGrantResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	grant, d := data.expandGrant(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := ssoadmin.PutApplicationGrantInput{
+		ApplicationArn: fwflex.StringFromFramework(ctx, data.ApplicationARN),
+		Grant:          grant,
+		GrantType:      data.GrantType.ValueEnum(),
+	}
+	_, err := conn.PutApplicationGrant(ctx, &input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("putting SSO Application (%s) Grant (%s)", data.ApplicationARN.ValueString(), data.GrantType.ValueString()), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+func findApplicationGrantByTwoPartKey(ctx context.Context, conn *ssoadmin.Client, applicationARN string, grantType awstypes.GrantType) (*ssoadmin.GetApplicationGrantOutput, error) {
+	input := ssoadmin.GetApplicationGrantInput{
+		ApplicationArn: aws.String(applicationARN),
+		GrantType:      grantType,
+	}
+	output, err := conn.GetApplicationGrant(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Grant == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type applicationGrantResourceModel struct {
+	framework.WithRegionModel
+	ApplicationARN    fwtypes.ARN                                                  `tfsdk:"application_arn"`
+	AuthorizationCode fwtypes.ListNestedObjectValueOf[authorizationCodeGrantModel] `tfsdk:"authorization_code"`
+	GrantType         fwtypes.StringEnum[awstypes.GrantType]                       `tfsdk:"grant_type"`
+	ID                types.String                                                 `tfsdk:"id"`
+	JWTBearer         fwtypes.ListNestedObjectValueOf[jwtBearerGrantModel]         `tfsdk:"jwt_bearer"`
+	Timeouts          timeouts.Value                                               `tfsdk:"timeouts"`
+}
+
+// expandGrant returns the grant for the configured grant type.
+// Refresh token and token exchange grants have no configuration.
+func (m applicationGrantResourceModel) expandGrant(ctx context.Context) (awstypes.Grant, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	grantType := m.GrantType.ValueEnum()
+
+	if grantType != awstypes.GrantTypeAuthorizationCode && !m.AuthorizationCode.IsNull() && len(m.AuthorizationCode.Elements()) > 0 {
+		diags.AddError("Invalid grant configuration", fmt.Sprintf(`"authorization_code" cannot be configured for grant type %q`, grantType))
+		return nil, diags
+	}
+
+	if grantType != awstypes.GrantTypeJwtBearer && !m.JWTBearer.IsNull() && len(m.JWTBearer.Elements()) > 0 {
+		diags.AddError("Invalid grant configuration", fmt.Sprintf(`"jwt_bearer" cannot be configured for grant type %q`, grantType))
+		return nil, diags
+	}
+
+	switch grantType {
+	case awstypes.GrantTypeAuthorizationCode:
+		var r awstypes.GrantMemberAuthorizationCode
+		diags.Append(fwflex.Expand(ctx, m.AuthorizationCode, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	case awstypes.GrantTypeJwtBearer:
+		var r awstypes.GrantMemberJwtBearer
+		diags.Append(fwflex.Expand(ctx, m.JWTBearer, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	case awstypes.GrantTypeRefreshToken:
+		return &awstypes.GrantMemberRefreshToken{}, diags
+	case awstypes.GrantTypeTokenExchange:
+		return &awstypes.GrantMemberTokenExchange{}, diags
+	}
+
+	diags.AddError("Invalid grant configuration", fmt.Sprintf("unsupported grant type %q", grantType))
+
+	return nil, diags
+}
+
+func (m *applicationGrantResourceModel) flattenGrant(ctx context.Context, grant awstypes.Grant) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.AuthorizationCode = fwtypes.NewListNestedObjectValueOfNull[authorizationCodeGrantModel](ctx)
+	m.JWTBearer = fwtypes.NewListNestedObjectValueOfNull[jwtBearerGrantModel](ctx)
+
+	switch v := grant.(type) {
+	case *awstypes.GrantMemberAuthorizationCode:
+		var authorizationCode authorizationCodeGrantModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &authorizationCode)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.AuthorizationCode = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &authorizationCode)
+	case *awstypes.GrantMemberJwtBearer:
+		var jwtBearer jwtBearerGrantModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &jwtBearer)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.JWTBearer = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &jwtBearer)
+	}
+
+	return diags
+}
+
+type authorizationCodeGrantModel struct {
+	RedirectURIs fwtypes.SetOfString `tfsdk:"redirect_uris"`
+}
+
+type jwtBearerGrantModel struct {
+	AuthorizedTokenIssuers fwtypes.ListNestedObjectValueOf[authorizedTokenIssuerModel] `tfsdk:"authorized_token_issuer"`
+}
+
+type authorizedTokenIssuerModel struct {
+	AuthorizedAudiences   fwtypes.ListOfString `tfsdk:"authorized_audiences"`
+	TrustedTokenIssuerARN fwtypes.ARN          `tfsdk:"trusted_token_issuer_arn"`
+}
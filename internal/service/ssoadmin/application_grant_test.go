@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminApplicationGrant_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application_grant.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationGrantConfig_authorizationCode(rName, "https://example.com/callback"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", applicationResourceName, "application_arn"),
+					resource.TestCheckResourceAttr(resourceName, "grant_type", string(awstypes.GrantTypeAuthorizationCode)),
+					resource.TestCheckResourceAttr(resourceName, "authorization_code.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authorization_code.0.redirect_uris.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "authorization_code.0.redirect_uris.*", "https://example.com/callback"),
+					resource.TestCheckResourceAttr(resourceName, "jwt_bearer.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationGrantConfig_authorizationCode(rName, "https://example.com/rotated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorization_code.0.redirect_uris.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "authorization_code.0.redirect_uris.*", "https://example.com/rotated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminApplicationGrant_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application_grant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationGrantConfig_authorizationCode(rName, "https://example.com/callback"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationGrantExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfssoadmin.ResourceApplicationGrant, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationGrantDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssoadmin_application_grant" {
+				continue
+			}
+
+			_, err := tfssoadmin.FindApplicationGrantByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_arn"], awstypes.GrantType(rs.Primary.Attributes["grant_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSO Application Grant %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationGrantExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		_, err := tfssoadmin.FindApplicationGrantByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_arn"], awstypes.GrantType(rs.Primary.Attributes["grant_type"]))
+
+		return err
+	}
+}
+
+func testAccApplicationGrantConfig_authorizationCode(rName, redirectURI string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_application" "test" {
+  name                     = %[1]q
+  application_provider_arn = %[2]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_application_grant" "test" {
+  application_arn = aws_ssoadmin_application.test.application_arn
+  grant_type      = "authorization_code"
+
+  authorization_code {
+    redirect_uris = [%[3]q]
+  }
+}
+`, rName, testAccApplicationProviderARN, redirectURI)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ssoadmin_application_provider", name="Application Provider")
+func newApplicationProviderDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &applicationProviderDataSource{}, nil
+}
+
+type applicationProviderDataSource struct {
+	framework.DataSourceWithModel[applicationProviderDataSourceModel]
+}
+
+func (d *applicationProviderDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_provider_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"display_data": framework.DataSourceComputedListOfObjectAttribute[displayDataModel](ctx),
+			"federation_protocol": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID:             framework.IDAttribute(),
+			"resource_server_scopes": framework.DataSourceComputedListOfObjectAttribute[resourceServerScopeModel](ctx),
+		},
+	}
+}
+
+func (d *applicationProviderDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data applicationProviderDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSOAdminClient(ctx)
+
+	arn := data.ApplicationProviderARN.ValueString()
+	output, err := findApplicationProviderByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Application Provider (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, arn)
+
+	var scopes []resourceServerScopeModel
+	if output.ResourceServerConfig != nil {
+		for scope, v := range output.ResourceServerConfig.Scopes {
+			scopes = append(scopes, resourceServerScopeModel{
+				DetailedTitle:   fwflex.StringToFramework(ctx, v.DetailedTitle),
+				LongDescription: fwflex.StringToFramework(ctx, v.LongDescription),
+				Scope:           fwflex.StringValueToFramework(ctx, scope),
+			})
+		}
+	}
+	slices.SortFunc(scopes, func(a, b resourceServerScopeModel) int {
+		return strings.Compare(a.Scope.ValueString(), b.Scope.ValueString())
+	})
+	data.ResourceServerScopes = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, scopes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findApplicationProviderByARN(ctx context.Context, conn *ssoadmin.Client, arn string) (*ssoadmin.DescribeApplicationProviderOutput, error) {
+	input := ssoadmin.DescribeApplicationProviderInput{
+		ApplicationProviderArn: aws.String(arn),
+	}
+	output, err := conn.DescribeApplicationProvider(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type applicationProviderDataSourceModel struct {
+	framework.WithRegionModel
+	ApplicationProviderARN fwtypes.ARN                                               `tfsdk:"application_provider_arn"`
+	DisplayData            fwtypes.ListNestedObjectValueOf[displayDataModel]         `tfsdk:"display_data"`
+	FederationProtocol     types.String                                              `tfsdk:"federation_protocol"`
+	ID                     types.String                                              `tfsdk:"id"`
+	ResourceServerScopes   fwtypes.ListNestedObjectValueOf[resourceServerScopeModel] `tfsdk:"resource_server_scopes" autoflex:"-"`
+}
+
+type resourceServerScopeModel struct {
+	DetailedTitle   types.String `tfsdk:"detailed_title"`
+	LongDescription types.String `tfsdk:"long_description"`
+	Scope           types.String `tfsdk:"scope"`
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminApplicationProviderDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssoadmin_application_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationProviderDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, dataSourceName, "application_provider_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "display_data.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "display_data.0.display_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "federation_protocol"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationProviderDataSourceConfig_basic() string {
+	return `
+data "aws_ssoadmin_application_provider" "test" {
+  application_provider_arn = "arn:aws:sso::aws:applicationProvider/custom" #lintignore:AWSAT005
+}
+`
+}
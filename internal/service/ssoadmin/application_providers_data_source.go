@@ -30,7 +30,11 @@ func (d *applicationProvidersDataSource) Schema(ctx context.Context, request dat
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"application_providers": framework.DataSourceComputedListOfObjectAttribute[applicationProviderModel](ctx),
-			names.AttrID:            framework.IDAttribute(),
+			"federation_protocol": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FederationProtocol](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
 		},
 	}
 }
@@ -56,7 +60,13 @@ func (d *applicationProvidersDataSource) Read(ctx context.Context, request datas
 			return
 		}
 
-		apiObjects = append(apiObjects, page.ApplicationProviders...)
+		for _, v := range page.ApplicationProviders {
+			if !data.FederationProtocol.IsNull() && v.FederationProtocol != data.FederationProtocol.ValueEnum() {
+				continue
+			}
+
+			apiObjects = append(apiObjects, v)
+		}
 	}
 
 	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region(ctx))
@@ -72,6 +82,7 @@ func (d *applicationProvidersDataSource) Read(ctx context.Context, request datas
 type applicationProvidersDataSourceModel struct {
 	framework.WithRegionModel
 	ApplicationProviders fwtypes.ListNestedObjectValueOf[applicationProviderModel] `tfsdk:"application_providers"`
+	FederationProtocol   fwtypes.StringEnum[awstypes.FederationProtocol]           `tfsdk:"federation_protocol"`
 	ID                   types.String                                              `tfsdk:"id"`
 }
 
@@ -4,9 +4,12 @@
 package ssoadmin_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -38,8 +41,60 @@ func TestAccSSOAdminApplicationProvidersDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminApplicationProvidersDataSource_federationProtocol(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssoadmin_application_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationProvidersDataSourceConfig_federationProtocol("OAUTH"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "federation_protocol", "OAUTH"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "application_providers.*", map[string]string{
+						"application_provider_arn": "arn:aws:sso::aws:applicationProvider/custom", //lintignore:AWSAT005
+					}),
+					testAccCheckApplicationProvidersFederationProtocol(dataSourceName, "OAUTH"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationProvidersFederationProtocol(n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "application_providers.") && strings.HasSuffix(k, ".federation_protocol") && v != want {
+				return fmt.Errorf("%s: %s, want %s", k, v, want)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccApplicationProvidersDataSourceConfig_basic() string {
 	return `
 data "aws_ssoadmin_application_providers" "test" {}
 `
 }
+
+func testAccApplicationProvidersDataSourceConfig_federationProtocol(federationProtocol string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_application_providers" "test" {
+  federation_protocol = %[1]q
+}
+`, federationProtocol)
+}
@@ -193,6 +193,64 @@ func TestAccSSOAdminApplication_portalOptions(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminApplication_portalOptionsVisibility(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application ssoadmin.DescribeApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_portalOptionsVisibility(rName, testAccApplicationProviderARN, string(types.ApplicationVisibilityEnabled), string(types.ApplicationStatusEnabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "portal_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "portal_options.0.sign_in_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "portal_options.0.visibility", string(types.ApplicationVisibilityEnabled)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ApplicationStatusEnabled)),
+				),
+			},
+			{
+				// Disabling the application is done in-place.
+				Config: testAccApplicationConfig_portalOptionsVisibility(rName, testAccApplicationProviderARN, string(types.ApplicationVisibilityEnabled), string(types.ApplicationStatusDisabled)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "portal_options.0.visibility", string(types.ApplicationVisibilityEnabled)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ApplicationStatusDisabled)),
+				),
+			},
+			{
+				// UpdateApplication can't change an application's visibility.
+				Config: testAccApplicationConfig_portalOptionsVisibility(rName, testAccApplicationProviderARN, string(types.ApplicationVisibilityDisabled), string(types.ApplicationStatusDisabled)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "portal_options.0.visibility", string(types.ApplicationVisibilityDisabled)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ApplicationStatusDisabled)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminApplication_status(t *testing.T) {
 	ctx := acctest.Context(t)
 	var application ssoadmin.DescribeApplicationOutput
@@ -242,6 +300,53 @@ func TestAccSSOAdminApplication_status(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminApplication_assignmentRequired(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application ssoadmin.DescribeApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_assignmentRequired(rName, testAccApplicationProviderARN, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "assignment_required", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"assignment_required"},
+			},
+			{
+				Config: testAccApplicationConfig_assignmentRequired(rName, testAccApplicationProviderARN, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "assignment_required", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_basic(rName, testAccApplicationProviderARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckNoResourceAttr(resourceName, "assignment_required"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminApplication_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var application ssoadmin.DescribeApplicationOutput
@@ -494,6 +599,23 @@ resource "aws_ssoadmin_application" "test" {
 `, rName, applicationProviderARN, applicationURL, origin)
 }
 
+func testAccApplicationConfig_portalOptionsVisibility(rName, applicationProviderARN, visibility, status string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_application" "test" {
+  name                     = %[1]q
+  application_provider_arn = %[2]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  status                   = %[4]q
+
+  portal_options {
+    visibility = %[3]q
+  }
+}
+`, rName, applicationProviderARN, visibility, status)
+}
+
 func testAccApplicationConfig_status(rName, applicationProviderARN, status string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -507,6 +629,19 @@ resource "aws_ssoadmin_application" "test" {
 `, rName, applicationProviderARN, status)
 }
 
+func testAccApplicationConfig_assignmentRequired(rName, applicationProviderARN string, assignmentRequired bool) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_application" "test" {
+  name                     = %[1]q
+  application_provider_arn = %[2]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  assignment_required      = %[3]t
+}
+`, rName, applicationProviderARN, assignmentRequired)
+}
+
 func testAccApplicationConfig_tags1(rName, applicationProviderARN, key1, value1 string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ssoadmin_applications", name="Applications")
+func newApplicationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &applicationsDataSource{}, nil
+}
+
+type applicationsDataSource struct {
+	framework.DataSourceWithModel[applicationsDataSourceModel]
+}
+
+func (d *applicationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_provider_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"applications": framework.DataSourceComputedListOfObjectAttribute[applicationSummaryModel](ctx),
+			names.AttrID:   framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationStatus](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *applicationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data applicationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSOAdminClient(ctx)
+
+	instanceARN := data.InstanceARN.ValueString()
+	input := ssoadmin.ListApplicationsInput{
+		InstanceArn: fwflex.StringFromFramework(ctx, data.InstanceARN),
+	}
+	if !data.ApplicationProviderARN.IsNull() {
+		input.Filter = &awstypes.ListApplicationsFilter{
+			ApplicationProvider: fwflex.StringFromFramework(ctx, data.ApplicationProviderARN),
+		}
+	}
+	filter := tfslices.PredicateTrue[awstypes.Application]()
+	if status := data.Status.ValueEnum(); status != "" {
+		filter = func(v awstypes.Application) bool {
+			return v.Status == status
+		}
+	}
+
+	output, err := findApplications(ctx, conn, &input, filter)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Applications (%s)", instanceARN), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, instanceARN)
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Applications)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findApplications(ctx context.Context, conn *ssoadmin.Client, input *ssoadmin.ListApplicationsInput, filter tfslices.Predicate[awstypes.Application]) ([]awstypes.Application, error) {
+	var output []awstypes.Application
+
+	pages := ssoadmin.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type applicationsDataSourceModel struct {
+	framework.WithRegionModel
+	ApplicationProviderARN fwtypes.ARN                                              `tfsdk:"application_provider_arn"`
+	Applications           fwtypes.ListNestedObjectValueOf[applicationSummaryModel] `tfsdk:"applications"`
+	ID                     types.String                                             `tfsdk:"id"`
+	InstanceARN            fwtypes.ARN                                              `tfsdk:"instance_arn"`
+	Status                 fwtypes.StringEnum[awstypes.ApplicationStatus]           `tfsdk:"status"`
+}
+
+type applicationSummaryModel struct {
+	ApplicationAccount     types.String                                   `tfsdk:"application_account"`
+	ApplicationARN         types.String                                   `tfsdk:"application_arn"`
+	ApplicationProviderARN types.String                                   `tfsdk:"application_provider_arn"`
+	Description            types.String                                   `tfsdk:"description"`
+	Name                   types.String                                   `tfsdk:"name"`
+	Status                 fwtypes.StringEnum[awstypes.ApplicationStatus] `tfsdk:"status"`
+}
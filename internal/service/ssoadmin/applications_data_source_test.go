@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminApplicationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssoadmin_applications.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationsDataSourceConfig_basic(rName, testAccApplicationProviderARN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, applicationResourceName, "instance_arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "applications.*.application_arn", applicationResourceName, "application_arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "applications.*", map[string]string{
+						"application_provider_arn": testAccApplicationProviderARN,
+						names.AttrName:             rName,
+						names.AttrStatus:           "ENABLED",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminApplicationsDataSource_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssoadmin_applications.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationsDataSourceConfig_status(rName, testAccApplicationProviderARN, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "applications.*", map[string]string{
+						names.AttrName:   rName,
+						names.AttrStatus: "DISABLED",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationsDataSourceConfig_basic(rName, applicationProviderARN string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName, applicationProviderARN), `
+data "aws_ssoadmin_applications" "test" {
+  instance_arn             = aws_ssoadmin_application.test.instance_arn
+  application_provider_arn = aws_ssoadmin_application.test.application_provider_arn
+}
+`)
+}
+
+func testAccApplicationsDataSourceConfig_status(rName, applicationProviderARN, status string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_status(rName, applicationProviderARN, status), fmt.Sprintf(`
+data "aws_ssoadmin_applications" "test" {
+  instance_arn = aws_ssoadmin_application.test.instance_arn
+  status       = %[1]q
+}
+`, status))
+}
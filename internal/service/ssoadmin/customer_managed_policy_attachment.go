@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -31,10 +32,15 @@ func resourceCustomerManagedPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomerManagedPolicyAttachmentCreate,
 		ReadWithoutTimeout:   resourceCustomerManagedPolicyAttachmentRead,
+		UpdateWithoutTimeout: resourceCustomerManagedPolicyAttachmentUpdate,
 		DeleteWithoutTimeout: resourceCustomerManagedPolicyAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				d.Set("verify_policy_exists", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -78,6 +84,11 @@ func resourceCustomerManagedPolicyAttachment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"verify_policy_exists": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -92,6 +103,11 @@ func resourceCustomerManagedPolicyAttachmentCreate(ctx context.Context, d *schem
 	instanceARN := d.Get("instance_arn").(string)
 	permissionSetARN := d.Get("permission_set_arn").(string)
 	id := CustomerManagedPolicyAttachmentCreateResourceID(policyName, policyPath, permissionSetARN, instanceARN)
+
+	if d.Get("verify_policy_exists").(bool) {
+		diags = append(diags, verifyCustomerManagedPolicyExists(ctx, meta.(*conns.AWSClient), policyName, policyPath)...)
+	}
+
 	input := &ssoadmin.AttachCustomerManagedPolicyReferenceToPermissionSetInput{
 		CustomerManagedPolicyReference: expandCustomerManagedPolicyReference(tfMap),
 		InstanceArn:                    aws.String(instanceARN),
@@ -144,6 +160,11 @@ func resourceCustomerManagedPolicyAttachmentRead(ctx context.Context, d *schema.
 	return diags
 }
 
+func resourceCustomerManagedPolicyAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Only verify_policy_exists can be updated, and it is only used during creation.
+	return resourceCustomerManagedPolicyAttachmentRead(ctx, d, meta)
+}
+
 func resourceCustomerManagedPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
@@ -200,6 +221,27 @@ func CustomerManagedPolicyAttachmentParseResourceID(id string) (string, string,
 	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CUSTOMER_MANAGED_POLICY_NAME%[2]sCUSTOMER_MANAGED_POLICY_PATH%[2]sPERMISSION_SET_ARN%[2]sINSTANCE_ARN", id, customerManagedPolicyAttachmentIDSeparator)
 }
 
+// verifyCustomerManagedPolicyExists returns a warning if the referenced IAM policy does not exist in the
+// account that Terraform is managing the SSO instance from, usually the organization's management account.
+// The policy need only exist in the accounts that the permission set is provisioned to, so a missing policy
+// is not an error, but it is most often a typo in the policy name or path.
+func verifyCustomerManagedPolicyExists(ctx context.Context, c *conns.AWSClient, policyName, policyPath string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policyARN := c.GlobalARN(ctx, "iam", "policy"+policyPath+policyName)
+	_, err := tfiam.FindPolicyByARN(ctx, c.IAMClient(ctx), policyARN)
+
+	if tfresource.NotFound(err) {
+		return sdkdiag.AppendWarningf(diags, "IAM policy %s was not found in account %s. The permission set will fail to provision to any account in which the policy does not exist.", policyARN, c.AccountID(ctx))
+	}
+
+	if err != nil {
+		return sdkdiag.AppendWarningf(diags, "unable to verify that IAM policy %s exists: %s", policyARN, err)
+	}
+
+	return diags
+}
+
 func findCustomerManagedPolicyByFourPartKey(ctx context.Context, conn *ssoadmin.Client, policyName, policyPath, permissionSetARN, instanceARN string) (*awstypes.CustomerManagedPolicyReference, error) {
 	input := &ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetInput{
 		InstanceArn:      aws.String(instanceARN),
@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -50,6 +51,49 @@ func TestAccSSOAdminCustomerManagedPolicyAttachment_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminCustomerManagedPolicyAttachment_verifyPolicyExists(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_customer_managed_policy_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePolicy1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePolicy2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomerManagedPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomerManagedPolicyAttachmentConfig_verifyPolicyExists(rName, rNamePolicy1, rNamePolicy2, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomerManagedPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "customer_managed_policy_reference.0.name", rNamePolicy1),
+					resource.TestCheckResourceAttr(resourceName, "verify_policy_exists", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_policy_exists"},
+			},
+			{
+				Config: testAccCustomerManagedPolicyAttachmentConfig_verifyPolicyExists(rName, rNamePolicy1, rNamePolicy2, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomerManagedPolicyAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "verify_policy_exists", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminCustomerManagedPolicyAttachment_forceNew(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ssoadmin_customer_managed_policy_attachment.test"
@@ -298,6 +342,21 @@ resource "aws_ssoadmin_customer_managed_policy_attachment" "test" {
 `)
 }
 
+func testAccCustomerManagedPolicyAttachmentConfig_verifyPolicyExists(rName, rNamePolicy1, rNamePolicy2 string, verifyPolicyExists bool) string {
+	return acctest.ConfigCompose(testAccCustomerManagedPolicyAttachmentConfig_base(rName, rNamePolicy1, rNamePolicy2), fmt.Sprintf(`
+resource "aws_ssoadmin_customer_managed_policy_attachment" "test" {
+  instance_arn         = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn   = aws_ssoadmin_permission_set.test.arn
+  verify_policy_exists = %[1]t
+
+  customer_managed_policy_reference {
+    name = aws_iam_policy.test1.name
+    path = "/"
+  }
+}
+`, verifyPolicyExists))
+}
+
 func testAccCustomerManagedPolicyAttachmentConfig_forceNew(rName, rNamePolicy1, rNamePolicy2 string) string {
 	return acctest.ConfigCompose(testAccCustomerManagedPolicyAttachmentConfig_base(rName, rNamePolicy1, rNamePolicy2), `
 resource "aws_ssoadmin_customer_managed_policy_attachment" "test" {
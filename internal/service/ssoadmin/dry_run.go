@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	dryRunOperationCreate = "Create"
+	dryRunOperationDelete = "Delete"
+
+	dryRunWarningSummary = "SSO Admin dry run: assignment operation not performed"
+
+	logKeyDryRunOperation = "tf_aws.ssoadmin.dry_run_operation"
+)
+
+// accountAssignmentsDryRunOperations logs, and returns the details of, each account assignment operation
+// that would be performed to change the old set of account assignments to the new set.
+func accountAssignmentsDryRunOperations(ctx context.Context, instanceARN string, o, n []accountAssignmentKey) []string {
+	var details []string
+
+	del, add := diffAccountAssignments(o, n)
+
+	for _, v := range del {
+		details = append(details, accountAssignmentDryRunDetail(ctx, dryRunOperationDelete, instanceARN, v))
+	}
+
+	for _, v := range add {
+		details = append(details, accountAssignmentDryRunDetail(ctx, dryRunOperationCreate, instanceARN, v))
+	}
+
+	return details
+}
+
+func accountAssignmentDryRunDetail(ctx context.Context, operation, instanceARN string, v accountAssignmentKey) string {
+	tflog.Warn(ctx, "SSO Admin dry run", map[string]any{
+		logKeyDryRunOperation: operation + "AccountAssignment",
+		"instance_arn":        instanceARN,
+		"permission_set_arn":  v.permissionSetARN,
+		"principal_id":        v.principalID,
+		"principal_type":      string(v.principalType),
+		"target_id":           v.targetID,
+	})
+
+	return fmt.Sprintf("%sAccountAssignment: instance %s, permission set %s, %s %s, account %s",
+		operation, instanceARN, v.permissionSetARN, v.principalType, v.principalID, v.targetID)
+}
+
+// applicationAssignmentDryRunDetail logs the application assignment operation that would be performed
+// and returns the detail of its warning.
+func applicationAssignmentDryRunDetail(ctx context.Context, operation, applicationARN, principalType, principalID string) string {
+	tflog.Warn(ctx, "SSO Admin dry run", map[string]any{
+		logKeyDryRunOperation: operation + "ApplicationAssignment",
+		"application_arn":     applicationARN,
+		"principal_id":        principalID,
+		"principal_type":      principalType,
+	})
+
+	return fmt.Sprintf("%sApplicationAssignment: application %s, %s %s", operation, applicationARN, principalType, principalID)
+}
+
+// dryRunError returns the error reported when applying changes to a resource with dry_run set.
+// Nothing is performed, and the resource's state is unchanged.
+func dryRunError(details ...string) error {
+	return fmt.Errorf("dry_run is set, so the following operations were not performed; set dry_run to false to perform them:\n%s", strings.Join(details, "\n"))
+}
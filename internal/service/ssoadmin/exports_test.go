@@ -6,10 +6,13 @@ package ssoadmin
 // Exports for use in tests only.
 var (
 	ResourceAccountAssignment                  = resourceAccountAssignment
+	ResourceAccountAssignments                 = resourceAccountAssignments
 	ResourceApplication                        = newApplicationResource
 	ResourceApplicationAssignment              = newApplicationAssignmentResource
 	ResourceApplicationAssignmentConfiguration = newApplicationAssignmentConfigurationResource
 	ResourceApplicationAccessScope             = newApplicationAccessScopeResource
+	ResourceApplicationAuthenticationMethod    = newApplicationAuthenticationMethodResource
+	ResourceApplicationGrant                   = newApplicationGrantResource
 	ResourceCustomerManagedPolicyAttachment    = resourceCustomerManagedPolicyAttachment
 	ResourceInstanceAccessControlAttributes    = resourceInstanceAccessControlAttributes
 	ResourceManagedPolicyAttachment            = resourceManagedPolicyAttachment
@@ -18,16 +21,18 @@ var (
 	ResourcePermissionSetInlinePolicy          = resourcePermissionSetInlinePolicy
 	ResourceTrustedTokenIssuer                 = newTrustedTokenIssuerResource
 
-	FindAccountAssignmentByFivePartKey          = findAccountAssignmentByFivePartKey
-	FindApplicationByID                         = findApplicationByID
-	FindApplicationAssignmentByID               = findApplicationAssignmentByID
-	FindApplicationAssignmentConfigurationByID  = findApplicationAssignmentConfigurationByID
-	FindApplicationAccessScopeByID              = findApplicationAccessScopeByID
-	FindCustomerManagedPolicyByFourPartKey      = findCustomerManagedPolicyByFourPartKey
-	FindInstanceAttributeControlAttributesByARN = findInstanceAttributeControlAttributesByARN
-	FindManagedPolicyByThreePartKey             = findManagedPolicyByThreePartKey
-	FindPermissionsBoundaryByTwoPartKey         = findPermissionsBoundaryByTwoPartKey
-	FindPermissionSetByTwoPartKey               = findPermissionSetByTwoPartKey
-	FindPermissionSetInlinePolicyByTwoPartKey   = findPermissionSetInlinePolicyByTwoPartKey
-	FindTrustedTokenIssuerByARN                 = findTrustedTokenIssuerByARN
+	FindAccountAssignmentByFivePartKey              = findAccountAssignmentByFivePartKey
+	FindApplicationByID                             = findApplicationByID
+	FindApplicationAssignmentByID                   = findApplicationAssignmentByID
+	FindApplicationAssignmentConfigurationByID      = findApplicationAssignmentConfigurationByID
+	FindApplicationAccessScopeByID                  = findApplicationAccessScopeByID
+	FindApplicationAuthenticationMethodByTwoPartKey = findApplicationAuthenticationMethodByTwoPartKey
+	FindApplicationGrantByTwoPartKey                = findApplicationGrantByTwoPartKey
+	FindCustomerManagedPolicyByFourPartKey          = findCustomerManagedPolicyByFourPartKey
+	FindInstanceAttributeControlAttributesByARN     = findInstanceAttributeControlAttributesByARN
+	FindManagedPolicyByThreePartKey                 = findManagedPolicyByThreePartKey
+	FindPermissionsBoundaryByTwoPartKey             = findPermissionsBoundaryByTwoPartKey
+	FindPermissionSetByTwoPartKey                   = findPermissionSetByTwoPartKey
+	FindPermissionSetInlinePolicyByTwoPartKey       = findPermissionSetInlinePolicyByTwoPartKey
+	FindTrustedTokenIssuerByARN                     = findTrustedTokenIssuerByARN
 )
@@ -5,7 +5,10 @@ package ssoadmin
 
 import (
 	"context"
+	"errors"
 	"log"
+	"slices"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
@@ -14,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -34,6 +38,11 @@ func resourceInstanceAccessControlAttributes() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"attribute": {
 				Type:     schema.TypeSet,
@@ -88,7 +97,7 @@ func resourceInstanceAccessControlAttributesCreate(ctx context.Context, d *schem
 	input := &ssoadmin.CreateInstanceAccessControlAttributeConfigurationInput{
 		InstanceArn: aws.String(instanceARN),
 		InstanceAccessControlAttributeConfiguration: &awstypes.InstanceAccessControlAttributeConfiguration{
-			AccessControlAttributes: expandAccessControlAttributes(d),
+			AccessControlAttributes: expandAccessControlAttributes(d.Get("attribute").(*schema.Set).List()),
 		},
 	}
 
@@ -100,6 +109,10 @@ func resourceInstanceAccessControlAttributesCreate(ctx context.Context, d *schem
 
 	d.SetId(instanceARN)
 
+	if _, err := waitInstanceAccessControlAttributesEnabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSO Instance Access Control Attributes (%s) create: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceInstanceAccessControlAttributesRead(ctx, d, meta)...)
 }
 
@@ -133,17 +146,36 @@ func resourceInstanceAccessControlAttributesUpdate(ctx context.Context, d *schem
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
 
-	input := &ssoadmin.UpdateInstanceAccessControlAttributeConfigurationInput{
-		InstanceArn: aws.String(d.Id()),
-		InstanceAccessControlAttributeConfiguration: &awstypes.InstanceAccessControlAttributeConfiguration{
-			AccessControlAttributes: expandAccessControlAttributes(d),
-		},
-	}
+	if d.HasChange("attribute") {
+		// The API only supports replacing the whole configuration, so apply the planned
+		// per-attribute changes on top of the current remote configuration.
+		output, err := findInstanceAttributeControlAttributesByARN(ctx, conn, d.Id())
 
-	_, err := conn.UpdateInstanceAccessControlAttributeConfiguration(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSO Instance Access Control Attributes (%s): %s", d.Id(), err)
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating SSO Instance Access Control Attributes (%s): %s", d.Id(), err)
+		o, n := d.GetChange("attribute")
+		input := &ssoadmin.UpdateInstanceAccessControlAttributeConfigurationInput{
+			InstanceArn: aws.String(d.Id()),
+			InstanceAccessControlAttributeConfiguration: &awstypes.InstanceAccessControlAttributeConfiguration{
+				AccessControlAttributes: applyAccessControlAttributesChanges(
+					output.InstanceAccessControlAttributeConfiguration.AccessControlAttributes,
+					expandAccessControlAttributes(o.(*schema.Set).List()),
+					expandAccessControlAttributes(n.(*schema.Set).List()),
+				),
+			},
+		}
+
+		_, err = conn.UpdateInstanceAccessControlAttributeConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSO Instance Access Control Attributes (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitInstanceAccessControlAttributesEnabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SSO Instance Access Control Attributes (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceInstanceAccessControlAttributesRead(ctx, d, meta)...)
@@ -189,20 +221,120 @@ func findInstanceAttributeControlAttributesByARN(ctx context.Context, conn *ssoa
 	return output, nil
 }
 
-func expandAccessControlAttributes(d *schema.ResourceData) []awstypes.AccessControlAttribute {
+func statusInstanceAccessControlAttributes(ctx context.Context, conn *ssoadmin.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findInstanceAttributeControlAttributesByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitInstanceAccessControlAttributesEnabled(ctx context.Context, conn *ssoadmin.Client, arn string, timeout time.Duration) (*ssoadmin.DescribeInstanceAccessControlAttributeConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.InstanceAccessControlAttributeConfigurationStatusCreationInProgress),
+		Target:     enum.Slice(awstypes.InstanceAccessControlAttributeConfigurationStatusEnabled),
+		Refresh:    statusInstanceAccessControlAttributes(ctx, conn, arn),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssoadmin.DescribeInstanceAccessControlAttributeConfigurationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// applyAccessControlAttributesChanges applies the differences between the previously (from) and currently (to)
+// configured attributes to the current remote attributes.
+// Attributes removed from configuration are dropped, added or modified attributes are
+// upserted by key and all other remote attributes are preserved.
+func applyAccessControlAttributesChanges(remote, from, to []awstypes.AccessControlAttribute) []awstypes.AccessControlAttribute {
+	oldByKey := make(map[string]awstypes.AccessControlAttribute, len(from))
+	for _, v := range from {
+		oldByKey[aws.ToString(v.Key)] = v
+	}
+	newByKey := make(map[string]awstypes.AccessControlAttribute, len(to))
+	for _, v := range to {
+		newByKey[aws.ToString(v.Key)] = v
+	}
+
+	var attributes []awstypes.AccessControlAttribute
+	seen := make(map[string]bool, len(remote))
+
+	for _, v := range remote {
+		key := aws.ToString(v.Key)
+		seen[key] = true
+
+		if n, ok := newByKey[key]; ok {
+			if o, ok := oldByKey[key]; !ok || !accessControlAttributeValuesEqual(o.Value, n.Value) {
+				v = n
+			}
+			attributes = append(attributes, v)
+			continue
+		}
+
+		if _, ok := oldByKey[key]; ok {
+			continue
+		}
+
+		attributes = append(attributes, v)
+	}
+
+	for _, v := range to {
+		if !seen[aws.ToString(v.Key)] {
+			attributes = append(attributes, v)
+		}
+	}
+
+	return attributes
+}
+
+func accessControlAttributeValuesEqual(x, y *awstypes.AccessControlAttributeValue) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	a, b := slices.Clone(x.Source), slices.Clone(y.Source)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
+
+func expandAccessControlAttributes(tfList []any) []awstypes.AccessControlAttribute {
 	var attributes []awstypes.AccessControlAttribute
 
-	attInterface := d.Get("attribute").(*schema.Set).List()
-	for _, attrMap := range attInterface {
-		attr := attrMap.(map[string]any)
+	for _, tfMapRaw := range tfList {
+		attr, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
 		var attribute awstypes.AccessControlAttribute
 		if key, ok := attr[names.AttrKey].(string); ok {
 			attribute.Key = aws.String(key)
 		}
-		val := attr[names.AttrValue].(*schema.Set).List()[0].(map[string]any)
-		if v, ok := val[names.AttrSource].(*schema.Set); ok && len(v.List()) > 0 {
-			attribute.Value = &awstypes.AccessControlAttributeValue{
-				Source: flex.ExpandStringValueSet(v),
+		if v, ok := attr[names.AttrValue].(*schema.Set); ok && v.Len() > 0 {
+			if val, ok := v.List()[0].(map[string]any); ok {
+				if v, ok := val[names.AttrSource].(*schema.Set); ok && v.Len() > 0 {
+					attribute.Value = &awstypes.AccessControlAttributeValue{
+						Source: flex.ExpandStringValueSet(v),
+					}
+				}
 			}
 		}
 		attributes = append(attributes, attribute)
@@ -219,9 +351,11 @@ func flattenAccessControlAttributes(attributes []awstypes.AccessControlAttribute
 
 	for _, attr := range attributes {
 		var val []any
-		val = append(val, map[string]any{
-			names.AttrSource: flex.FlattenStringValueSet(attr.Value.Source),
-		})
+		if attr.Value != nil {
+			val = append(val, map[string]any{
+				names.AttrSource: flex.FlattenStringValueSet(attr.Value.Source),
+			})
+		}
 		results = append(results, map[string]any{
 			names.AttrKey:   aws.ToString(attr.Key),
 			names.AttrValue: val,
@@ -8,7 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -26,6 +30,8 @@ func TestAccSSOAdmin_serial(t *testing.T) {
 			acctest.CtDisappears: testAccInstanceAccessControlAttributes_disappears,
 			"multiple":           testAccInstanceAccessControlAttributes_multiple,
 			"update":             testAccInstanceAccessControlAttributes_update,
+			"partialUpdate":      testAccInstanceAccessControlAttributes_partialUpdate,
+			"outOfBandChange":    testAccInstanceAccessControlAttributes_outOfBandChange,
 		},
 	}
 
@@ -139,6 +145,89 @@ func testAccInstanceAccessControlAttributes_update(t *testing.T) {
 	})
 }
 
+func testAccInstanceAccessControlAttributes_partialUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_instance_access_control_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceAccessControlAttributesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAccessControlAttributesConfig_multiple(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAccessControlAttributesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "2"),
+				),
+			},
+			{
+				Config: testAccInstanceAccessControlAttributesConfig_partialUpdate(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAccessControlAttributesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						names.AttrKey: "name",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						names.AttrKey: "email",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInstanceAccessControlAttributes_outOfBandChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_instance_access_control_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceAccessControlAttributesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAccessControlAttributesConfig_multiple(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAccessControlAttributesExists(ctx, resourceName),
+					testAccCheckInstanceAccessControlAttributesUpdateOutOfBand(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccInstanceAccessControlAttributesConfig_multiple(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAccessControlAttributesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						names.AttrKey: "last",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "attribute.*.value.*.source.*", "${path:name.familyName}"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceAccessControlAttributesDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
@@ -184,6 +273,49 @@ func testAccCheckInstanceAccessControlAttributesExists(ctx context.Context, reso
 	}
 }
 
+// testAccCheckInstanceAccessControlAttributesUpdateOutOfBand changes the source of one attribute
+// and adds another attribute outside of Terraform.
+func testAccCheckInstanceAccessControlAttributesUpdateOutOfBand(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		input := ssoadmin.UpdateInstanceAccessControlAttributeConfigurationInput{
+			InstanceArn: aws.String(rs.Primary.ID),
+			InstanceAccessControlAttributeConfiguration: &awstypes.InstanceAccessControlAttributeConfiguration{
+				AccessControlAttributes: []awstypes.AccessControlAttribute{
+					{
+						Key: aws.String("name"),
+						Value: &awstypes.AccessControlAttributeValue{
+							Source: []string{"${path:name.givenName}"},
+						},
+					},
+					{
+						Key: aws.String("last"),
+						Value: &awstypes.AccessControlAttributeValue{
+							Source: []string{"${path:displayName}"},
+						},
+					},
+					{
+						Key: aws.String("email"),
+						Value: &awstypes.AccessControlAttributeValue{
+							Source: []string{"${path:emails[primary eq true].value}"},
+						},
+					},
+				},
+			},
+		}
+
+		_, err := conn.UpdateInstanceAccessControlAttributeConfiguration(ctx, &input)
+
+		return err
+	}
+}
+
 func testAccInstanceAccessControlAttributesConfig_basic() string {
 	return `
 data "aws_ssoadmin_instances" "test" {}
@@ -236,3 +368,25 @@ resource "aws_ssoadmin_instance_access_control_attributes" "test" {
 }
 `
 }
+
+func testAccInstanceAccessControlAttributesConfig_partialUpdate() string {
+	return `
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_instance_access_control_attributes" "test" {
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  attribute {
+    key = "name"
+    value {
+      source = ["$${path:name.givenName}"]
+    }
+  }
+  attribute {
+    key = "email"
+    value {
+      source = ["$${path:emails[primary eq true].value}"]
+    }
+  }
+}
+`
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -78,6 +80,11 @@ func resourcePermissionSet() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`[\w+=,.@-]+`), "must match [\\w+=,.@-]"),
 				),
 			},
+			"provisioning_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 			"relay_state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -212,7 +219,13 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// Re-provision ALL accounts after making the above changes
-		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if v, ok := d.GetOk("provisioning_concurrency"); ok {
+			err = provisionPermissionSetToAccounts(ctx, conn, permissionSetARN, instanceARN, v.(int), d.Timeout(schema.TimeoutUpdate))
+		} else {
+			err = provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate))
+		}
+
+		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -307,6 +320,84 @@ func provisionPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissi
 	return nil
 }
 
+// provisionPermissionSetToAccounts provisions a permission set to each account that it's provisioned to,
+// with at most the specified number of provisioning requests in progress at once.
+// This is faster than provisioning to all accounts in a single request in organizations with many accounts.
+func provisionPermissionSetToAccounts(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string, concurrency int, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	accountIDs, err := findAccountIDsForProvisionedPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+	if err != nil {
+		return fmt.Errorf("reading SSO Permission Set (%s) provisioned accounts: %w", permissionSetARN, err)
+	}
+
+	results := make([]error, len(accountIDs))
+	sem := make(chan struct{}, concurrency)
+	var completed atomic.Int32
+	var wg sync.WaitGroup
+
+	for i, accountID := range accountIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i] = provisionPermissionSetToAccount(ctx, conn, permissionSetARN, instanceARN, accountID, deadline.Remaining())
+
+			log.Printf("[INFO] Provisioned SSO Permission Set (%s) to %d of %d accounts", permissionSetARN, completed.Add(1), len(accountIDs))
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(results...)
+}
+
+func provisionPermissionSetToAccount(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN, accountID string, timeout time.Duration) error {
+	input := ssoadmin.ProvisionPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+		TargetId:         aws.String(accountID),
+		TargetType:       awstypes.ProvisionTargetTypeAwsAccount,
+	}
+
+	output, err := conn.ProvisionPermissionSet(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("provisioning SSO Permission Set (%s) to account (%s): %w", permissionSetARN, accountID, err)
+	}
+
+	if _, err := waitPermissionSetProvisioned(ctx, conn, instanceARN, aws.ToString(output.PermissionSetProvisioningStatus.RequestId), timeout); err != nil {
+		return fmt.Errorf("waiting for SSO Permission Set (%s) provision to account (%s): %w", permissionSetARN, accountID, err)
+	}
+
+	return nil
+}
+
+func findAccountIDsForProvisionedPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string) ([]string, error) {
+	input := ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+	}
+	var output []string
+
+	pages := ssoadmin.NewListAccountsForProvisionedPermissionSetPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccountIds...)
+	}
+
+	return output, nil
+}
+
 func findPermissionSetProvisioningStatus(ctx context.Context, conn *ssoadmin.Client, instanceARN, requestID string) (*awstypes.PermissionSetProvisioningStatus, error) {
 	input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
 		InstanceArn:                     aws.String(instanceARN),
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ssoadmin_permission_set_policy_document", name="Permission Set Policy Document")
+func newPermissionSetPolicyDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &permissionSetPolicyDocumentDataSource{}, nil
+}
+
+type permissionSetPolicyDocumentDataSource struct {
+	framework.DataSourceWithModel[permissionSetPolicyDocumentDataSourceModel]
+}
+
+func (d *permissionSetPolicyDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"inline_policy": schema.StringAttribute{
+				Computed: true,
+			},
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"managed_policy_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"permission_set_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (d *permissionSetPolicyDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data permissionSetPolicyDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSOAdminClient(ctx)
+
+	instanceARN, permissionSetARN := data.InstanceARN.ValueString(), data.PermissionSetARN.ValueString()
+	permissionSet, err := findPermissionSetByTwoPartKey(ctx, conn, permissionSetARN, instanceARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Permission Set (%s)", permissionSetARN), err.Error())
+
+		return
+	}
+
+	inlinePolicy, err := findPermissionSetInlinePolicyByTwoPartKey(ctx, conn, permissionSetARN, instanceARN)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Permission Set (%s) inline policy", permissionSetARN), err.Error())
+
+		return
+	}
+
+	managedPolicies, err := findAttachedManagedPolicies(ctx, conn, &ssoadmin.ListManagedPoliciesInPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+	}, tfslices.PredicateTrue[awstypes.AttachedManagedPolicy]())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Permission Set (%s) managed policies", permissionSetARN), err.Error())
+
+		return
+	}
+
+	customerManagedPolicies, err := findCustomerManagedPolicyReferences(ctx, conn, &ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+	}, tfslices.PredicateTrue[awstypes.CustomerManagedPolicyReference]())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Permission Set (%s) customer managed policies", permissionSetARN), err.Error())
+
+		return
+	}
+
+	permissionsBoundary, err := findPermissionsBoundaryByTwoPartKey(ctx, conn, permissionSetARN, instanceARN)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Permission Set (%s) permissions boundary", permissionSetARN), err.Error())
+
+		return
+	}
+
+	document, err := newPermissionSetPolicyDocument(permissionSet, inlinePolicy, managedPolicies, customerManagedPolicies, permissionsBoundary)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("rendering SSO Permission Set (%s) policy document", permissionSetARN), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, permissionSetARN)
+	data.InlinePolicy = fwflex.StringValueToFramework(ctx, inlinePolicy)
+	data.JSON = fwflex.StringValueToFramework(ctx, document)
+
+	managedPolicyARNs := tfslices.ApplyToAll(managedPolicies, func(v awstypes.AttachedManagedPolicy) string {
+		return aws.ToString(v.Arn)
+	})
+	slices.Sort(managedPolicyARNs)
+	data.ManagedPolicyARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, managedPolicyARNs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// permissionSetPolicyDocument is the consolidated view of the policies that make up a permission set's effective permissions.
+// Customer managed policies are referenced by name and path as they are resolved in each account the permission set is provisioned to.
+type permissionSetPolicyDocument struct {
+	PermissionSetARN        string                                       `json:"PermissionSetArn"`
+	Name                    string                                       `json:"Name"`
+	InlinePolicy            json.RawMessage                              `json:"InlinePolicy,omitempty"`
+	ManagedPolicies         []permissionSetPolicyDocumentManagedPolicy   `json:"ManagedPolicies"`
+	CustomerManagedPolicies []permissionSetPolicyDocumentPolicyReference `json:"CustomerManagedPolicies"`
+	PermissionsBoundary     *permissionSetPolicyDocumentBoundary         `json:"PermissionsBoundary,omitempty"`
+}
+
+type permissionSetPolicyDocumentManagedPolicy struct {
+	ARN  string `json:"Arn"`
+	Name string `json:"Name"`
+}
+
+type permissionSetPolicyDocumentPolicyReference struct {
+	Name string `json:"Name"`
+	Path string `json:"Path"`
+}
+
+type permissionSetPolicyDocumentBoundary struct {
+	CustomerManagedPolicyReference *permissionSetPolicyDocumentPolicyReference `json:"CustomerManagedPolicyReference,omitempty"`
+	ManagedPolicyARN               string                                      `json:"ManagedPolicyArn,omitempty"`
+}
+
+func newPermissionSetPolicyDocument(permissionSet *awstypes.PermissionSet, inlinePolicy string, managedPolicies []awstypes.AttachedManagedPolicy, customerManagedPolicies []awstypes.CustomerManagedPolicyReference, permissionsBoundary *awstypes.PermissionsBoundary) (string, error) {
+	document := permissionSetPolicyDocument{
+		PermissionSetARN:        aws.ToString(permissionSet.PermissionSetArn),
+		Name:                    aws.ToString(permissionSet.Name),
+		ManagedPolicies:         make([]permissionSetPolicyDocumentManagedPolicy, 0, len(managedPolicies)),
+		CustomerManagedPolicies: make([]permissionSetPolicyDocumentPolicyReference, 0, len(customerManagedPolicies)),
+	}
+
+	if inlinePolicy != "" {
+		if !json.Valid([]byte(inlinePolicy)) {
+			return "", errors.New("inline policy is not valid JSON")
+		}
+		document.InlinePolicy = json.RawMessage(inlinePolicy)
+	}
+
+	for _, v := range managedPolicies {
+		document.ManagedPolicies = append(document.ManagedPolicies, permissionSetPolicyDocumentManagedPolicy{
+			ARN:  aws.ToString(v.Arn),
+			Name: aws.ToString(v.Name),
+		})
+	}
+	slices.SortFunc(document.ManagedPolicies, func(a, b permissionSetPolicyDocumentManagedPolicy) int {
+		return cmp.Compare(a.ARN, b.ARN)
+	})
+
+	for _, v := range customerManagedPolicies {
+		document.CustomerManagedPolicies = append(document.CustomerManagedPolicies, permissionSetPolicyDocumentPolicyReference{
+			Name: aws.ToString(v.Name),
+			Path: aws.ToString(v.Path),
+		})
+	}
+	slices.SortFunc(document.CustomerManagedPolicies, func(a, b permissionSetPolicyDocumentPolicyReference) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Name, b.Name))
+	})
+
+	if permissionsBoundary != nil {
+		document.PermissionsBoundary = &permissionSetPolicyDocumentBoundary{
+			ManagedPolicyARN: aws.ToString(permissionsBoundary.ManagedPolicyArn),
+		}
+		if v := permissionsBoundary.CustomerManagedPolicyReference; v != nil {
+			document.PermissionsBoundary.CustomerManagedPolicyReference = &permissionSetPolicyDocumentPolicyReference{
+				Name: aws.ToString(v.Name),
+				Path: aws.ToString(v.Path),
+			}
+		}
+	}
+
+	output, err := json.MarshalIndent(document, "", "  ")
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+type permissionSetPolicyDocumentDataSourceModel struct {
+	framework.WithRegionModel
+	ID                types.String         `tfsdk:"id"`
+	InlinePolicy      types.String         `tfsdk:"inline_policy"`
+	InstanceARN       fwtypes.ARN          `tfsdk:"instance_arn"`
+	JSON              types.String         `tfsdk:"json"`
+	ManagedPolicyARNs fwtypes.ListOfString `tfsdk:"managed_policy_arns"`
+	PermissionSetARN  fwtypes.ARN          `tfsdk:"permission_set_arn"`
+}
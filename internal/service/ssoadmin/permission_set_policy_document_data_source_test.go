@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminPermissionSetPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssoadmin_permission_set_policy_document.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetPolicyDocumentDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, permissionSetResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "inline_policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "managed_policy_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "managed_policy_arns.0", "aws_ssoadmin_managed_policy_attachment.test", "managed_policy_arn"),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"CustomerManagedPolicies": \[\s*{\s*"Name": "`+rName+`",\s*"Path": "/"\s*}\s*\]`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"InlinePolicy": {`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"ManagedPolicyArn": "arn:[^:]+:iam::aws:policy/PowerUserAccess"`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"Name": "AmazonCognitoReadOnly"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionSetPolicyDocumentDataSource_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssoadmin_permission_set_policy_document.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetPolicyDocumentDataSourceConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(dataSourceName, "inline_policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "managed_policy_arns.#", "0"),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"ManagedPolicies": \[\]`)),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"CustomerManagedPolicies": \[\]`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionSetPolicyDocumentDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+`, rName)
+}
+
+func testAccPermissionSetPolicyDocumentDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionSetPolicyDocumentDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid       = "1"
+    actions   = ["s3:ListAllMyBuckets"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::*"]
+  }
+}
+
+resource "aws_ssoadmin_permission_set_inline_policy" "test" {
+  inline_policy      = data.aws_iam_policy_document.test.json
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+
+resource "aws_ssoadmin_managed_policy_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  managed_policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonCognitoReadOnly"
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+  path = "/"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_ssoadmin_customer_managed_policy_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  customer_managed_policy_reference {
+    name = aws_iam_policy.test.name
+    path = "/"
+  }
+}
+
+resource "aws_ssoadmin_permissions_boundary_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  permissions_boundary {
+    managed_policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/PowerUserAccess"
+  }
+}
+
+data "aws_ssoadmin_permission_set_policy_document" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  depends_on = [
+    aws_ssoadmin_customer_managed_policy_attachment.test,
+    aws_ssoadmin_managed_policy_attachment.test,
+    aws_ssoadmin_permission_set_inline_policy.test,
+    aws_ssoadmin_permissions_boundary_attachment.test,
+  ]
+}
+`, rName))
+}
+
+func testAccPermissionSetPolicyDocumentDataSourceConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionSetPolicyDocumentDataSourceConfig_base(rName), `
+data "aws_ssoadmin_permission_set_policy_document" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+`)
+}
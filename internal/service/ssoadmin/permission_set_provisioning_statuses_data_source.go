@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// permissionSetProvisioningStatusesDefaultMaxResults is the default maximum number of provisioning requests described.
+	permissionSetProvisioningStatusesDefaultMaxResults = 100
+)
+
+// @FrameworkDataSource("aws_ssoadmin_permission_set_provisioning_statuses", name="Permission Set Provisioning Statuses")
+func newPermissionSetProvisioningStatusesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &permissionSetProvisioningStatusesDataSource{}, nil
+}
+
+type permissionSetProvisioningStatusesDataSource struct {
+	framework.DataSourceWithModel[permissionSetProvisioningStatusesDataSourceModel]
+}
+
+func (d *permissionSetProvisioningStatusesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"max_results": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"permission_set_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"provisioning_statuses": framework.DataSourceComputedListOfObjectAttribute[permissionSetProvisioningStatusModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.StatusValues](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *permissionSetProvisioningStatusesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data permissionSetProvisioningStatusesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSOAdminClient(ctx)
+
+	instanceARN := data.InstanceARN.ValueString()
+	input := ssoadmin.ListPermissionSetProvisioningStatusInput{
+		InstanceArn: aws.String(instanceARN),
+	}
+	if !data.Status.IsNull() {
+		input.Filter = &awstypes.OperationStatusFilter{
+			Status: data.Status.ValueEnum(),
+		}
+	}
+
+	metadata, err := findPermissionSetProvisioningStatusMetadata(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSO Instance (%s) Permission Set Provisioning Statuses", instanceARN), err.Error())
+
+		return
+	}
+
+	// The list operation only returns the request ID, status and creation date of each request.
+	// Filter on those before describing each request, most recent first, up to the maximum number of results.
+	if !data.Status.IsNull() {
+		metadata = slices.DeleteFunc(metadata, func(v awstypes.PermissionSetProvisioningStatusMetadata) bool {
+			return v.Status != data.Status.ValueEnum()
+		})
+	}
+	slices.SortStableFunc(metadata, func(a, b awstypes.PermissionSetProvisioningStatusMetadata) int {
+		return cmp.Compare(aws.ToTime(b.CreatedDate).UnixNano(), aws.ToTime(a.CreatedDate).UnixNano())
+	})
+
+	maxResults := permissionSetProvisioningStatusesDefaultMaxResults
+	if !data.MaxResults.IsNull() {
+		maxResults = int(data.MaxResults.ValueInt32())
+	}
+
+	var apiObjects []awstypes.PermissionSetProvisioningStatus
+	for _, v := range metadata {
+		if len(apiObjects) == maxResults {
+			break
+		}
+
+		requestID := aws.ToString(v.RequestId)
+		apiObject, err := findPermissionSetProvisioningStatus(ctx, conn, instanceARN, requestID)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading SSO Permission Set Provisioning Status (%s)", requestID), err.Error())
+
+			return
+		}
+
+		if v := data.PermissionSetARN.ValueString(); v != "" && aws.ToString(apiObject.PermissionSetArn) != v {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, instanceARN)
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, apiObjects, &data.ProvisioningStatuses)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findPermissionSetProvisioningStatusMetadata(ctx context.Context, conn *ssoadmin.Client, input *ssoadmin.ListPermissionSetProvisioningStatusInput) ([]awstypes.PermissionSetProvisioningStatusMetadata, error) {
+	var output []awstypes.PermissionSetProvisioningStatusMetadata
+
+	pages := ssoadmin.NewListPermissionSetProvisioningStatusPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PermissionSetsProvisioningStatus...)
+	}
+
+	return output, nil
+}
+
+type permissionSetProvisioningStatusesDataSourceModel struct {
+	framework.WithRegionModel
+	ID                   types.String                                                          `tfsdk:"id"`
+	InstanceARN          fwtypes.ARN                                                           `tfsdk:"instance_arn"`
+	MaxResults           types.Int32                                                           `tfsdk:"max_results"`
+	PermissionSetARN     fwtypes.ARN                                                           `tfsdk:"permission_set_arn"`
+	ProvisioningStatuses fwtypes.ListNestedObjectValueOf[permissionSetProvisioningStatusModel] `tfsdk:"provisioning_statuses"`
+	Status               fwtypes.StringEnum[awstypes.StatusValues]                             `tfsdk:"status"`
+}
+
+type permissionSetProvisioningStatusModel struct {
+	AccountID        types.String                              `tfsdk:"account_id"`
+	CreatedDate      timetypes.RFC3339                         `tfsdk:"created_date"`
+	FailureReason    types.String                              `tfsdk:"failure_reason"`
+	PermissionSetARN types.String                              `tfsdk:"permission_set_arn"`
+	RequestID        types.String                              `tfsdk:"request_id"`
+	Status           fwtypes.StringEnum[awstypes.StatusValues] `tfsdk:"status"`
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminPermissionSetProvisioningStatusesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssoadmin_permission_set_provisioning_statuses.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningStatusesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_arn", permissionSetResourceName, "instance_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioning_statuses.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provisioning_statuses.0.created_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "provisioning_statuses.0.permission_set_arn", permissionSetResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provisioning_statuses.0.request_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioning_statuses.0.status", "SUCCEEDED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionSetProvisioningStatusesDataSource_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssoadmin_permission_set_provisioning_statuses.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningStatusesDataSourceConfig_status(rName, "FAILED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "provisioning_statuses.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "FAILED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionSetProvisioningStatusesDataSource_maxResults(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssoadmin_permission_set_provisioning_statuses.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetProvisioningStatusesDataSourceConfig_maxResults(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "max_results", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioning_statuses.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionSetProvisioningStatusesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_managed_policy_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  managed_policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonCognitoReadOnly"
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+`, rName)
+}
+
+func testAccPermissionSetProvisioningStatusesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionSetProvisioningStatusesDataSourceConfig_base(rName), `
+data "aws_ssoadmin_permission_set_provisioning_statuses" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  depends_on = [aws_ssoadmin_managed_policy_attachment.test]
+}
+`)
+}
+
+func testAccPermissionSetProvisioningStatusesDataSourceConfig_status(rName, status string) string {
+	return acctest.ConfigCompose(testAccPermissionSetProvisioningStatusesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ssoadmin_permission_set_provisioning_statuses" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  status             = %[1]q
+
+  depends_on = [aws_ssoadmin_managed_policy_attachment.test]
+}
+`, status))
+}
+
+func testAccPermissionSetProvisioningStatusesDataSourceConfig_maxResults(rName string, maxResults int) string {
+	return acctest.ConfigCompose(testAccPermissionSetProvisioningStatusesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ssoadmin_permission_set_provisioning_statuses" "test" {
+  instance_arn = aws_ssoadmin_permission_set.test.instance_arn
+  max_results  = %[1]d
+
+  depends_on = [aws_ssoadmin_managed_policy_attachment.test]
+}
+`, maxResults))
+}
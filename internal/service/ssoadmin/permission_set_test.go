@@ -245,6 +245,45 @@ func TestAccSSOAdminPermissionSet_RelayState_updateSessionDuration(t *testing.T)
 	})
 }
 
+func TestAccSSOAdminPermissionSet_provisioningConcurrency(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetConfig_provisioningConcurrency(rName, "PT1H", "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSOAdminPermissionSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_concurrency", "5"),
+					resource.TestCheckResourceAttr(resourceName, "relay_state", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "session_duration", "PT1H"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provisioning_concurrency"},
+			},
+			{
+				Config: testAccPermissionSetConfig_provisioningConcurrency(rName, "PT2H", "https://example.com/updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSOAdminPermissionSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_concurrency", "5"),
+					resource.TestCheckResourceAttr(resourceName, "relay_state", "https://example.com/updated"),
+					resource.TestCheckResourceAttr(resourceName, "session_duration", "PT2H"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminPermissionSet_mixedPolicyAttachments(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ssoadmin_permission_set.test"
@@ -403,6 +442,20 @@ resource "aws_ssoadmin_permission_set" "test" {
 `, rName)
 }
 
+func testAccPermissionSetConfig_provisioningConcurrency(rName, sessionDuration, relayState string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name                     = %[1]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  provisioning_concurrency = 5
+  relay_state              = %[3]q
+  session_duration         = %[2]q
+}
+`, rName, sessionDuration, relayState)
+}
+
 func testAccPermissionSetConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/document"
+	identitystoretypes "github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// findIdentityStoreIDByInstanceARN returns the ID of the identity store connected to the specified SSO instance.
+func findIdentityStoreIDByInstanceARN(ctx context.Context, conn *ssoadmin.Client, instanceARN string) (string, error) {
+	instances, err := findInstanceMetadatas(ctx, conn)
+
+	if err != nil {
+		return "", err
+	}
+
+	instance, err := tfresource.AssertSingleValueResult(tfslices.Filter(instances, func(v awstypes.InstanceMetadata) bool {
+		return aws.ToString(v.InstanceArn) == instanceARN
+	}))
+
+	if err != nil {
+		return "", err
+	}
+
+	if instance.IdentityStoreId == nil {
+		return "", tfresource.NewEmptyResultError(instanceARN)
+	}
+
+	return aws.ToString(instance.IdentityStoreId), nil
+}
+
+// findIdentityStoreIDByApplicationARN returns the ID of the identity store connected to the SSO instance owning the specified application.
+func findIdentityStoreIDByApplicationARN(ctx context.Context, conn *ssoadmin.Client, applicationARN string) (string, error) {
+	application, err := findApplicationByID(ctx, conn, applicationARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	return findIdentityStoreIDByInstanceARN(ctx, conn, aws.ToString(application.InstanceArn))
+}
+
+// findPrincipalIDByName resolves a user name (for USER principals) or a group display name (for GROUP principals) to its Identity Store ID.
+func findPrincipalIDByName(ctx context.Context, conn *identitystore.Client, identityStoreID string, principalType awstypes.PrincipalType, principalName string) (string, error) {
+	switch principalType {
+	case awstypes.PrincipalTypeUser:
+		input := identitystore.GetUserIdInput{
+			AlternateIdentifier: &identitystoretypes.AlternateIdentifierMemberUniqueAttribute{
+				Value: identitystoretypes.UniqueAttribute{
+					AttributePath:  aws.String("userName"),
+					AttributeValue: document.NewLazyDocument(principalName),
+				},
+			},
+			IdentityStoreId: aws.String(identityStoreID),
+		}
+		output, err := conn.GetUserId(ctx, &input)
+
+		if errs.IsA[*identitystoretypes.ResourceNotFoundException](err) {
+			return "", &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		if output == nil || output.UserId == nil {
+			return "", tfresource.NewEmptyResultError(input)
+		}
+
+		return aws.ToString(output.UserId), nil
+	case awstypes.PrincipalTypeGroup:
+		input := identitystore.GetGroupIdInput{
+			AlternateIdentifier: &identitystoretypes.AlternateIdentifierMemberUniqueAttribute{
+				Value: identitystoretypes.UniqueAttribute{
+					AttributePath:  aws.String("displayName"),
+					AttributeValue: document.NewLazyDocument(principalName),
+				},
+			},
+			IdentityStoreId: aws.String(identityStoreID),
+		}
+		output, err := conn.GetGroupId(ctx, &input)
+
+		if errs.IsA[*identitystoretypes.ResourceNotFoundException](err) {
+			return "", &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		if output == nil || output.GroupId == nil {
+			return "", tfresource.NewEmptyResultError(input)
+		}
+
+		return aws.ToString(output.GroupId), nil
+	default:
+		return "", fmt.Errorf("unsupported principal type: %s", principalType)
+	}
+}
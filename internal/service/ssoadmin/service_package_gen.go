@@ -32,12 +32,36 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Application Assignments",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newApplicationProviderDataSource,
+			TypeName: "aws_ssoadmin_application_provider",
+			Name:     "Application Provider",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newApplicationProvidersDataSource,
 			TypeName: "aws_ssoadmin_application_providers",
 			Name:     "Application Providers",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newApplicationsDataSource,
+			TypeName: "aws_ssoadmin_applications",
+			Name:     "Applications",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newPermissionSetPolicyDocumentDataSource,
+			TypeName: "aws_ssoadmin_permission_set_policy_document",
+			Name:     "Permission Set Policy Document",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newPermissionSetProvisioningStatusesDataSource,
+			TypeName: "aws_ssoadmin_permission_set_provisioning_statuses",
+			Name:     "Permission Set Provisioning Statuses",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newPermissionSetsDataSource,
 			TypeName: "aws_ssoadmin_permission_sets",
@@ -79,6 +103,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_ssoadmin_application_assignment",
 			Name:     "Application Assignment",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalParameterizedIdentity([]inttypes.IdentityAttribute{
+				inttypes.StringIdentityAttribute("application_arn", true),
+				inttypes.StringIdentityAttribute("principal_id", true),
+				inttypes.StringIdentityAttribute("principal_type", true),
+			}),
+			Import: inttypes.FrameworkImport{
+				WrappedImport: true,
+				ImportID:      applicationAssignmentImportID{},
+				SetIDAttr:     true,
+			},
 		},
 		{
 			Factory:  newApplicationAssignmentConfigurationResource,
@@ -90,6 +124,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newApplicationAuthenticationMethodResource,
+			TypeName: "aws_ssoadmin_application_authentication_method",
+			Name:     "Application Authentication Method",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newApplicationGrantResource,
+			TypeName: "aws_ssoadmin_application_grant",
+			Name:     "Application Grant",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newTrustedTokenIssuerResource,
 			TypeName: "aws_ssoadmin_trusted_token_issuer",
@@ -129,6 +175,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Account Assignment",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceAccountAssignments,
+			TypeName: "aws_ssoadmin_account_assignments",
+			Name:     "Account Assignments",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceCustomerManagedPolicyAttachment,
 			TypeName: "aws_ssoadmin_customer_managed_policy_attachment",
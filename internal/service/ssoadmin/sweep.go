@@ -6,6 +6,7 @@ package ssoadmin
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -174,8 +175,9 @@ func sweepApplications(region string) error {
 			for _, application := range page.Applications {
 				applicationARN := aws.ToString(application.ApplicationArn)
 
-				if applicationProviderARN := aws.ToString(application.ApplicationProviderArn); applicationProviderARN != "" {
-					log.Printf("[INFO] Skipping SSO Application %s: ApplicationProviderArn=%s", applicationARN, applicationProviderARN)
+				// Applications managed by other AWS services share the instance, so only sweep those created by acceptance tests.
+				if name := aws.ToString(application.Name); !strings.HasPrefix(name, sweep.ResourcePrefix) {
+					log.Printf("[INFO] Skipping SSO Application %s: %s", applicationARN, name)
 					continue
 				}
 
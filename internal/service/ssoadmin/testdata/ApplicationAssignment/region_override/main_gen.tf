@@ -0,0 +1,52 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_ssoadmin_application_assignment" "test" {
+  region = var.region
+
+  application_arn = aws_ssoadmin_application.test.application_arn
+  principal_id    = aws_identitystore_user.test.user_id
+  principal_type  = "USER"
+}
+
+resource "aws_identitystore_user" "test" {
+  region = var.region
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = var.rName
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_ssoadmin_application" "test" {
+  region = var.region
+
+  name                     = var.rName
+  application_provider_arn = local.test_application_provider_arn
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+data "aws_ssoadmin_instances" "test" {
+  region = var.region
+}
+
+locals {
+  test_application_provider_arn = "arn:aws:sso::aws:applicationProvider/custom"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
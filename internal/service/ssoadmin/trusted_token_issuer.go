@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -40,12 +42,19 @@ import (
 // @Testing(preCheckWithRegion="github.com/hashicorp/terraform-provider-aws/internal/acctest;acctest.PreCheckSSOAdminInstancesWithRegion")
 // @Testing(serialize=true)
 func newTrustedTokenIssuerResource(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &trustedTokenIssuerResource{}, nil
+	r := &trustedTokenIssuerResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
 }
 
 type trustedTokenIssuerResource struct {
 	framework.ResourceWithModel[trustedTokenIssuerResourceModel]
 	framework.WithImportByIdentity
+	framework.WithTimeouts
 }
 
 func (r *trustedTokenIssuerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -119,6 +128,11 @@ func (r *trustedTokenIssuerResource) Schema(ctx context.Context, request resourc
 					},
 				},
 			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -130,6 +144,9 @@ func (r *trustedTokenIssuerResource) Create(ctx context.Context, request resourc
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, data.Timeouts))
+	defer cancel()
+
 	conn := r.Meta().SSOAdminClient(ctx)
 
 	name := fwflex.StringValueFromFramework(ctx, data.Name)
@@ -211,11 +228,15 @@ func (r *trustedTokenIssuerResource) Update(ctx context.Context, request resourc
 	if response.Diagnostics.HasError() {
 		return
 	}
+
 	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.UpdateTimeout(ctx, new.Timeouts))
+	defer cancel()
+
 	conn := r.Meta().SSOAdminClient(ctx)
 
 	if !new.Name.Equal(old.Name) ||
@@ -255,6 +276,9 @@ func (r *trustedTokenIssuerResource) Delete(ctx context.Context, request resourc
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, data.Timeouts))
+	defer cancel()
+
 	conn := r.Meta().SSOAdminClient(ctx)
 
 	input := ssoadmin.DeleteTrustedTokenIssuerInput{
@@ -320,6 +344,7 @@ type trustedTokenIssuerResourceModel struct {
 	TrustedTokenIssuerType          fwtypes.StringEnum[awstypes.TrustedTokenIssuerType]                   `tfsdk:"trusted_token_issuer_type"`
 	Tags                            tftags.Map                                                            `tfsdk:"tags"`
 	TagsAll                         tftags.Map                                                            `tfsdk:"tags_all"`
+	Timeouts                        timeouts.Value                                                        `tfsdk:"timeouts"`
 }
 
 type trustedTokenIssuerConfigurationModel struct {
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// BatchFunc applies a single tagging operation to all the specified resources.
+type BatchFunc func(ctx context.Context, identifiers []string) error
+
+// Batcher coalesces concurrent tagging operations that differ only in the resource they target
+// (e.g. applying the same default_tags change to many resources) into a single API call
+// for services whose tagging APIs accept multiple resource identifiers.
+//
+// An operation is sent as soon as no other operation with the same key is in progress, so a lone
+// operation is never delayed. Operations submitted while one with the same key is in progress are
+// combined and sent when it completes.
+// The key must identify everything about the operation other than the resource identifier,
+// i.e. the client, Region, operation and tags.
+type Batcher struct {
+	maxSize int
+	mu      sync.Mutex
+	queues  map[string]*batchQueue
+}
+
+type batchQueue struct {
+	waiting []*batchCall
+}
+
+type batchCall struct {
+	ctx        context.Context
+	done       chan error
+	f          BatchFunc
+	identifier string
+}
+
+// errBatchFailed is returned to each caller whose operation was part of a failed combined call.
+var errBatchFailed = errors.New("batched operation failed")
+
+// NewBatcher returns a new Batcher.
+// maxSize is the maximum number of resource identifiers passed in a single call.
+func NewBatcher(maxSize int) *Batcher {
+	return &Batcher{
+		maxSize: maxSize,
+		queues:  make(map[string]*batchQueue),
+	}
+}
+
+// Do submits the operation identified by key for the specified resource and waits for it to complete.
+// If the combined call fails, the operation is retried for the resource individually using the caller's
+// own context and function so that a failure for one resource (e.g. it no longer exists) is not reported
+// against the others.
+func (b *Batcher) Do(ctx context.Context, key, identifier string, f BatchFunc) error {
+	if b == nil || b.maxSize <= 1 {
+		return f(ctx, []string{identifier})
+	}
+
+	c := &batchCall{
+		ctx:        ctx,
+		done:       make(chan error, 1),
+		f:          f,
+		identifier: identifier,
+	}
+
+	b.mu.Lock()
+	q, inProgress := b.queues[key]
+	if !inProgress {
+		q = &batchQueue{}
+		b.queues[key] = q
+	}
+	q.waiting = append(q.waiting, c)
+	b.mu.Unlock()
+
+	if !inProgress {
+		b.run(key, q)
+	}
+
+	select {
+	case err := <-c.done:
+		if errors.Is(err, errBatchFailed) {
+			return f(ctx, []string{identifier})
+		}
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run sends the next batch of waiting operations for key and then hands the queue off
+// to a new goroutine if more operations arrived in the meantime.
+func (b *Batcher) run(key string, q *batchQueue) {
+	b.mu.Lock()
+	var calls []*batchCall
+	for len(q.waiting) > 0 && len(calls) < b.maxSize {
+		c := q.waiting[0]
+		q.waiting = q.waiting[1:]
+		// Callers that have given up have already returned.
+		if c.ctx.Err() == nil {
+			calls = append(calls, c)
+		}
+	}
+	b.mu.Unlock()
+
+	if len(calls) > 0 {
+		identifiers := make([]string, 0, len(calls))
+		seen := make(map[string]struct{}, len(calls))
+		for _, c := range calls {
+			if _, ok := seen[c.identifier]; ok {
+				continue
+			}
+			seen[c.identifier] = struct{}{}
+			identifiers = append(identifiers, c.identifier)
+		}
+
+		// The combined call is made on behalf of the first caller still waiting.
+		err := calls[0].f(calls[0].ctx, identifiers)
+		if err != nil && len(identifiers) > 1 {
+			err = errBatchFailed
+		}
+
+		for _, c := range calls {
+			c.done <- err
+		}
+	}
+
+	b.mu.Lock()
+	more := len(q.waiting) > 0
+	if !more {
+		delete(b.queues, key)
+	}
+	b.mu.Unlock()
+
+	if more {
+		go b.run(key, q)
+	}
+}
+
+// TagsFunc applies a tagging operation for the specified tags to all the specified resources.
+type TagsFunc func(ctx context.Context, identifiers []string, tags KeyValueTags) error
+
+// Update removes and updates the specified resource's tags.
+// Removed and updated tags have disjoint keys, so the untag and tag operations are made concurrently
+// and each is combined with identical operations for other resources.
+// keyPrefix must identify everything about the operations other than the tags, i.e. the client and Region.
+// A nil Batcher makes the operations in turn for the resource alone.
+func (b *Batcher) Update(ctx context.Context, keyPrefix, identifier string, removedTags, updatedTags KeyValueTags, untag, tag TagsFunc) error {
+	untagFunc := func(ctx context.Context, identifiers []string) error {
+		return untag(ctx, identifiers, removedTags)
+	}
+	tagFunc := func(ctx context.Context, identifiers []string) error {
+		return tag(ctx, identifiers, updatedTags)
+	}
+
+	if b == nil {
+		if len(removedTags) > 0 {
+			if err := untagFunc(ctx, []string{identifier}); err != nil {
+				return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+			}
+		}
+
+		if len(updatedTags) > 0 {
+			if err := tagFunc(ctx, []string{identifier}); err != nil {
+				return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+			}
+		}
+
+		return nil
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs []error
+	)
+	appendErr := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	}
+
+	if len(removedTags) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			key := fmt.Sprintf("%s/untag/%q", keyPrefix, removedTags.Map())
+			if err := b.Do(ctx, key, identifier, untagFunc); err != nil {
+				appendErr(fmt.Errorf("untagging resource (%s): %w", identifier, err))
+			}
+		}()
+	}
+
+	if len(updatedTags) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			key := fmt.Sprintf("%s/tag/%q", keyPrefix, updatedTags.Map())
+			if err := b.Do(ctx, key, identifier, tagFunc); err != nil {
+				appendErr(fmt.Errorf("tagging resource (%s): %w", identifier, err))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+type batchContextKey struct{}
+
+type batchRecorder struct {
+	calls    [][]string
+	contexts []any
+	fail     string
+	mu       sync.Mutex
+}
+
+func (r *batchRecorder) f(ctx context.Context, identifiers []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, slices.Clone(identifiers))
+	r.contexts = append(r.contexts, ctx.Value(batchContextKey{}))
+
+	if slices.Contains(identifiers, r.fail) {
+		return errors.New("failed")
+	}
+
+	return nil
+}
+
+// blockFirst returns a BatchFunc that blocks until release is closed, signalling started once it has been called.
+func blockFirst(f BatchFunc, started chan<- struct{}, release <-chan struct{}) BatchFunc {
+	return func(ctx context.Context, identifiers []string) error {
+		close(started)
+		<-release
+
+		return f(ctx, identifiers)
+	}
+}
+
+// waitForWaiting waits until n operations are queued behind the in-progress operation for key.
+func waitForWaiting(t *testing.T, b *Batcher, key string, n int) {
+	t.Helper()
+
+	for range 1000 {
+		b.mu.Lock()
+		q, ok := b.queues[key]
+		got := 0
+		if ok {
+			got = len(q.waiting)
+		}
+		b.mu.Unlock()
+
+		if got == n {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("timed out waiting for %d queued operations", n)
+}
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identifiers   []string
+		fail          string
+		maxSize       int
+		wantCallSizes []int
+		wantErrs      []string
+	}{
+		"disabled": {
+			identifiers:   []string{"b", "c", "d"},
+			maxSize:       1,
+			wantCallSizes: []int{1, 1, 1, 1},
+		},
+		"coalesced": {
+			identifiers:   []string{"b", "c", "d"},
+			maxSize:       10,
+			wantCallSizes: []int{1, 3},
+		},
+		"split at max size": {
+			identifiers:   []string{"b", "c", "d", "e", "f"},
+			maxSize:       2,
+			wantCallSizes: []int{1, 2, 2, 1},
+		},
+		"failure isolated": {
+			identifiers:   []string{"b", "c", "d"},
+			fail:          "c",
+			maxSize:       10,
+			wantCallSizes: []int{1, 3, 1, 1, 1},
+			wantErrs:      []string{"c"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &batchRecorder{fail: testCase.fail}
+			b := NewBatcher(testCase.maxSize)
+
+			var (
+				mu   sync.Mutex
+				wg   sync.WaitGroup
+				errs = make(map[string]error)
+			)
+			do := func(identifier string, f BatchFunc) {
+				defer wg.Done()
+
+				ctx := context.WithValue(context.Background(), batchContextKey{}, identifier)
+				err := b.Do(ctx, "key", identifier, f)
+
+				mu.Lock()
+				errs[identifier] = err
+				mu.Unlock()
+			}
+
+			// The first operation is in progress while the others are submitted.
+			started, release := make(chan struct{}), make(chan struct{})
+			wg.Add(1)
+			go do("a", blockFirst(r.f, started, release))
+			<-started
+
+			for _, identifier := range testCase.identifiers {
+				wg.Add(1)
+				go do(identifier, r.f)
+			}
+			if testCase.maxSize > 1 {
+				waitForWaiting(t, b, "key", len(testCase.identifiers))
+			}
+
+			close(release)
+			wg.Wait()
+
+			var gotErrs []string
+			for identifier, err := range errs {
+				if err != nil {
+					gotErrs = append(gotErrs, identifier)
+				}
+			}
+			slices.Sort(gotErrs)
+
+			if !slices.Equal(gotErrs, testCase.wantErrs) {
+				t.Errorf("failed identifiers = %v, want %v", gotErrs, testCase.wantErrs)
+			}
+
+			var gotCallSizes []int
+			for _, call := range r.calls {
+				gotCallSizes = append(gotCallSizes, len(call))
+			}
+
+			if testCase.maxSize <= 1 {
+				slices.Sort(gotCallSizes)
+			}
+
+			if !slices.Equal(gotCallSizes, testCase.wantCallSizes) {
+				t.Errorf("call sizes = %v, want %v", gotCallSizes, testCase.wantCallSizes)
+			}
+
+			// Individual calls are made with the caller's own context.
+			for i, call := range r.calls {
+				if len(call) == 1 && r.contexts[i] != call[0] {
+					t.Errorf("call for %s made with context for %v", call[0], r.contexts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBatcher_notDelayed(t *testing.T) {
+	t.Parallel()
+
+	r := &batchRecorder{}
+	b := NewBatcher(10)
+
+	if err := b.Do(context.Background(), "key", "a", r.f); err != nil {
+		t.Fatalf("err = %v", err)
+	}
+
+	if got, want := len(r.calls), 1; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if got := len(b.queues); got != 0 {
+		t.Errorf("queues = %d, want 0", got)
+	}
+}
+
+func TestBatcher_differentKeys(t *testing.T) {
+	t.Parallel()
+
+	r := &batchRecorder{}
+	b := NewBatcher(10)
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- b.Do(context.Background(), "k1", "a", blockFirst(r.f, started, release))
+	}()
+	<-started
+
+	// An operation with a different key is not held up by the one in progress.
+	if err := b.Do(context.Background(), "k2", "a", r.f); err != nil {
+		t.Errorf("err = %v", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("err = %v", err)
+	}
+
+	if got, want := len(r.calls), 2; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestBatcher_canceled(t *testing.T) {
+	t.Parallel()
+
+	r := &batchRecorder{}
+	b := NewBatcher(10)
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- b.Do(context.Background(), "key", "a", blockFirst(r.f, started, release))
+	}()
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		canceled <- b.Do(ctx, "key", "b", r.f)
+	}()
+	waitForWaiting(t, b, "key", 1)
+	cancel()
+
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("err = %v", err)
+	}
+
+	// The canceled operation is dropped from the queue.
+	if got, want := len(r.calls), 1; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestBatcher_Update(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		batcher     *Batcher
+		removedTags KeyValueTags
+		updatedTags KeyValueTags
+		fail        string
+		wantUntag   []string
+		wantTag     map[string]string
+		wantErr     bool
+	}{
+		"nil batcher": {
+			removedTags: New(context.Background(), []string{"k1"}),
+			updatedTags: New(context.Background(), map[string]string{"k2": "v2"}),
+			wantUntag:   []string{"k1"},
+			wantTag:     map[string]string{"k2": "v2"},
+		},
+		"batcher": {
+			batcher:     NewBatcher(10),
+			removedTags: New(context.Background(), []string{"k1"}),
+			updatedTags: New(context.Background(), map[string]string{"k2": "v2"}),
+			wantUntag:   []string{"k1"},
+			wantTag:     map[string]string{"k2": "v2"},
+		},
+		"no changes": {
+			batcher:     NewBatcher(10),
+			removedTags: New(context.Background(), nil),
+			updatedTags: New(context.Background(), nil),
+		},
+		"tag failure": {
+			batcher:     NewBatcher(10),
+			removedTags: New(context.Background(), []string{"k1"}),
+			updatedTags: New(context.Background(), map[string]string{"k2": "v2"}),
+			fail:        "tag",
+			wantUntag:   []string{"k1"},
+			wantErr:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu       sync.Mutex
+				gotUntag []string
+				gotTag   map[string]string
+			)
+			untag := func(_ context.Context, identifiers []string, tags KeyValueTags) error {
+				if !slices.Equal(identifiers, []string{"a"}) {
+					t.Errorf("untag identifiers = %v, want [a]", identifiers)
+				}
+				if testCase.fail == "untag" {
+					return errors.New("failed")
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				gotUntag = tags.Keys()
+
+				return nil
+			}
+			tag := func(_ context.Context, identifiers []string, tags KeyValueTags) error {
+				if !slices.Equal(identifiers, []string{"a"}) {
+					t.Errorf("tag identifiers = %v, want [a]", identifiers)
+				}
+				if testCase.fail == "tag" {
+					return errors.New("failed")
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				gotTag = tags.Map()
+
+				return nil
+			}
+
+			err := testCase.batcher.Update(context.Background(), "prefix", "a", testCase.removedTags, testCase.updatedTags, untag, tag)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+
+			if !slices.Equal(gotUntag, testCase.wantUntag) {
+				t.Errorf("untagged keys = %v, want %v", gotUntag, testCase.wantUntag)
+			}
+
+			if len(gotTag) != len(testCase.wantTag) {
+				t.Errorf("tagged = %v, want %v", gotTag, testCase.wantTag)
+			}
+			for k, v := range testCase.wantTag {
+				if gotTag[k] != v {
+					t.Errorf("tagged = %v, want %v", gotTag, testCase.wantTag)
+				}
+			}
+		})
+	}
+}
@@ -61,7 +61,9 @@ const (
 	CodeArtifactEndpointID                 = "codeartifact"
 	CodeGuruReviewerEndpointID             = "codeguru-reviewer"
 	CodeStarConnectionsEndpointID          = "codestar-connections"
+	CodeStarNotificationsEndpointID        = "codestar-notifications"
 	CognitoIdentityEndpointID              = "cognito-identity"
+	CognitoIDPEndpointID                   = "cognito-idp"
 	ComprehendEndpointID                   = "comprehend"
 	ComputeOptimizerEndpointID             = "compute-optimizer"
 	ConfigServiceEndpointID                = "config"
@@ -69,21 +71,27 @@ const (
 	DataExchangeEndpointID                 = "dataexchange"
 	DataPipelineEndpointID                 = "datapipeline"
 	DataZoneEndpointID                     = "datazone"
+	DeployEndpointID                       = "codedeploy"
 	DetectiveEndpointID                    = "api.detective"
 	DeviceFarmEndpointID                   = "devicefarm"
 	DevOpsGuruEndpointID                   = "devops-guru"
 	DirectConnectEndpointID                = "directconnect"
 	DLMEndpointID                          = "dlm"
+	DocDBEndpointID                        = "rds"
 	DSQLEndpointID                         = "dsql"
 	ECREndpointID                          = "api.ecr"
+	ECRPublicEndpointID                    = "api.ecr-public"
 	ECSEndpointID                          = "ecs"
 	EFSEndpointID                          = "elasticfilesystem"
 	EKSEndpointID                          = "eks"
 	ELBEndpointID                          = "elasticloadbalancing"
+	ELBV2EndpointID                        = "elasticloadbalancing"
+	EMRContainersEndpointID                = "emr-containers"
 	EMREndpointID                          = "elasticmapreduce"
 	ElasticsearchEndpointID                = "es"
 	ElasticTranscoderEndpointID            = "elastictranscoder"
 	ElastiCacheEndpointID                  = "elasticache"
+	EMRServerlessEndpointID                = "emr-serverless"
 	EventsEndpointID                       = "events"
 	EvidentlyEndpointID                    = "evidently"
 	FMSEndpointID                          = "fms"
@@ -97,6 +105,7 @@ const (
 	ImageBuilderEndpointID                 = "imagebuilder"
 	Inspector2EndpointID                   = "inspector2"
 	InternetMonitorEndpointID              = "internetmonitor"
+	KinesisAnalyticsV2EndpointID           = "kinesisanalytics"
 	KMSEndpointID                          = "kms"
 	KafkaConnectEndpointID                 = "kafkaconnect"
 	KendraEndpointID                       = "kendra"
@@ -104,22 +113,32 @@ const (
 	LambdaEndpointID                       = "lambda"
 	LexModelBuildingServiceEndpointID      = "models.lex"
 	LexV2ModelsEndpointID                  = "models-v2-lex"
+	LicenseManagerEndpointID               = "license-manager"
 	LocationEndpointID                     = "location"
 	M2EndpointID                           = "m2"
+	MemoryDBEndpointID                     = "memory-db"
 	MQEndpointID                           = "mq"
 	Macie2EndpointID                       = "macie2"
 	MediaConvertEndpointID                 = "mediaconvert"
 	MediaLiveEndpointID                    = "medialive"
+	MWAAEndpointID                         = "airflow"
+	NeptuneEndpointID                      = "rds"
+	NetworkFirewallEndpointID              = "network-firewall"
 	NotificationsEndpointID                = "notifications"
 	NotificationsContactsEndpointID        = "notifications-contacts"
 	ObservabilityAccessManagerEndpointID   = "oam"
+	OpenSearchEndpointID                   = "es"
 	OpenSearchIngestionEndpointID          = "osis"
 	OpenSearchServerlessEndpointID         = "aoss"
 	PaymentCryptographyEndpointID          = "paymentcryptography"
+	PinpointSMSVoiceV2EndpointID           = "sms-voice"
 	PipesEndpointID                        = "pipes"
 	PollyEndpointID                        = "polly"
 	QLDBEndpointID                         = "qldb"
 	QuickSightEndpointID                   = "quicksight"
+	RedshiftDataEndpointID                 = "redshift-data"
+	ResourceGroupsTaggingAPIEndpointID     = "tagging"
+	Route53RecoveryReadinessEndpointID     = "route53-recovery-readiness"
 	RUMEndpointID                          = "rum"
 	RedshiftEndpointID                     = "redshift"
 	RedshiftServerlessEndpointID           = "redshift-serverless"
@@ -129,9 +148,13 @@ const (
 	Route53DomainsEndpointID               = "route53domains"
 	Route53RecoveryControlConfigEndpointID = "route53-recovery-control-config"
 	ServiceCatalogEndpointID               = "servicecatalog"
+	SESV2EndpointID                        = "email"
+	SFNEndpointID                          = "states"
+	SSMContactsEndpointID                  = "ssm-contacts"
 	SSMEndpointID                          = "ssm"
 	SSMIncidentsEndpointID                 = "ssm-incidents"
 	SSMQuickSetupEndpointID                = "ssm-quicksetup"
+	SSMSAPEndpointID                       = "ssm-sap"
 	SSOAdminEndpointID                     = "sso"
 	STSEndpointID                          = "sts"
 	SchedulerEndpointID                    = "scheduler"
@@ -141,6 +164,8 @@ const (
 	ServiceQuotasEndpointID                = "servicequotas"
 	SESEndpointID                          = "email"
 	ShieldEndpointID                       = "shield"
+	TimestreamQueryEndpointID              = "query.timestream"
+	TimestreamWriteEndpointID              = "ingest.timestream"
 	TranscribeEndpointID                   = "transcribe"
 	TransferEndpointID                     = "transfer"
 	VPCLatticeEndpointID                   = "vpc-lattice"
@@ -150,6 +175,83 @@ const (
 	WorkSpacesWebEndpointID                = "workspaces-web"
 )
 
+// endpointIDs maps service packages to their AWS SDK endpoint IDs, where these differ from the service package name.
+var endpointIDs = map[string]string{
+	ACMPCA:                       ACMPCAEndpointID,
+	AMP:                          AMPEndpointID,
+	APIGatewayV2:                 APIGatewayV2EndpointID,
+	AccessAnalyzer:               AccessAnalyzerEndpointID,
+	AppAutoScaling:               ApplicationAutoscalingEndpointID,
+	AppIntegrations:              AppIntegrationsEndpointID,
+	AppStream:                    AppStreamEndpointID,
+	AutoScalingPlans:             AutoScalingPlansEndpointID,
+	BCMDataExports:               BCMDataExportsEndpointID,
+	ChimeSDKMediaPipelines:       ChimeSDKMediaPipelinesEndpointID,
+	ChimeSDKVoice:                ChimeSDKVoiceEndpointID,
+	CloudWatch:                   CloudWatchEndpointID,
+	CodeGuruReviewer:             CodeGuruReviewerEndpointID,
+	CodeStarConnections:          CodeStarConnectionsEndpointID,
+	CodeStarNotifications:        CodeStarNotificationsEndpointID,
+	CognitoIDP:                   CognitoIDPEndpointID,
+	CognitoIdentity:              CognitoIdentityEndpointID,
+	ComputeOptimizer:             ComputeOptimizerEndpointID,
+	ConfigService:                ConfigServiceEndpointID,
+	Deploy:                       DeployEndpointID,
+	Detective:                    DetectiveEndpointID,
+	DevOpsGuru:                   DevOpsGuruEndpointID,
+	DocDB:                        DocDBEndpointID,
+	ECR:                          ECREndpointID,
+	ECRPublic:                    ECRPublicEndpointID,
+	EFS:                          EFSEndpointID,
+	ELB:                          ELBEndpointID,
+	ELBV2:                        ELBV2EndpointID,
+	EMR:                          EMREndpointID,
+	EMRContainers:                EMRContainersEndpointID,
+	EMRServerless:                EMRServerlessEndpointID,
+	Elasticsearch:                ElasticsearchEndpointID,
+	KinesisAnalyticsV2:           KinesisAnalyticsV2EndpointID,
+	LexModels:                    LexModelBuildingServiceEndpointID,
+	LexV2Models:                  LexV2ModelsEndpointID,
+	LicenseManager:               LicenseManagerEndpointID,
+	MWAA:                         MWAAEndpointID,
+	MemoryDB:                     MemoryDBEndpointID,
+	Neptune:                      NeptuneEndpointID,
+	NetworkFirewall:              NetworkFirewallEndpointID,
+	NotificationsContacts:        NotificationsContactsEndpointID,
+	OpenSearch:                   OpenSearchEndpointID,
+	OpenSearchServerless:         OpenSearchServerlessEndpointID,
+	PinpointSMSVoiceV2:           PinpointSMSVoiceV2EndpointID,
+	RedshiftData:                 RedshiftDataEndpointID,
+	RedshiftServerless:           RedshiftServerlessEndpointID,
+	ResourceExplorer2:            ResourceExplorer2EndpointID,
+	ResourceGroupsTaggingAPI:     ResourceGroupsTaggingAPIEndpointID,
+	Route53RecoveryControlConfig: Route53RecoveryControlConfigEndpointID,
+	Route53RecoveryReadiness:     Route53RecoveryReadinessEndpointID,
+	SES:                          SESEndpointID,
+	SESV2:                        SESV2EndpointID,
+	SFN:                          SFNEndpointID,
+	SSMContacts:                  SSMContactsEndpointID,
+	SSMIncidents:                 SSMIncidentsEndpointID,
+	SSMQuickSetup:                SSMQuickSetupEndpointID,
+	SSMSAP:                       SSMSAPEndpointID,
+	SSOAdmin:                     SSOAdminEndpointID,
+	ServiceCatalogAppRegistry:    ServiceCatalogAppRegistryEndpointID,
+	TimestreamQuery:              TimestreamQueryEndpointID,
+	TimestreamWrite:              TimestreamWriteEndpointID,
+	VPCLattice:                   VPCLatticeEndpointID,
+	WAFRegional:                  WAFRegionalEndpointID,
+	WorkSpacesWeb:                WorkSpacesWebEndpointID,
+}
+
+// EndpointID returns the AWS SDK endpoint ID, the service's host name prefix in its default endpoints, for the specified service package.
+func EndpointID(servicePackageName string) string {
+	if v, ok := endpointIDs[servicePackageName]; ok {
+		return v
+	}
+
+	return servicePackageName
+}
+
 // PartitionForRegion returns the partition for the given Region.
 // Returns the empty partition if the Region is empty.
 // Returns the standard partition if no known partition includes the Region.